@@ -76,6 +76,8 @@ func NewCommand() *cobra.Command {
 		helmRegistryMaxIndexSize              string
 		disableManifestMaxExtractedSize       bool
 		includeHiddenDirectories              bool
+		enableGitWorktrees                    bool
+		maxGitWorktrees                       int
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -143,6 +145,8 @@ func NewCommand() *cobra.Command {
 				HelmManifestMaxExtractedSize:                 helmManifestMaxExtractedSizeQuantity.ToDec().Value(),
 				HelmRegistryMaxIndexSize:                     helmRegistryMaxIndexSizeQuantity.ToDec().Value(),
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
+				GitWorktreesEnabled:                          enableGitWorktrees,
+				MaxGitWorktrees:                              maxGitWorktrees,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -235,6 +239,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&helmRegistryMaxIndexSize, "helm-registry-max-index-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_INDEX_SIZE", "1G"), "Maximum size of registry index file")
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
+	command.Flags().BoolVar(&enableGitWorktrees, "enable-git-worktrees", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_GIT_WORKTREES", false), "Check out the revisions of Git repositories into worktrees, so that the manifests of different revisions of a repository are generated concurrently")
+	command.Flags().IntVar(&maxGitWorktrees, "max-git-worktrees", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_MAX_GIT_WORKTREES", 10, 0, math.MaxInt32), "Maximum number of worktrees kept per Git repository, the least recently used idle worktrees are removed beyond it (0 for unlimited)")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
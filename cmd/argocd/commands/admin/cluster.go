@@ -19,7 +19,6 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/utils/ptr"
 
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
@@ -78,17 +77,17 @@ type ClusterWithInfo struct {
 	Namespaces []string
 }
 
-func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
+func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClient *versioned.Clientset, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, []v1alpha1.Application, error) {
 	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 
 	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
 	clustersList, err := argoDB.ListClusters(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	appItems, err := appClient.ArgoprojV1alpha1().Applications(namespace).List(ctx, v1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
 	clusterShardingCache.Init(clustersList, appItems)
@@ -102,7 +101,7 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 		port, err := kubeutil.PortForward(6379, namespace, &overrides,
 			redisHaProxyPodLabelSelector, redisPodLabelSelector)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
@@ -117,13 +116,13 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 		client := redis.NewClient(redisOptions)
 		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
 	} else {
 		cache, err = cacheSrc()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -131,7 +130,7 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 	for i, app := range apps {
 		err := argo.ValidateDestination(ctx, &app.Spec.Destination, argoDB)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		apps[i] = app
 	}
@@ -151,7 +150,6 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 			cluster := batch[i]
 			if replicas > 0 {
 				clusterShard = clusterShards[cluster.Server]
-				cluster.Shard = ptr.To(int64(clusterShard))
 				log.Infof("Cluster with uid: %s will be processed by shard %d", cluster.ID, clusterShard)
 			}
 			if shard != -1 && clusterShard != shard {
@@ -172,7 +170,7 @@ func loadClusters(ctx context.Context, kubeClient *kubernetes.Clientset, appClie
 			return nil
 		})
 	}
-	return clusters, nil
+	return clusters, apps, nil
 }
 
 func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, appControllerName string) (int, error) {
@@ -190,6 +188,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	var (
 		shard               int
 		replicas            int
+		simulateReplicas    int
 		shardingAlgorithm   string
 		clientConfig        clientcmd.ClientConfig
 		cacheSrc            func() (*appstatecache.Cache, error)
@@ -199,6 +198,12 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	command := cobra.Command{
 		Use:   "shards",
 		Short: "Print information about each controller shard and the estimated portion of Kubernetes resources it is responsible for.",
+		Example: `
+#Display the estimated portion of Kubernetes resources handled by each shard
+argocd admin cluster shards
+
+#Simulate the distribution of clusters across 5 controller replicas with every supported sharding method
+argocd admin cluster shards --simulate-replicas 5`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
 
@@ -218,7 +223,19 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			if replicas == 0 {
 				return
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
+			if simulateReplicas > 0 {
+				// the distribution has to be computed for every cluster, so the shard filter is ignored
+				clusters, apps, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, -1, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
+				errors.CheckError(err)
+				if len(clusters) == 0 {
+					return
+				}
+				for _, algorithm := range []string{common.LegacyShardingAlgorithm, common.RoundRobinShardingAlgorithm, common.ConsistentHashingWithBoundedLoadsAlgorithm} {
+					printShardSimulation(simulateShardDistribution(clusters, apps, simulateReplicas, algorithm))
+				}
+				return
+			}
+			clusters, _, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
 			errors.CheckError(err)
 			if len(clusters) == 0 {
 				return
@@ -230,6 +247,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
+	command.Flags().IntVar(&simulateReplicas, "simulate-replicas", 0, "Simulate the cluster distribution for the given application controller replicas count with every supported sharding method and compare it to the current distribution")
 	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
@@ -262,6 +280,73 @@ func printStatsSummary(clusters []ClusterWithInfo) {
 	_ = w.Flush()
 }
 
+// shardSimulationStats holds the workload a single shard would be responsible for in a simulated distribution
+type shardSimulationStats struct {
+	Clusters  int
+	Apps      int
+	Resources int64
+}
+
+// shardSimulation holds the result of distributing the clusters with a given sharding method and replicas count
+type shardSimulation struct {
+	Algorithm string
+	Replicas  int
+	Shards    []shardSimulationStats
+	// MovedClusters is the number of clusters which would be assigned to a different shard than the current one
+	MovedClusters int
+	// TotalClusters is the number of clusters taken into account by the simulation
+	TotalClusters int
+}
+
+// simulateShardDistribution computes how the given clusters would be distributed across the given number of replicas
+// with the given sharding method, without modifying the current assignment.
+func simulateShardDistribution(clusters []ClusterWithInfo, apps []v1alpha1.Application, replicas int, shardingAlgorithm string) shardSimulation {
+	clusterList := &v1alpha1.ClusterList{}
+	for _, c := range clusters {
+		clusterList.Items = append(clusterList.Items, c.Cluster)
+	}
+	clusterShardingCache := sharding.NewClusterSharding(nil, 0, replicas, shardingAlgorithm)
+	clusterShardingCache.Init(clusterList, &v1alpha1.ApplicationList{Items: apps})
+	distribution := clusterShardingCache.GetDistribution()
+
+	appsByServer := map[string]int{}
+	for _, app := range apps {
+		appsByServer[app.Spec.Destination.Server]++
+	}
+
+	simulation := shardSimulation{
+		Algorithm:     shardingAlgorithm,
+		Replicas:      replicas,
+		Shards:        make([]shardSimulationStats, replicas),
+		TotalClusters: len(clusters),
+	}
+	for _, c := range clusters {
+		shard, ok := distribution[c.Server]
+		if !ok || shard < 0 || shard >= replicas {
+			log.Warnf("Cluster %s would not be assigned to any shard", c.Server)
+			continue
+		}
+		if shard != c.Shard {
+			simulation.MovedClusters++
+		}
+		simulation.Shards[shard].Clusters++
+		simulation.Shards[shard].Apps += appsByServer[c.Server]
+		simulation.Shards[shard].Resources += c.Info.CacheInfo.ResourcesCount
+	}
+	return simulation
+}
+
+func printShardSimulation(simulation shardSimulation) {
+	fmt.Printf("Sharding method: %s, replicas: %d\n", simulation.Algorithm, simulation.Replicas)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SHARD\tCLUSTERS COUNT\tAPPS COUNT\tRESOURCES COUNT\n")
+	for shard, stats := range simulation.Shards {
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%d\t%d\n", shard, stats.Clusters, stats.Apps, stats.Resources)
+	}
+	_ = w.Flush()
+	fmt.Printf("Clusters moved to another shard: %d/%d\n\n", simulation.MovedClusters, simulation.TotalClusters)
+}
+
 func runClusterNamespacesCommand(ctx context.Context, clientConfig clientcmd.ClientConfig, action func(appClient *versioned.Clientset, argoDB db.ArgoDB, clusters map[string][]string) error) error {
 	clientCfg, err := clientConfig.ClientConfig()
 	if err != nil {
@@ -507,7 +592,7 @@ argocd admin cluster stats target-cluster`,
 				replicas, err = getControllerReplicas(ctx, kubeClient, namespace, clientOpts.AppControllerName)
 				errors.CheckError(err)
 			}
-			clusters, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
+			clusters, _, err := loadClusters(ctx, kubeClient, appClient, replicas, shardingAlgorithm, namespace, portForwardRedis, cacheSrc, shard, clientOpts.RedisName, clientOpts.RedisHaProxyName, redisCompressionStr)
 			errors.CheckError(err)
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -664,6 +749,7 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 			if clusterOpts.Shard >= 0 {
 				clst.Shard = &clusterOpts.Shard
 			}
+			clst.AutoNamespaces = clusterOpts.AutoNamespaces
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, ArgoCDNamespace)
 			argoDB := db.NewDB(ArgoCDNamespace, settingsMgr, kubeClientset)
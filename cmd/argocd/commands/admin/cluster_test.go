@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newClusterWithInfo(id string, server string, shard int, resourcesCount int64) ClusterWithInfo {
+	cluster := v1alpha1.Cluster{ID: id, Server: server, Name: id}
+	cluster.Info.CacheInfo.ResourcesCount = resourcesCount
+	return ClusterWithInfo{Cluster: cluster, Shard: shard}
+}
+
+func newAppWithDestination(name string, server string) v1alpha1.Application {
+	return v1alpha1.Application{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: server}},
+	}
+}
+
+func TestSimulateShardDistribution(t *testing.T) {
+	clusters := []ClusterWithInfo{
+		newClusterWithInfo("1", "https://cluster-1", 0, 100),
+		newClusterWithInfo("2", "https://cluster-2", 0, 200),
+		newClusterWithInfo("3", "https://cluster-3", 0, 300),
+		newClusterWithInfo("4", "https://cluster-4", 0, 400),
+	}
+	apps := []v1alpha1.Application{
+		newAppWithDestination("app-1", "https://cluster-1"),
+		newAppWithDestination("app-2", "https://cluster-1"),
+		newAppWithDestination("app-3", "https://cluster-3"),
+	}
+
+	t.Run("RoundRobin", func(t *testing.T) {
+		simulation := simulateShardDistribution(clusters, apps, 2, common.RoundRobinShardingAlgorithm)
+
+		assert.Equal(t, common.RoundRobinShardingAlgorithm, simulation.Algorithm)
+		assert.Equal(t, 2, simulation.Replicas)
+		require.Len(t, simulation.Shards, 2)
+		assert.Equal(t, shardSimulationStats{Clusters: 2, Apps: 3, Resources: 400}, simulation.Shards[0])
+		assert.Equal(t, shardSimulationStats{Clusters: 2, Apps: 0, Resources: 600}, simulation.Shards[1])
+		assert.Equal(t, 2, simulation.MovedClusters)
+		assert.Equal(t, 4, simulation.TotalClusters)
+	})
+
+	t.Run("AllAlgorithmsAssignEveryCluster", func(t *testing.T) {
+		for _, algorithm := range []string{common.LegacyShardingAlgorithm, common.RoundRobinShardingAlgorithm, common.ConsistentHashingWithBoundedLoadsAlgorithm} {
+			simulation := simulateShardDistribution(clusters, apps, 3, algorithm)
+			require.Len(t, simulation.Shards, 3)
+			clustersCount, appsCount, resourcesCount := 0, 0, int64(0)
+			for _, stats := range simulation.Shards {
+				clustersCount += stats.Clusters
+				appsCount += stats.Apps
+				resourcesCount += stats.Resources
+			}
+			assert.Equal(t, len(clusters), clustersCount, algorithm)
+			assert.Equal(t, len(apps), appsCount, algorithm)
+			assert.Equal(t, int64(1000), resourcesCount, algorithm)
+		}
+	})
+
+	t.Run("ExplicitShardIsKept", func(t *testing.T) {
+		pinned := newClusterWithInfo("5", "https://cluster-5", 1, 10)
+		pinned.Cluster.Shard = ptr.To(int64(1))
+		simulation := simulateShardDistribution([]ClusterWithInfo{pinned}, nil, 2, common.LegacyShardingAlgorithm)
+
+		assert.Equal(t, 1, simulation.Shards[1].Clusters)
+		assert.Equal(t, 0, simulation.MovedClusters)
+	})
+
+	t.Run("SingleReplica", func(t *testing.T) {
+		simulation := simulateShardDistribution(clusters, apps, 1, common.LegacyShardingAlgorithm)
+
+		require.Len(t, simulation.Shards, 1)
+		assert.Equal(t, 4, simulation.Shards[0].Clusters)
+		assert.Equal(t, 0, simulation.MovedClusters)
+	})
+}
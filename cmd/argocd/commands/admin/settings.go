@@ -5,15 +5,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	healthutil "github.com/argoproj/gitops-engine/pkg/health"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -348,6 +351,7 @@ func NewResourceOverridesCommand(cmdCtx commandContext) *cobra.Command {
 	command.AddCommand(NewResourceActionListCommand(cmdCtx))
 	command.AddCommand(NewResourceActionRunCommand(cmdCtx))
 	command.AddCommand(NewResourceHealthCommand(cmdCtx))
+	command.AddCommand(NewResourceOverridesTestCommand(cmdCtx))
 	return command
 }
 
@@ -627,3 +631,267 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 	}
 	return command
 }
+
+// resourceHealthTests represents the content of a health_test.yaml file from the resource_customizations directory
+type resourceHealthTests struct {
+	Tests []struct {
+		InputPath    string                  `json:"inputPath"`
+		HealthStatus healthutil.HealthStatus `json:"healthStatus"`
+	} `json:"tests"`
+}
+
+// resourceActionTests represents the content of an action_test.yaml file from the resource_customizations directory
+type resourceActionTests struct {
+	DiscoveryTests []struct {
+		InputPath string                    `json:"inputPath"`
+		Result    []v1alpha1.ResourceAction `json:"result"`
+	} `json:"discoveryTests"`
+	ActionTests []struct {
+		Action             string `json:"action"`
+		InputPath          string `json:"inputPath"`
+		ExpectedOutputPath string `json:"expectedOutputPath"`
+	} `json:"actionTests"`
+}
+
+// resourceOverrideTestsSummary holds the number of passed and failed resource override tests
+type resourceOverrideTestsSummary struct {
+	Passed int
+	Failed int
+}
+
+func (s *resourceOverrideTestsSummary) report(name string, err error) {
+	if err != nil {
+		s.Failed++
+		_, _ = fmt.Printf("FAIL\t%s\n%s\n", name, err.Error())
+		return
+	}
+	s.Passed++
+	_, _ = fmt.Printf("PASS\t%s\n", name)
+}
+
+func NewResourceOverridesTestCommand(cmdCtx commandContext) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "test DIR",
+		Short: "Run health and action tests for resource customizations",
+		Long: "Walks the given directory for 'health_test.yaml' and 'action_test.yaml' files using the same layout as the 'resource_customizations' directory " +
+			"and runs the tests using the lua scripts configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Example: `
+argocd admin settings resource-overrides test ./resource_customizations --argocd-cm-path ./argocd-cm.yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) < 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			settingsManager, err := cmdCtx.createSettingsManager(ctx)
+			errors.CheckError(err)
+			overrides, err := settingsManager.GetResourceOverrides()
+			errors.CheckError(err)
+
+			summary, err := runResourceOverridesTests(args[0], lua.VM{ResourceOverrides: overrides})
+			errors.CheckError(err)
+			_, _ = fmt.Printf("\n%d passed, %d failed\n", summary.Passed, summary.Failed)
+			if summary.Failed > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	return command
+}
+
+// runResourceOverridesTests runs all health and action tests found in the given directory using the given lua VM
+func runResourceOverridesTests(dir string, luaVM lua.VM) (*resourceOverrideTestsSummary, error) {
+	summary := &resourceOverrideTestsSummary{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch info.Name() {
+		case "health_test.yaml":
+			return runResourceHealthTests(path, luaVM, summary)
+		case "action_test.yaml":
+			return runResourceActionTests(path, luaVM, summary)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func runResourceHealthTests(path string, luaVM lua.VM, summary *resourceOverrideTestsSummary) error {
+	var tests resourceHealthTests
+	if err := unmarshalTestFile(path, &tests); err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	for _, test := range tests.Tests {
+		summary.report(fmt.Sprintf("%s health %s", dir, test.InputPath), func() error {
+			obj, err := readTestObject(filepath.Join(dir, test.InputPath))
+			if err != nil {
+				return err
+			}
+			script, useOpenLibs, err := luaVM.GetHealthScript(obj)
+			if err != nil {
+				return err
+			}
+			if script == "" {
+				return fmt.Errorf("health script is not configured for '%s'", obj.GroupVersionKind().GroupKind())
+			}
+			// the script runs with the same libraries as in the controller
+			vm := luaVM
+			vm.UseOpenLibs = useOpenLibs
+			result, err := vm.ExecuteHealthLua(obj, script)
+			if err != nil {
+				return err
+			}
+			if result.Status != test.HealthStatus.Status || result.Message != test.HealthStatus.Message {
+				return fmt.Errorf("expected status %q with message %q, got status %q with message %q",
+					test.HealthStatus.Status, test.HealthStatus.Message, result.Status, result.Message)
+			}
+			return nil
+		}())
+	}
+	return nil
+}
+
+func runResourceActionTests(path string, luaVM lua.VM, summary *resourceOverrideTestsSummary) error {
+	var tests resourceActionTests
+	if err := unmarshalTestFile(path, &tests); err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	for _, test := range tests.DiscoveryTests {
+		summary.report(fmt.Sprintf("%s discovery %s", dir, test.InputPath), func() error {
+			obj, err := readTestObject(filepath.Join(dir, test.InputPath))
+			if err != nil {
+				return err
+			}
+			discoveryScript, err := luaVM.GetResourceActionDiscovery(obj)
+			if err != nil {
+				return err
+			}
+			availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScript)
+			if err != nil {
+				return err
+			}
+			for _, action := range availableActions {
+				if !slices.ContainsFunc(test.Result, func(expected v1alpha1.ResourceAction) bool { return reflect.DeepEqual(expected, action) }) {
+					return fmt.Errorf("unexpected action %q (disabled: %t) was discovered", action.Name, action.Disabled)
+				}
+			}
+			for _, expected := range test.Result {
+				if !slices.ContainsFunc(availableActions, func(action v1alpha1.ResourceAction) bool { return reflect.DeepEqual(expected, action) }) {
+					return fmt.Errorf("expected action %q (disabled: %t) was not discovered", expected.Name, expected.Disabled)
+				}
+			}
+			return nil
+		}())
+	}
+	for _, test := range tests.ActionTests {
+		summary.report(fmt.Sprintf("%s action %s %s", dir, test.Action, test.InputPath), func() error {
+			obj, err := readTestObject(filepath.Join(dir, test.InputPath))
+			if err != nil {
+				return err
+			}
+			action, err := luaVM.GetResourceAction(obj, test.Action)
+			if err != nil {
+				return err
+			}
+			impactedResources, err := luaVM.ExecuteResourceAction(obj, action.ActionLua)
+			if err != nil {
+				return err
+			}
+			expectedObjects, err := readExpectedActionOutput(filepath.Join(dir, test.ExpectedOutputPath))
+			if err != nil {
+				return err
+			}
+			produced := make([]bool, len(expectedObjects))
+			for _, impactedResource := range impactedResources {
+				result := impactedResource.UnstructuredObj
+				var expected *unstructured.Unstructured
+				for i := range expectedObjects {
+					if expectedObjects[i].GroupVersionKind() == result.GroupVersionKind() && expectedObjects[i].GetName() == result.GetName() && expectedObjects[i].GetNamespace() == result.GetNamespace() {
+						expected = &expectedObjects[i]
+						produced[i] = true
+						break
+					}
+				}
+				if expected == nil {
+					return fmt.Errorf("resource %s/%s was not found in the expected output", result.GetKind(), result.GetName())
+				}
+				// the lua VM returns numbers as float64, so the objects are compared using diff instead of deep equality
+				diffResult, err := diff.Diff(expected, result)
+				if err != nil {
+					return err
+				}
+				if diffResult.Modified {
+					_ = cli.PrintDiff(result.GetName(), expected, result)
+					return fmt.Errorf("resource %s/%s does not match the expected output", result.GetKind(), result.GetName())
+				}
+			}
+			for i := range expectedObjects {
+				if !produced[i] {
+					return fmt.Errorf("expected resource %s/%s was not produced by the action", expectedObjects[i].GetKind(), expectedObjects[i].GetName())
+				}
+			}
+			return nil
+		}())
+	}
+	return nil
+}
+
+func unmarshalTestFile(path string, tests interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading test file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, tests); err != nil {
+		return fmt.Errorf("error unmarshaling test file %s: %w", path, err)
+	}
+	return nil
+}
+
+func readTestObject(path string) (*unstructured.Unstructured, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// readExpectedActionOutput reads the expected output of an action. Old-style actions expect a single object
+// while new-style actions expect a list of impacted resources.
+func readExpectedActionOutput(path string) ([]unstructured.Unstructured, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "-") {
+		var impactedResources []map[string]interface{}
+		if err := yaml.Unmarshal(data, &impactedResources); err != nil {
+			return nil, err
+		}
+		objects := make([]unstructured.Unstructured, len(impactedResources))
+		for i, impactedResource := range impactedResources {
+			obj, ok := impactedResource["unstructuredObj"].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected output %s has an item without 'unstructuredObj'", path)
+			}
+			objects[i] = unstructured.Unstructured{Object: obj}
+		}
+		return objects, nil
+	}
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return []unstructured.Unstructured{{Object: obj}}, nil
+}
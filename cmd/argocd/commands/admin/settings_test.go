@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	utils "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	"github.com/stretchr/testify/assert"
@@ -464,3 +468,111 @@ resume   false
 		assert.Contains(t, out, "false")
 	})
 }
+
+func TestResourceOverridesTest(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "example.com/ExampleResource/actions/testdata"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com/ExampleResource/health_test.yaml"), []byte(`tests:
+- healthStatus:
+    status: Progressing
+    message: "Still working"
+  inputPath: actions/testdata/resource.yaml
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com/ExampleResource/actions/action_test.yaml"), []byte(`discoveryTests:
+- inputPath: testdata/resource.yaml
+  result:
+  - name: label
+    disabled: false
+actionTests:
+- action: label
+  inputPath: testdata/resource.yaml
+  expectedOutputPath: testdata/resource-labeled.yaml
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com/ExampleResource/actions/testdata/resource.yaml"), []byte(testCustomResourceYAML), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "example.com/ExampleResource/actions/testdata/resource-labeled.yaml"), []byte(`apiVersion: example.com/v1alpha1
+kind: ExampleResource
+metadata:
+  name: example-resource
+  labels:
+    app: example
+    test: updated
+spec:
+  replicas: 0
+`), 0o644))
+
+	customizations := `example.com/ExampleResource:
+  health.lua: |
+    return { status = "Progressing", message = "Still working" }
+  actions: |
+    discovery.lua: |
+      actions = {}
+      actions["label"] = {["disabled"] = false}
+      return actions
+    definitions:
+    - name: label
+      action.lua: |
+        obj.metadata.labels["test"] = 'updated'
+        return obj
+`
+
+	t.Run("AllTestsPass", func(t *testing.T) {
+		overrides, err := newSettingsManager(map[string]string{"resource.customizations": customizations}).GetResourceOverrides()
+		require.NoError(t, err)
+		var summary *resourceOverrideTestsSummary
+		out, err := captureStdout(func() {
+			summary, err = runResourceOverridesTests(dir, lua.VM{ResourceOverrides: overrides})
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, summary.Passed)
+		assert.Equal(t, 0, summary.Failed)
+		assert.Contains(t, out, "PASS")
+		assert.NotContains(t, out, "FAIL")
+	})
+
+	t.Run("TestsFail", func(t *testing.T) {
+		overrides, err := newSettingsManager(map[string]string{"resource.customizations": strings.ReplaceAll(customizations, "updated", "changed")}).GetResourceOverrides()
+		require.NoError(t, err)
+		overrides["example.com/ExampleResource"] = v1alpha1.ResourceOverride{
+			HealthLua: `return { status = "Healthy" }`,
+			Actions:   overrides["example.com/ExampleResource"].Actions,
+		}
+		var summary *resourceOverrideTestsSummary
+		out, err := captureStdout(func() {
+			summary, err = runResourceOverridesTests(dir, lua.VM{ResourceOverrides: overrides})
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Passed)
+		assert.Equal(t, 2, summary.Failed)
+		assert.Contains(t, out, `expected status "Progressing" with message "Still working", got status "Healthy" with message ""`)
+		assert.Contains(t, out, "does not match the expected output")
+	})
+
+	t.Run("ExpectedActionNotDiscovered", func(t *testing.T) {
+		overrides, err := newSettingsManager(map[string]string{"resource.customizations": strings.ReplaceAll(customizations, `actions["label"] = {["disabled"] = false}`, "")}).GetResourceOverrides()
+		require.NoError(t, err)
+		var summary *resourceOverrideTestsSummary
+		out, err := captureStdout(func() {
+			summary, err = runResourceOverridesTests(dir, lua.VM{ResourceOverrides: overrides})
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.Passed)
+		assert.Equal(t, 1, summary.Failed)
+		assert.Contains(t, out, `expected action "label" (disabled: false) was not discovered`)
+	})
+
+	t.Run("Command", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": customizations,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"test", dir})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "3 passed, 0 failed")
+	})
+}
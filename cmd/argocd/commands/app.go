@@ -0,0 +1,490 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	argoerrors "github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/templates"
+)
+
+// NewApplicationCommand returns a new instance of an `argocd app` command
+func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "app",
+		Aliases: []string{"apps", "application", "applications"},
+		Short:   "Manage applications",
+		Example: templates.Examples(`
+			# Hard refresh all applications of the team-a project
+			argocd app bulk hard-refresh --project team-a
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationSyncCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkCommand(clientOpts))
+	command.AddCommand(NewApplicationPauseCommand(clientOpts))
+	command.AddCommand(NewApplicationResumeCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationReconcileProfilesCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncHistoryCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
+func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		revision         string
+		prune            bool
+		dryRun           bool
+		ignorePruneLimit bool
+		appNamespace     string
+		project          string
+	)
+	command := &cobra.Command{
+		Use:   "sync APPNAME",
+		Short: "Sync an application to its target state",
+		Example: templates.Examples(`
+			# Sync an app
+			argocd app sync my-app
+
+			# Sync an app and prune more resources than permitted by its PruneLimit sync option
+			argocd app sync my-app --prune --ignore-prune-limit
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			_, err := appIf.Sync(ctx, &applicationpkg.ApplicationSyncRequest{
+				Name:             &appName,
+				AppNamespace:     &appNs,
+				Project:          &project,
+				Revision:         &revision,
+				Prune:            &prune,
+				DryRun:           &dryRun,
+				IgnorePruneLimit: &ignorePruneLimit,
+			})
+			argoerrors.CheckError(err)
+			fmt.Printf("Sync of application %s started\n", args[0])
+		},
+	}
+	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().BoolVar(&ignorePruneLimit, "ignore-prune-limit", false, "Allow pruning more resources than permitted by the PruneLimit sync option")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only sync an application in namespace")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	return command
+}
+
+// NewApplicationPauseCommand returns a new instance of an `argocd app pause` command
+func NewApplicationPauseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		reason   string
+		duration time.Duration
+		project  string
+	)
+	command := &cobra.Command{
+		Use:   "pause APPNAME",
+		Short: "Pause the reconciliation of an application",
+		Example: templates.Examples(`
+			# Pause the reconciliation of the guestbook application until it is resumed
+			argocd app pause guestbook --reason "database migration in progress"
+
+			# Pause the reconciliation of the guestbook application for two hours
+			argocd app pause guestbook --reason "incident 1234" --duration 2h
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			req := applicationpkg.ApplicationPauseRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+				Reason:       &reason,
+			}
+			if duration > 0 {
+				req.Duration = ptr.To(duration.String())
+			}
+			app, err := appIf.Pause(ctx, &req)
+			argoerrors.CheckError(err)
+			fmt.Println(app.Spec.Pause.Message())
+		},
+	}
+	command.Flags().StringVar(&reason, "reason", "", "The reason why the application is paused")
+	command.Flags().DurationVar(&duration, "duration", 0, "Resume the reconciliation automatically after the given duration, e.g. 30m or 2h (the pause does not expire by default)")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	return command
+}
+
+// NewApplicationResumeCommand returns a new instance of an `argocd app resume` command
+func NewApplicationResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "resume APPNAME",
+		Short: "Resume the reconciliation of a paused application",
+		Example: templates.Examples(`
+			# Resume the reconciliation of the guestbook application
+			argocd app resume guestbook
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			_, err := appIf.Resume(ctx, &applicationpkg.ApplicationResumeRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+			})
+			argoerrors.CheckError(err)
+			fmt.Printf("Reconciliation of application %s resumed\n", args[0])
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	return command
+}
+
+// NewApplicationSyncLogsCommand returns a new instance of an `argocd app sync-logs` command
+func NewApplicationSyncLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		project string
+		output  string
+	)
+	command := &cobra.Command{
+		Use:   "sync-logs APPNAME",
+		Short: "Print the logs captured from the pods which failed during the last sync of an application",
+		Example: templates.Examples(`
+			# Print the logs of the hooks which failed during the last sync of the guestbook application
+			argocd app sync-logs guestbook
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			res, err := appIf.SyncLogs(ctx, &applicationpkg.ApplicationSyncLogsQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+			})
+			argoerrors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(res.Items, output, false)
+				argoerrors.CheckError(err)
+			case "":
+				if len(res.Items) == 0 {
+					fmt.Println("No logs were captured during the last sync")
+					return
+				}
+				for i, item := range res.Items {
+					if i > 0 {
+						fmt.Println()
+					}
+					resource := item.Kind + "/" + item.Name
+					if item.Namespace != "" {
+						resource = item.Kind + "/" + item.Namespace + "/" + item.Name
+					}
+					fmt.Printf("==> %s, pod %s, container %s (captured at %s) <==\n", resource, item.PodName, item.Container, item.CapturedAt.Format(time.RFC3339))
+					fmt.Print(item.Logs)
+					if item.Logs != "" && !strings.HasSuffix(item.Logs, "\n") {
+						fmt.Println()
+					}
+				}
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+// NewApplicationReconcileProfilesCommand returns a new instance of an `argocd app reconcile-profiles` command
+func NewApplicationReconcileProfilesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		project string
+		output  string
+	)
+	command := &cobra.Command{
+		Use:   "reconcile-profiles APPNAME",
+		Short: "Print the time spent in each phase of the last reconciliations of an application",
+		Example: templates.Examples(`
+			# Print the profiles of the last reconciliations of the guestbook application
+			argocd app reconcile-profiles guestbook
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			res, err := appIf.ReconcileProfiles(ctx, &applicationpkg.ApplicationReconcileProfilesQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+			})
+			argoerrors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(res.Items, output, false)
+				argoerrors.CheckError(err)
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "STARTED\tCOMPARISON\tDURATION\tPHASES\n")
+				for _, item := range res.Items {
+					var phases []string
+					for _, phase := range item.Phases {
+						phases = append(phases, fmt.Sprintf("%s=%dms", phase.Name, phase.DurationMs))
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%dms\t%s\n", item.StartedAt.Format(time.RFC3339), item.ComparisonLevel, item.DurationMs, strings.Join(phases, " "))
+				}
+				_ = w.Flush()
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+// NewApplicationSyncHistoryCommand returns a new instance of an `argocd app sync-history` command
+func NewApplicationSyncHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		project   string
+		output    string
+		offset    int64
+		limit     int64
+		resources bool
+	)
+	command := &cobra.Command{
+		Use:   "sync-history APPNAME",
+		Short: "Print the extended sync history of an application, including the result of the sync of each resource",
+		Example: templates.Examples(`
+			# Print the last 20 sync operations of the guestbook application
+			argocd app sync-history guestbook
+
+			# Print the next 20 sync operations, with the result of the sync of each resource
+			argocd app sync-history guestbook --offset 20 --resources
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			res, err := appIf.SyncHistory(ctx, &applicationpkg.ApplicationSyncHistoryQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Project:      &project,
+				Offset:       &offset,
+				Limit:        &limit,
+			})
+			argoerrors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(res.Items, output, false)
+				argoerrors.CheckError(err)
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "ID\tSTARTED\tDURATION\tPHASE\tINITIATED BY\tREVISIONS\tMESSAGE\n")
+				for _, item := range res.Items {
+					initiatedBy := item.InitiatedBy.Username
+					if item.InitiatedBy.Automated {
+						initiatedBy = "automated sync policy"
+					}
+					_, _ = fmt.Fprintf(w, "%d\t%s\t%dms\t%s\t%s\t%s\t%s\n", item.ID, item.StartedAt.Format(time.RFC3339), item.DurationMs, item.Phase, initiatedBy, strings.Join(item.Revisions, ","), item.Message)
+					// the resources are listed below their operation, with their status in the phase column
+					if resources {
+						for _, resource := range item.Resources {
+							_, _ = fmt.Fprintf(w, "\t  %s\t\t%s\t\t\t%s\n", strings.TrimPrefix(strings.Join([]string{resource.Namespace, resource.Kind, resource.Name}, "/"), "/"), resource.Status, resource.Message)
+						}
+					}
+				}
+				_ = w.Flush()
+				fmt.Printf("Showing %d of %d sync operations\n", len(res.Items), res.GetTotal())
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	command.Flags().Int64Var(&offset, "offset", 0, "Number of most recent sync operations to skip")
+	command.Flags().Int64Var(&limit, "limit", 20, "Maximum number of sync operations to print, 0 prints all of them")
+	command.Flags().BoolVar(&resources, "resources", false, "Print the result of the sync of each resource")
+	return command
+}
+
+// NewApplicationBulkCommand returns a new instance of an `argocd app bulk` command
+func NewApplicationBulkCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		selector          string
+		projects          []string
+		name              string
+		appNamespace      string
+		concurrency       int64
+		prune             bool
+		dryRun            bool
+		cascade           bool
+		propagationPolicy string
+	)
+	command := &cobra.Command{
+		Use:   "bulk OPERATION",
+		Short: "Run an operation against all applications matching a selector, project or name pattern",
+		Long:  "Run an operation against all applications matching a selector, project or name pattern. OPERATION is one of sync, refresh, hard-refresh, terminate or delete.",
+		Example: templates.Examples(`
+			# Sync all applications labeled with team=a
+			argocd app bulk sync -l team=a
+
+			# Hard refresh all applications whose name starts with guestbook-, ten at a time
+			argocd app bulk hard-refresh --name 'guestbook-*' --concurrency 10
+
+			# Terminate the running operations of all applications in the staging project
+			argocd app bulk terminate --project staging
+
+			# Delete all applications of the staging project without deleting their resources
+			argocd app bulk delete --project staging --cascade=false
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if selector == "" && len(projects) == 0 && name == "" {
+				log.Fatal("At least one of --selector, --project or --name is required")
+			}
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			req := applicationpkg.ApplicationBulkOperationRequest{
+				Operation:    ptr.To(args[0]),
+				Selector:     ptr.To(selector),
+				Projects:     projects,
+				Name:         ptr.To(name),
+				AppNamespace: ptr.To(appNamespace),
+				Concurrency:  ptr.To(concurrency),
+				Prune:        ptr.To(prune),
+				DryRun:       ptr.To(dryRun),
+			}
+			if c.Flags().Changed("cascade") {
+				req.Cascade = ptr.To(cascade)
+			}
+			if c.Flags().Changed("propagation-policy") {
+				req.PropagationPolicy = ptr.To(propagationPolicy)
+			}
+			stream, err := appIf.BulkOperation(ctx, &req)
+			argoerrors.CheckError(err)
+
+			failed, err := printBulkOperationProgress(stream, os.Stdout)
+			argoerrors.CheckError(err)
+			if failed > 0 {
+				log.Fatalf("%s failed for %d application(s)", args[0], failed)
+			}
+		},
+	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Run the operation against apps matching the label selector")
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Run the operation against apps of the given projects")
+	command.Flags().StringVar(&name, "name", "", "Run the operation against apps whose name matches the glob pattern")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only run the operation against apps in the given namespace")
+	command.Flags().Int64Var(&concurrency, "concurrency", 0, "Maximum number of applications processed in parallel, capped by the server (defaults to 10)")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources when syncing")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview the sync without affecting the clusters")
+	command.Flags().BoolVar(&cascade, "cascade", true, "Perform a cascaded deletion of all application resources when deleting")
+	command.Flags().StringVarP(&propagationPolicy, "propagation-policy", "P", "foreground", "Specify propagation policy for deletion of application's resources. One of: foreground|background")
+	return command
+}
+
+// printBulkOperationProgress prints the bulk operation results as they are received and returns the number of
+// applications the operation failed for
+func printBulkOperationProgress(stream applicationpkg.ApplicationService_BulkOperationClient, out io.Writer) (int, error) {
+	failed := 0
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return failed, nil
+		}
+		if err != nil {
+			return failed, err
+		}
+		result := "Succeeded"
+		if !resp.GetSucceeded() {
+			result = "Failed"
+			failed++
+		}
+		line := fmt.Sprintf("[%d/%d] %s/%s\t%s", resp.GetCompleted(), resp.GetTotal(), resp.GetAppNamespace(), resp.GetName(), result)
+		if resp.GetMessage() != "" {
+			line = fmt.Sprintf("%s\t%s", line, strings.TrimSpace(resp.GetMessage()))
+		}
+		_, _ = fmt.Fprintln(out, line)
+	}
+}
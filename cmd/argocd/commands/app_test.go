@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+)
+
+type fakeBulkOperationClient struct {
+	grpc.ClientStream
+	responses []*applicationpkg.ApplicationBulkOperationResponse
+}
+
+func (f *fakeBulkOperationClient) Recv() (*applicationpkg.ApplicationBulkOperationResponse, error) {
+	if len(f.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+func TestPrintBulkOperationProgress(t *testing.T) {
+	stream := &fakeBulkOperationClient{responses: []*applicationpkg.ApplicationBulkOperationResponse{{
+		Name:         ptr.To("guestbook"),
+		AppNamespace: ptr.To("argocd"),
+		Succeeded:    ptr.To(true),
+		Completed:    ptr.To(int64(1)),
+		Total:        ptr.To(int64(2)),
+	}, {
+		Name:         ptr.To("helm-guestbook"),
+		AppNamespace: ptr.To("argocd"),
+		Succeeded:    ptr.To(false),
+		Message:      ptr.To("permission denied"),
+		Completed:    ptr.To(int64(2)),
+		Total:        ptr.To(int64(2)),
+	}}}
+	out := bytes.NewBufferString("")
+
+	failed, err := printBulkOperationProgress(stream, out)
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, "[1/2] argocd/guestbook\tSucceeded\n[2/2] argocd/helm-guestbook\tFailed\tpermission denied\n", out.String())
+}
@@ -181,6 +181,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if clusterOpts.Project != "" {
 				clst.Project = clusterOpts.Project
 			}
+			clst.AutoNamespaces = clusterOpts.AutoNamespaces
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  clusterOpts.Upsert,
@@ -2,9 +2,12 @@ package headless
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,12 +15,16 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/initialize"
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -28,7 +35,12 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/reposerver"
 	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/reposerver/askpass"
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
+	reposervermetrics "github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/reposerver/repository"
 	"github.com/argoproj/argo-cd/v2/server"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/util/cache"
@@ -37,6 +49,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/io"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/localconfig"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type forwardCacheClient struct {
@@ -152,6 +165,141 @@ func (c *forwardRepoClientset) NewRepoServerClient() (io.Closer, repoapiclient.R
 	return c.repoClientset.NewRepoServerClient()
 }
 
+// controllerHistoryKeyPrefixes are the prefixes of the cache keys of the history recorded by the application
+// controller, along with the description of the history
+var controllerHistoryKeyPrefixes = map[string]string{
+	"app|reconcile-profiles|": "reconcile profiles",
+	"app|sync-history|":       "sync history",
+	"app|sync-captured-logs|": "captured sync logs",
+}
+
+// localStateKeyPrefixes are the prefixes of the cache keys of the application state which is computed in the current
+// process in local mode
+var localStateKeyPrefixes = []string{
+	"app|managed-resources|",
+	"app|resources-tree|",
+}
+
+// localCacheClient is the in-memory cache of the local mode. The managed resources and the resource tree of an
+// application are computed on the first read, since no application controller populates the cache. The history
+// recorded by the application controller is not available, so reading it fails with an explicit error rather than a
+// cache miss.
+type localCacheClient struct {
+	cache.CacheClient
+	// refreshApp computes the state of the given application and stores it into the cache
+	refreshApp func(appName string) error
+}
+
+func (c *localCacheClient) Get(key string, obj interface{}) error {
+	for prefix, history := range controllerHistoryKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return status.Errorf(codes.FailedPrecondition, "the %s of applications is recorded by the application controller and is not available with --core-local, use --core instead", history)
+		}
+	}
+	err := c.CacheClient.Get(key, obj)
+	if !errors.Is(err, cache.ErrCacheMiss) || c.refreshApp == nil {
+		return err
+	}
+	for _, prefix := range localStateKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			appName := strings.TrimSuffix(strings.TrimPrefix(key, prefix), "|"+common.CacheVersion)
+			if err := c.refreshApp(appName); err != nil {
+				return err
+			}
+			return c.CacheClient.Get(key, obj)
+		}
+	}
+	return err
+}
+
+// localAppState lazily starts watching the applications and the destination clusters in the current process, and
+// computes the state of applications with the comparison logic of the application controller
+type localAppState struct {
+	ctx           context.Context
+	namespace     string
+	kubeClientset kubernetes.Interface
+	appClientset  appclientset.Interface
+	repoClientset repoapiclient.Clientset
+	cache         *appstatecache.Cache
+	init          sync.Once
+	state         *controller.LocalAppState
+	err           error
+}
+
+func (s *localAppState) refresh(appName string) error {
+	s.init.Do(func() {
+		settingsMgr := settings.NewSettingsManager(s.ctx, s.kubeClientset, s.namespace)
+		s.state, s.err = controller.NewLocalAppState(s.ctx, s.namespace, settingsMgr, s.kubeClientset, s.appClientset, s.repoClientset, s.cache)
+	})
+	if s.err != nil {
+		return s.err
+	}
+	return s.state.Refresh(s.ctx, appName)
+}
+
+// localRepoClientset lazily starts a repo server in the current process and connects to it
+type localRepoClientset struct {
+	ctx           context.Context
+	init          sync.Once
+	repoClientset repoapiclient.Clientset
+	err           error
+}
+
+func (c *localRepoClientset) NewRepoServerClient() (io.Closer, repoapiclient.RepoServerServiceClient, error) {
+	c.init.Do(func() {
+		c.repoClientset, c.err = startLocalRepoServer(c.ctx)
+	})
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+	return c.repoClientset.NewRepoServerClient()
+}
+
+// startLocalRepoServer starts a repo server with an in-memory cache listening on a random local port. The server
+// and its temporary files are cleaned up once the given context is done.
+func startLocalRepoServer(ctx context.Context) (repoapiclient.Clientset, error) {
+	tempDir, err := os.MkdirTemp("", "argocd-repo-server")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	// git invokes the current binary as askpass helper, which reads the socket path from the environment
+	askpass.SocketPath = filepath.Join(tempDir, "askpass.sock")
+	if err := os.Setenv("ARGOCD_ASK_PASS_SOCK", askpass.SocketPath); err != nil {
+		return nil, fmt.Errorf("error setting askpass socket path: %w", err)
+	}
+	askPassServer := askpass.NewServer()
+	if err := askPassServer.Run(askpass.SocketPath); err != nil {
+		return nil, fmt.Errorf("error starting askpass server: %w", err)
+	}
+
+	// use the same limits as the repo server defaults
+	maxTarSize := resource.MustParse("100M")
+	maxExtractedSize := resource.MustParse("1G")
+	repoCache := reposervercache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), 24*time.Hour, 3*time.Minute, 10*time.Second, time.Hour)
+	srv, err := reposerver.NewServer(reposervermetrics.NewMetricsServer(), repoCache, nil, repository.RepoServerInitConstants{
+		MaxCombinedDirectoryManifestsSize: resource.MustParse("10M"),
+		StreamedManifestMaxTarSize:        maxTarSize.Value(),
+		StreamedManifestMaxExtractedSize:  maxExtractedSize.Value(),
+		HelmManifestMaxExtractedSize:      maxExtractedSize.Value(),
+		HelmRegistryMaxIndexSize:          maxExtractedSize.Value(),
+	}, askPassServer)
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server: %w", err)
+	}
+	ln, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+	grpcServer := srv.CreateGRPC()
+	go func() { _ = grpcServer.Serve(ln) }()
+	go func() {
+		<-ctx.Done()
+		grpcServer.Stop()
+		_ = os.RemoveAll(tempDir)
+	}()
+	return repoapiclient.NewRepoServerClientset(ln.Addr().String(), 60, repoapiclient.TLSConfiguration{DisableTLS: true}), nil
+}
+
 func testAPI(ctx context.Context, clientOpts *apiclient.ClientOptions) error {
 	apiClient, err := apiclient.NewClient(clientOpts)
 	if err != nil {
@@ -179,7 +327,8 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		flags := pflag.NewFlagSet("tmp", pflag.ContinueOnError)
 		clientConfig = cli.AddKubectlFlagsToSet(flags)
 	}
-	startInProcessAPI := clientOpts.Core
+	startInProcessAPI := clientOpts.Core || clientOpts.CoreLocal
+	localMode := clientOpts.CoreLocal
 	if !startInProcessAPI {
 		// Core mode is enabled on client options. Check the local config to see if we should start the API server.
 		localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
@@ -192,7 +341,8 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 				return fmt.Errorf("error resolving context: %w", err)
 			}
 			// There was a local config file, so determine whether core mode is enabled per the config file.
-			startInProcessAPI = configCtx.Server.Core
+			startInProcessAPI = configCtx.Server.Core || configCtx.Server.CoreLocal
+			localMode = configCtx.Server.CoreLocal
 		}
 	}
 	// If we're in core mode, start the API server on the fly.
@@ -240,7 +390,22 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 	if err != nil {
 		return fmt.Errorf("error running miniredis: %w", err)
 	}
-	appstateCache := appstatecache.NewCache(cache.NewCache(&forwardCacheClient{namespace: namespace, context: ctxStr, compression: compression, redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName}), time.Hour)
+	var cacheClient cache.CacheClient = &forwardCacheClient{namespace: namespace, context: ctxStr, compression: compression, redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName}
+	var repoClientset repoapiclient.Clientset = &forwardRepoClientset{namespace: namespace, context: ctxStr, repoServerName: clientOpts.RepoServerName, kubeClientset: kubeClientset}
+	var localCache *localCacheClient
+	if localMode {
+		// In local mode nothing but the Kubernetes API is reachable, so the state which is normally shared through
+		// Redis is kept in memory and manifests are generated by a repo server running in the current process.
+		localCache = &localCacheClient{CacheClient: cache.NewInMemoryCache(time.Hour)}
+		cacheClient = localCache
+		repoClientset = &localRepoClientset{ctx: ctx}
+	}
+	appstateCache := appstatecache.NewCache(cache.NewCache(cacheClient), time.Hour)
+	if localCache != nil {
+		// the state of applications is computed from the destination clusters rather than by the application controller
+		state := &localAppState{ctx: ctx, namespace: namespace, kubeClientset: kubeClientset, appClientset: appClientset, repoClientset: repoClientset, cache: appstateCache}
+		localCache.refreshApp = state.refresh
+	}
 	srv := server.NewServer(ctx, server.ArgoCDServerOpts{
 		EnableGZip:           false,
 		Namespace:            namespace,
@@ -252,7 +417,7 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		KubeClientset:        kubeClientset,
 		Insecure:             true,
 		ListenHost:           *address,
-		RepoClientset:        &forwardRepoClientset{namespace: namespace, context: ctxStr, repoServerName: clientOpts.RepoServerName, kubeClientset: kubeClientset},
+		RepoClientset:        repoClientset,
 		EnableProxyExtension: false,
 	})
 	srv.Init(ctx)
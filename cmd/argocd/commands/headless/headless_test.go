@@ -0,0 +1,89 @@
+package headless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func TestLocalCacheClient(t *testing.T) {
+	var refreshed []string
+	client := &localCacheClient{CacheClient: cache.NewInMemoryCache(time.Hour)}
+	appstateCache := appstatecache.NewCache(cache.NewCache(client), time.Hour)
+	client.refreshApp = func(appName string) error {
+		refreshed = append(refreshed, appName)
+		if appName == "broken-app" {
+			return errors.New("cluster is unreachable")
+		}
+		if err := appstateCache.SetAppResourcesTree(appName, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{{ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Name: "my-pod"}}}}); err != nil {
+			return err
+		}
+		return appstateCache.SetAppManagedResources(appName, []*v1alpha1.ResourceDiff{{Kind: "Deployment", Name: "my-deploy"}})
+	}
+
+	// the state of an application is computed on the first read
+	var tree v1alpha1.ApplicationTree
+	require.NoError(t, appstateCache.GetAppResourcesTree("argocd_my-app", &tree))
+	require.Len(t, tree.Nodes, 1)
+	assert.Equal(t, "my-pod", tree.Nodes[0].Name)
+	var managedResources []*v1alpha1.ResourceDiff
+	require.NoError(t, appstateCache.GetAppManagedResources("argocd_my-app", &managedResources))
+	require.Len(t, managedResources, 1)
+	assert.Equal(t, "my-deploy", managedResources[0].Name)
+	assert.Equal(t, []string{"argocd_my-app"}, refreshed)
+
+	err := appstateCache.GetAppResourcesTree("broken-app", &tree)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cluster is unreachable")
+
+	// the history recorded by the application controller is not available
+	var records []*v1alpha1.SyncHistoryRecord
+	err = appstateCache.GetAppSyncHistory("argocd_my-app", &records)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "sync history")
+	assert.NotErrorIs(t, err, servercache.ErrCacheMiss)
+
+	// the state which is not computed by the application controller is kept in memory
+	require.NoError(t, appstateCache.SetClusterInfo("https://kubernetes.default.svc", &v1alpha1.ClusterInfo{ServerVersion: "1.30"}))
+	var info v1alpha1.ClusterInfo
+	require.NoError(t, appstateCache.GetClusterInfo("https://kubernetes.default.svc", &info))
+	assert.Equal(t, "1.30", info.ServerVersion)
+	assert.Equal(t, []string{"argocd_my-app", "broken-app"}, refreshed)
+}
+
+func TestStartLocalRepoServer(t *testing.T) {
+	repoPath := t.TempDir()
+	for _, args := range [][]string{{"init", "-b", "main", repoPath}, {"-C", repoPath, "commit", "--allow-empty", "-m", "Initial commit"}, {"-C", repoPath, "tag", "v1.0.0"}} {
+		require.NoError(t, exec.Command("git", args...).Run())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clientset, err := startLocalRepoServer(ctx)
+	require.NoError(t, err)
+	closer, client, err := clientset.NewRepoServerClient()
+	require.NoError(t, err)
+	defer io.Close(closer)
+
+	refs, err := client.ListRefs(ctx, &repoapiclient.ListRefsRequest{Repo: &v1alpha1.Repository{Repo: fmt.Sprintf("file://%s", filepath.ToSlash(repoPath))}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main"}, refs.Branches)
+	assert.Equal(t, []string{"v1.0.0"}, refs.Tags)
+}
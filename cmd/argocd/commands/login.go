@@ -55,12 +55,18 @@ argocd login cd.argoproj.io
 argocd login cd.argoproj.io --sso
 
 # Configure direct access using Kubernetes API server
-argocd login cd.argoproj.io --core`,
+argocd login cd.argoproj.io --core
+
+# Configure direct access using Kubernetes API server only, without relying on Argo CD repo server and Redis
+argocd login cd.argoproj.io --core-local`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
 			var server string
 
+			// local core mode is a flavor of core mode
+			globalClientOpts.Core = globalClientOpts.Core || globalClientOpts.CoreLocal
+
 			if len(args) != 1 && !globalClientOpts.PortForward && !globalClientOpts.Core {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
@@ -157,6 +163,7 @@ argocd login cd.argoproj.io --core`,
 				GRPCWeb:         globalClientOpts.GRPCWeb,
 				GRPCWebRootPath: globalClientOpts.GRPCWebRootPath,
 				Core:            globalClientOpts.Core,
+				CoreLocal:       globalClientOpts.CoreLocal,
 			})
 			localCfg.UpsertUser(localconfig.User{
 				Name:         ctxName,
@@ -156,6 +156,7 @@ type ClusterOptions struct {
 	SystemNamespace         string
 	Namespaces              []string
 	ClusterResources        bool
+	AutoNamespaces          bool
 	Name                    string
 	Project                 string
 	Shard                   int64
@@ -182,6 +183,7 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.AwsProfile, "aws-profile", "", "Optional AWS profile. If set then AWS IAM Authenticator uses this profile to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringArrayVar(&opts.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
 	command.Flags().BoolVar(&opts.ClusterResources, "cluster-resources", false, "Indicates if cluster level resources should be managed. The setting is used only if list of managed namespaces is not empty.")
+	command.Flags().BoolVar(&opts.AutoNamespaces, "auto-namespaces", false, "Indicates if the managed namespaces should be inferred from the destinations and resources of the applications deployed to the cluster. Cannot be combined with a list of managed namespaces.")
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringVar(&opts.Project, "project", "", "project of the cluster")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
@@ -133,6 +133,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// ResourceCostShardingAlgorithm distributes the clusters across the shards according to the size of their live state
+	// cache, i.e. the number of resources and APIs watched by the controller, and periodically rebalances them when the
+	// loads of the shards drift apart.
+	ResourceCostShardingAlgorithm = "resource-cost"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -204,6 +209,18 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationSyncWaveTimeout makes the sync wait for the resources of a sync wave to become ready, at most for the
+	// given time before failing the sync. The largest timeout of the resources of a wave applies to the whole wave.
+	AnnotationSyncWaveTimeout = "argocd.argoproj.io/sync-wave-timeout"
+	// LabelKeyManagedNamespaceTemplate is the label of the resources created from the managed namespace templates of a
+	// project. The resources belong to their namespace rather than to an application.
+	LabelKeyManagedNamespaceTemplate = "argocd.argoproj.io/managed-namespace-template"
+	// LabelKeyManagedNamespaceTemplatesHash is the label of the namespaces holding the hash of the resources created
+	// from the managed namespace templates
+	LabelKeyManagedNamespaceTemplatesHash = "argocd.argoproj.io/managed-namespace-templates-hash"
+	// AnnotationKeyManagedNamespaceTemplates is the annotation of the namespaces listing the resources created from the
+	// managed namespace templates, so that the resources of the removed templates are deleted
+	AnnotationKeyManagedNamespaceTemplates = "argocd.argoproj.io/managed-namespace-templates"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -244,6 +261,8 @@ const (
 	EnvGnuPGHome = "ARGOCD_GNUPGHOME"
 	// EnvWatchAPIBufferSize is the buffer size used to transfer K8S watch events to watch API consumer
 	EnvWatchAPIBufferSize = "ARGOCD_WATCH_API_BUFFER_SIZE"
+	// EnvBulkOperationMaxConcurrency is the maximum number of applications processed in parallel by a bulk operation
+	EnvBulkOperationMaxConcurrency = "ARGOCD_BULK_OPERATION_MAX_CONCURRENCY"
 	// EnvPauseGenerationAfterFailedAttempts will pause manifest generation after the specified number of failed generation attempts
 	EnvPauseGenerationAfterFailedAttempts = "ARGOCD_PAUSE_GEN_AFTER_FAILED_ATTEMPTS"
 	// EnvPauseGenerationMinutes pauses manifest generation for the specified number of minutes, after sufficient manifest generation failures
@@ -258,6 +277,10 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingImbalanceThreshold is the relative imbalance between the shard loads above which the resource-cost sharding algorithm rebalances the clusters
+	EnvControllerShardingImbalanceThreshold = "ARGOCD_CONTROLLER_SHARDING_IMBALANCE_THRESHOLD"
+	// EnvControllerShardingRebalanceInterval is the interval at which the resource-cost sharding algorithm evaluates the shard loads
+	EnvControllerShardingRebalanceInterval = "ARGOCD_CONTROLLER_SHARDING_REBALANCE_INTERVAL"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/client-go/informers"
 	informerv1 "k8s.io/client-go/informers/apps/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -67,6 +69,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	settings_util "github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/stats"
 )
 
 const (
@@ -119,27 +122,34 @@ type ApplicationController struct {
 	// queue contains app namespace/name/comparisonType and used to request app refresh with the predefined comparison type
 	appComparisonTypeRefreshQueue workqueue.RateLimitingInterface
 	appOperationQueue             workqueue.RateLimitingInterface
-	projectRefreshQueue           workqueue.RateLimitingInterface
-	appInformer                   cache.SharedIndexInformer
-	appLister                     applisters.ApplicationLister
-	projInformer                  cache.SharedIndexInformer
-	appStateManager               AppStateManager
-	stateCache                    statecache.LiveStateCache
-	statusRefreshTimeout          time.Duration
-	statusHardRefreshTimeout      time.Duration
-	statusRefreshJitter           time.Duration
-	selfHealTimeout               time.Duration
-	repoClientset                 apiclient.Clientset
-	db                            db.ArgoDB
-	settingsMgr                   *settings_util.SettingsManager
-	refreshRequestedApps          map[string]CompareWith
-	refreshRequestedAppsMutex     *sync.Mutex
-	metricsServer                 *metrics.MetricsServer
-	kubectlSemaphore              *semaphore.Weighted
-	clusterSharding               sharding.ClusterShardingCache
-	projByNameCache               sync.Map
-	applicationNamespaces         []string
-	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
+	// appOperationScheduler schedules the operations taken from appOperationQueue across projects
+	appOperationScheduler     *operationScheduler
+	projectRefreshQueue       workqueue.RateLimitingInterface
+	appInformer               cache.SharedIndexInformer
+	appLister                 applisters.ApplicationLister
+	projInformer              cache.SharedIndexInformer
+	appStateManager           AppStateManager
+	stateCache                statecache.LiveStateCache
+	statusRefreshTimeout      time.Duration
+	statusHardRefreshTimeout  time.Duration
+	statusRefreshJitter       time.Duration
+	selfHealTimeout           time.Duration
+	repoClientset             apiclient.Clientset
+	db                        db.ArgoDB
+	settingsMgr               *settings_util.SettingsManager
+	refreshRequestedApps      map[string]CompareWith
+	refreshRequestedAppsMutex *sync.Mutex
+	metricsServer             *metrics.MetricsServer
+	kubectlSemaphore          *semaphore.Weighted
+	clusterSharding           sharding.ClusterShardingCache
+	projByNameCache           sync.Map
+	applicationNamespaces     []string
+	ignoreNormalizerOpts      normalizers.IgnoreNormalizerOpts
+	reconcileProfiler         *reconcileProfiler
+	// orphanedResourcesCleanupReports contains the orphaned resources last reported by the dry run cleanup of each app
+	orphanedResourcesCleanupReports sync.Map
+	// orphanedResourcesCleanupTimes contains the time of the last cleanup of the orphaned resources of each app
+	orphanedResourcesCleanupTimes sync.Map
 
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
@@ -203,6 +213,7 @@ func NewApplicationController(
 		applicationNamespaces:             applicationNamespaces,
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
+		reconcileProfiler:                 newReconcileProfiler(reconcileProfileHistory),
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -288,6 +299,9 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
+	ctrl.appOperationScheduler = newOperationScheduler(func(project string, priority appv1.OperationPriorityClass, wait time.Duration) {
+		ctrl.metricsServer.ObserveOperationQueueWait(project, string(priority), wait)
+	})
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
@@ -450,6 +464,36 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 	}
 }
 
+// getComparedSources returns the sources of the application and the revisions its live state is compared with at the
+// given comparison level
+func getComparedSources(app *appv1.Application, comparisonLevel CompareWith) ([]appv1.ApplicationSource, []string) {
+	revisions := make([]string, 0)
+	sources := make([]appv1.ApplicationSource, 0)
+
+	// If we have multiple sources, we use all the sources under `sources` field and ignore source under `source` field.
+	// else we use the source under the source field.
+	if app.Spec.HasMultipleSources() {
+		for _, source := range app.Spec.Sources {
+			// We do not perform any filtering of duplicate sources.
+			// Argo CD will apply and update the resources generated from the sources automatically
+			// based on the order in which manifests were generated
+			sources = append(sources, source)
+			revisions = append(revisions, source.TargetRevision)
+		}
+		if comparisonLevel == CompareWithRecent {
+			revisions = app.Status.Sync.Revisions
+		}
+	} else {
+		revision := app.Spec.GetSource().TargetRevision
+		if comparisonLevel == CompareWithRecent {
+			revision = app.Status.Sync.Revision
+		}
+		revisions = append(revisions, revision)
+		sources = append(sources, app.Spec.GetSource())
+	}
+	return sources, revisions
+}
+
 // setAppManagedResources will build a list of ResourceDiff based on the provided comparisonResult
 // and persist app resources related data in the cache. Will return the persisted ApplicationTree.
 func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application, comparisonResult *comparisonResult) (*appv1.ApplicationTree, error) {
@@ -469,6 +513,12 @@ func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application,
 	if err != nil {
 		return nil, fmt.Errorf("error setting app managed resources: %w", err)
 	}
+	// the inputs of the comparison are kept along with the managed resources so that the diffs can be reused after a
+	// restart of the controller
+	err = ctrl.cache.SetAppComparisonInputs(a.InstanceName(ctrl.namespace), comparisonResult.comparisonInputs)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app comparison inputs: %w", err)
+	}
 	return tree, nil
 }
 
@@ -746,8 +796,9 @@ func (ctrl *ApplicationController) hideSecretData(app *appv1.Application, compar
 			if err != nil {
 				return nil, fmt.Errorf("error getting cluster cache: %w", err)
 			}
+			ignoreDifferences := append(slices.Clone(app.Spec.IgnoreDifferences), childAppPauseIgnoreDifferences)
 			diffConfig, err := argodiff.NewDiffConfigBuilder().
-				WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
+				WithDiffSettings(ignoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
 				WithTracking(appLabelKey, trackingMethod).
 				WithNoCache().
 				WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
@@ -798,6 +849,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.appRefreshQueue.ShutDown()
 	defer ctrl.appComparisonTypeRefreshQueue.ShutDown()
 	defer ctrl.appOperationQueue.ShutDown()
+	defer ctrl.appOperationScheduler.ShutDown()
 	defer ctrl.projectRefreshQueue.ShutDown()
 
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache)
@@ -833,6 +885,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.clusterSharding.RunRebalancing(ctx, ctrl.cache)
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -841,9 +894,14 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}, time.Second, ctx.Done())
 	}
 
+	go wait.Until(func() {
+		for ctrl.processAppOperationQueueItem() {
+		}
+	}, time.Second, ctx.Done())
+
 	for i := 0; i < operationProcessors; i++ {
 		go wait.Until(func() {
-			for ctrl.processAppOperationQueueItem() {
+			for ctrl.processScheduledAppOperation() {
 			}
 		}, time.Second, ctx.Done())
 	}
@@ -893,6 +951,9 @@ func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, Com
 	return ok, level
 }
 
+// processAppOperationQueueItem takes an application from the operation queue and schedules its operation, if it has
+// one, according to the operations policy of its project. The key stays in progress in the operation queue until the
+// operation is processed by processScheduledAppOperation.
 func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext bool) {
 	appKey, shutdown := ctrl.appOperationQueue.Get()
 	if shutdown {
@@ -900,25 +961,80 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		return
 	}
 	processNext = true
+	scheduled := false
 	defer func() {
 		if r := recover(); r != nil {
 			log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
 		}
-		ctrl.appOperationQueue.Done(appKey)
+		if !scheduled {
+			ctrl.appOperationQueue.Done(appKey)
+		}
 	}()
 
-	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey.(string))
+	app, ok := ctrl.getAppFromOperationQueueKey(appKey.(string))
+	if !ok || (app.Operation == nil && app.DeletionTimestamp == nil) {
+		// the operation might have been removed while in progress
+		ctrl.appOperationScheduler.Done(appKey.(string), false)
+		return
+	}
+	var policy *appv1.ProjectOperationsPolicy
+	if proj, err := ctrl.getAppProj(app); err == nil {
+		policy = proj.Spec.Operations
+	}
+	ctrl.appOperationScheduler.Add(appKey.(string), app.Spec.GetProject(), policy, isAppOperationInProgress(app))
+	scheduled = true
+	return
+}
+
+// getAppFromOperationQueueKey returns the application with the given key from the informer
+func (ctrl *ApplicationController) getAppFromOperationQueueKey(appKey string) (*appv1.Application, bool) {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
 	if err != nil {
 		log.Errorf("Failed to get application '%s' from informer index: %+v", appKey, err)
-		return
+		return nil, false
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
-		return
+		return nil, false
 	}
-	origApp, ok := obj.(*appv1.Application)
+	app, ok := obj.(*appv1.Application)
 	if !ok {
 		log.Warnf("Key '%s' in index is not an application", appKey)
+		return nil, false
+	}
+	return app, true
+}
+
+// isAppOperationInProgress returns true if the operation of the application is started and not completed yet
+func isAppOperationInProgress(app *appv1.Application) bool {
+	return app.Operation != nil && app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
+}
+
+// processScheduledAppOperation processes the next operation scheduled by the operation scheduler. The operation counts
+// against the maximum number of concurrent operations of its project until it is completed, which might take many
+// iterations.
+func (ctrl *ApplicationController) processScheduledAppOperation() (processNext bool) {
+	appKey, shutdown := ctrl.appOperationScheduler.Get()
+	if shutdown {
+		processNext = false
+		return
+	}
+	processNext = true
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+		}
+		// the informer holds the operation state written back by the processing
+		inProgress := false
+		if app, ok := ctrl.getAppFromOperationQueueKey(appKey); ok {
+			inProgress = isAppOperationInProgress(app)
+		}
+		ctrl.appOperationScheduler.Done(appKey, inProgress)
+		ctrl.appOperationQueue.Done(appKey)
+	}()
+
+	origApp, ok := ctrl.getAppFromOperationQueueKey(appKey)
+	if !ok {
 		return
 	}
 	app := origApp.DeepCopy()
@@ -936,9 +1052,13 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	}
 
 	if app.Operation != nil {
+		if app.Spec.Pause.IsActive(time.Now()) {
+			getAppLog(app).Infof("Postponing operation: %s", app.Spec.Pause.Message())
+			return
+		}
 		ctrl.processRequestedAppOperation(app)
 	} else if app.DeletionTimestamp != nil {
-		if err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*appv1.Cluster, error) {
+		if err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*appv1.Cluster, error) {
 			return ctrl.db.GetProjectClusters(context.Background(), project)
 		}); err != nil {
 			ctrl.setAppCondition(app, appv1.ApplicationCondition{
@@ -1109,6 +1229,8 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	isValid, cluster := ctrl.isValidDestination(app)
 	if !isValid {
 		app.UnSetCascadedDeletion()
+		app.UnSetPreDeleteFinalizer()
+		app.UnSetPreDeleteFinalizer("cleanup")
 		app.UnSetPostDeleteFinalizer()
 		if err := ctrl.updateFinalizers(app); err != nil {
 			return err
@@ -1117,6 +1239,50 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return nil
 	}
 	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, cluster.RESTConfig())
+	// the resources and the delete hooks are deleted and created with the same privileges as the syncs
+	serviceAccount, err := getServiceAccountToImpersonate(ctrl.settingsMgr, app, proj, cluster.Server)
+	if err != nil {
+		return err
+	}
+	if serviceAccount != "" {
+		logCtx = logCtx.WithField("impersonatedServiceAccount", serviceAccount)
+		config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+
+	// pre-delete hooks have to complete before any resource of the application gets deleted
+	if app.HasPreDeleteFinalizer() {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
+		if err != nil {
+			return err
+		}
+
+		done, err := ctrl.executeDeleteHooks(preDeleteHookType, app, proj, objsMap, config, logCtx)
+		if err != nil {
+			return err
+		}
+		if !done {
+			return nil
+		}
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPreDeleteFinalizer("cleanup") {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(app, proj, projectClusters)
+		if err != nil {
+			return err
+		}
+
+		done, err := ctrl.cleanupDeleteHooks(preDeleteHookType, objsMap, config, logCtx)
+		if err != nil {
+			return err
+		}
+		if !done {
+			return nil
+		}
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
 
 	if app.CascadedDeletion() {
 		logCtx.Infof("Deleting resources")
@@ -1180,7 +1346,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
-		done, err := ctrl.executePostDeleteHooks(app, proj, objsMap, config, logCtx)
+		done, err := ctrl.executeDeleteHooks(postDeleteHookType, app, proj, objsMap, config, logCtx)
 		if err != nil {
 			return err
 		}
@@ -1197,7 +1363,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
-		done, err := ctrl.cleanupPostDeleteHooks(objsMap, config, logCtx)
+		done, err := ctrl.cleanupDeleteHooks(postDeleteHookType, objsMap, config, logCtx)
 		if err != nil {
 			return err
 		}
@@ -1280,6 +1446,15 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			ctrl.setOperationState(app, state)
 		}
 	}()
+	// The operation is postponed while the destination cluster is unreachable, unless it is being terminated. The
+	// application is requeued once the cluster responds.
+	if app.Status.OperationState == nil || app.Status.OperationState.Phase != synccommon.OperationTerminating {
+		if unreachableCondition := ctrl.getClusterUnreachableCondition(app); unreachableCondition != nil {
+			logCtx.Infof("Postponing operation: %s", unreachableCondition.Message)
+			ctrl.setAppCondition(app, *unreachableCondition)
+			return
+		}
+	}
 	terminating := false
 	if isOperationInProgress(app) {
 		state = app.Status.OperationState.DeepCopy()
@@ -1310,16 +1485,24 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
 		}
 	} else {
+		// The operation is postponed until the application dependencies are satisfied. The application is requeued
+		// once one of its dependencies is updated.
+		if dependenciesCondition := ctrl.getDependenciesCondition(app); dependenciesCondition != nil {
+			logCtx.Infof("Postponing operation: %s", dependenciesCondition.Message)
+			ctrl.setAppCondition(app, *dependenciesCondition)
+			return
+		}
 		state = &appv1.OperationState{Phase: synccommon.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
 		ctrl.setOperationState(app, state)
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 
+	var requeueAfter time.Duration
 	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
 	} else {
-		ctrl.appStateManager.SyncAppState(app, state)
+		requeueAfter = ctrl.appStateManager.SyncAppState(app, state)
 	}
 
 	// Check whether application is allowed to use project
@@ -1366,6 +1549,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 
 	ctrl.setOperationState(app, state)
+	if state.Phase == synccommon.OperationRunning && requeueAfter > 0 {
+		// the operation waits for its resources, it is resumed later on
+		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), requeueAfter)
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1451,6 +1638,7 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 		}
 		ctrl.logAppEvent(app, eventInfo, strings.Join(messages, " "), context.TODO())
 		ctrl.metricsServer.IncSync(app, state)
+		ctrl.recordSyncHistory(app, state, logCtx)
 	}
 }
 
@@ -1506,6 +1694,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	origApp = origApp.DeepCopy()
 
+	if ctrl.isReconcilePaused(origApp) {
+		return
+	}
+
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
@@ -1520,6 +1712,8 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	})
 
 	startTime := time.Now()
+	ts := stats.NewTimingStats()
+	var compareTimings map[string]time.Duration
 	defer func() {
 		reconcileDuration := time.Since(startTime)
 		ctrl.metricsServer.IncReconcile(origApp, reconcileDuration)
@@ -1528,6 +1722,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			"patch_ms": patchMs.Milliseconds(),
 			"setop_ms": setOpMs.Milliseconds(),
 		}).Info("Reconciliation completed")
+		timings := ts.Timings()
+		for k, v := range compareTimings {
+			timings[k] = v
+		}
+		ctrl.recordReconcileProfile(origApp, startTime, reconcileDuration, comparisonLevel, timings, logCtx)
 	}()
 
 	if comparisonLevel == ComparisonWithNothing {
@@ -1538,22 +1737,27 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			var tree *appv1.ApplicationTree
 			if tree, err = ctrl.getResourceTree(app, managedResources); err == nil {
 				app.Status.Summary = tree.GetSummary(app)
+				ctrl.aggregateChildApplicationsStatus(app, app.Status.Resources)
 				if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), tree); err != nil {
 					logCtx.Errorf("Failed to cache resources tree: %v", err)
 					return
 				}
 			}
+			ts.AddCheckpoint("tree_ms")
 
 			patchMs = ctrl.persistAppStatus(origApp, &app.Status)
+			ts.AddCheckpoint("persist_ms")
 			return
 		}
 	}
 
 	project, hasErrors := ctrl.refreshAppConditions(app)
+	ts.AddCheckpoint("conditions_ms")
 	if hasErrors {
 		app.Status.Sync.Status = appv1.SyncStatusCodeUnknown
 		app.Status.Health.Status = health.HealthStatusUnknown
 		patchMs = ctrl.persistAppStatus(origApp, &app.Status)
+		ts.AddCheckpoint("persist_ms")
 
 		if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), &appv1.ApplicationTree{}); err != nil {
 			logCtx.Warnf("failed to set app resource tree: %v", err)
@@ -1561,6 +1765,18 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		if err := ctrl.cache.SetAppManagedResources(app.InstanceName(ctrl.namespace), nil); err != nil {
 			logCtx.Warnf("failed to set app managed resources tree: %v", err)
 		}
+		if err := ctrl.cache.SetAppComparisonInputs(app.InstanceName(ctrl.namespace), nil); err != nil {
+			logCtx.Warnf("failed to set app comparison inputs: %v", err)
+		}
+		return
+	}
+
+	// comparing the application would only time out while its destination cluster is unreachable: the last known
+	// state is kept along with the ClusterUnreachable condition. The application is refreshed once the cluster responds.
+	if hasClusterUnreachableCondition(app) {
+		logCtx.Info("Skipping comparison, destination cluster is unreachable")
+		patchMs = ctrl.persistAppStatus(origApp, &app.Status)
+		ts.AddCheckpoint("persist_ms")
 		return
 	}
 
@@ -1569,32 +1785,8 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		localManifests = opState.Operation.Sync.Manifests
 	}
 
-	revisions := make([]string, 0)
-	sources := make([]appv1.ApplicationSource, 0)
-
 	hasMultipleSources := app.Spec.HasMultipleSources()
-
-	// If we have multiple sources, we use all the sources under `sources` field and ignore source under `source` field.
-	// else we use the source under the source field.
-	if hasMultipleSources {
-		for _, source := range app.Spec.Sources {
-			// We do not perform any filtering of duplicate sources.
-			// Argo CD will apply and update the resources generated from the sources automatically
-			// based on the order in which manifests were generated
-			sources = append(sources, source)
-			revisions = append(revisions, source.TargetRevision)
-		}
-		if comparisonLevel == CompareWithRecent {
-			revisions = app.Status.Sync.Revisions
-		}
-	} else {
-		revision := app.Spec.GetSource().TargetRevision
-		if comparisonLevel == CompareWithRecent {
-			revision = app.Status.Sync.Revision
-		}
-		revisions = append(revisions, revision)
-		sources = append(sources, app.Spec.GetSource())
-	}
+	sources, revisions := getComparedSources(app, comparisonLevel)
 	now := metav1.Now()
 
 	logCtx.Infof("Comparing app state (comparisonLevel: %v, revisions: %v)", comparisonLevel, revisions)
@@ -1602,11 +1794,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	compareResult, err := ctrl.appStateManager.CompareAppState(app, project, revisions, sources,
 		refreshType == appv1.RefreshTypeHard,
 		comparisonLevel == CompareWithLatestForceResolve, localManifests, hasMultipleSources, false)
+	ts.AddCheckpoint("compare_ms")
 
 	if goerrors.Is(err, CompareStateRepoError) {
 		logCtx.Warnf("Ignoring temporary failed attempt to compare app state against repo: %v", err)
 		return // short circuit if git error is encountered
 	}
+	compareTimings = compareResult.timings
 
 	for k, v := range compareResult.timings {
 		logCtx = logCtx.WithField(k, v.Milliseconds())
@@ -1620,24 +1814,50 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	} else {
 		app.Status.Summary = tree.GetSummary(app)
 	}
+	ts.AddCheckpoint("tree_ms")
+
+	// the time of the last change of the health status is kept so that the time spent degraded is known
+	if compareResult.healthStatus.Status == app.Status.Health.Status && app.Status.Health.LastTransitionTime != nil {
+		compareResult.healthStatus.LastTransitionTime = app.Status.Health.LastTransitionTime
+	} else {
+		compareResult.healthStatus.LastTransitionTime = &now
+	}
 
 	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
-		setOpMs = opMS
-		if syncErrCond != nil {
+		if rollbackCond, opMS := ctrl.autoRollback(app, compareResult.healthStatus); rollbackCond != nil {
+			setOpMs = opMS
 			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				[]appv1.ApplicationCondition{*rollbackCond},
+				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
 			)
 		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			if !isAutoSyncPausedByRollback(app) {
+				app.Status.SetConditions(
+					[]appv1.ApplicationCondition{},
+					map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
+				)
+			}
+			syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
+			setOpMs = opMS
+			if syncErrCond != nil {
+				app.Status.SetConditions(
+					[]appv1.ApplicationCondition{*syncErrCond},
+					map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				)
+			} else {
+				app.Status.SetConditions(
+					[]appv1.ApplicationCondition{},
+					map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				)
+			}
+		}
+		if tree != nil {
+			ctrl.cleanupOrphanedResources(app, project, tree, logCtx)
 		}
 	} else {
 		logCtx.Info("Sync prevented by sync window")
 	}
+	ts.AddCheckpoint("auto_sync_ms")
 
 	if app.Status.ReconciledAt == nil || comparisonLevel >= CompareWithLatest {
 		app.Status.ReconciledAt = &now
@@ -1651,9 +1871,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	app.Status.SourceType = compareResult.appSourceType
 	app.Status.SourceTypes = compareResult.appSourceTypes
 	app.Status.ControllerNamespace = ctrl.namespace
+	ctrl.aggregateChildApplicationsStatus(app, app.Status.Resources)
+	markRevisionHistoryHealthy(app)
 	patchMs = ctrl.persistAppStatus(origApp, &app.Status)
-	if (compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
+	ts.AddCheckpoint("persist_ms")
+	if (compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer() || compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer("cleanup") ||
+		compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
 		app.GetDeletionTimestamp() == nil {
+		if compareResult.hasPreDeleteHooks {
+			app.SetPreDeleteFinalizer()
+			app.SetPreDeleteFinalizer("cleanup")
+		} else {
+			app.UnSetPreDeleteFinalizer()
+			app.UnSetPreDeleteFinalizer("cleanup")
+		}
 		if compareResult.hasPostDeleteHooks {
 			app.SetPostDeleteFinalizer()
 			app.SetPostDeleteFinalizer("cleanup")
@@ -1753,9 +1984,23 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 			errorConditions = append(errorConditions, specConditions...)
 		}
 	}
-	app.Status.SetConditions(errorConditions, map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError: true,
-		appv1.ApplicationConditionUnknownError:     true,
+	conditions := errorConditions
+	if dependenciesCondition := ctrl.getDependenciesCondition(app); dependenciesCondition != nil {
+		if dependenciesCondition.IsError() {
+			errorConditions = append(errorConditions, *dependenciesCondition)
+		}
+		conditions = append(conditions, *dependenciesCondition)
+	}
+	if unreachableCondition := ctrl.getClusterUnreachableCondition(app); unreachableCondition != nil {
+		conditions = append(conditions, *unreachableCondition)
+	}
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionInvalidSpecError:   true,
+		appv1.ApplicationConditionUnknownError:       true,
+		appv1.ApplicationConditionDependencyWarning:  true,
+		appv1.ApplicationConditionClusterUnreachable: true,
+		// the reconciliation is not paused anymore if we get here
+		appv1.ApplicationConditionReconcilePausedWarning: true,
 	})
 	return proj, len(errorConditions) > 0
 }
@@ -1862,6 +2107,16 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if dependenciesCondition := ctrl.getDependenciesCondition(app); dependenciesCondition != nil {
+		logCtx.Infof("Skipping auto-sync: %s", dependenciesCondition.Message)
+		return nil, 0
+	}
+
+	if isAutoSyncPausedByRollback(app) {
+		logCtx.Infof("Skipping auto-sync: paused after an automated rollback until the application is synced manually")
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -2153,6 +2408,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: dependsOnIndexFunc,
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2170,6 +2426,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				newApp, newOK := obj.(*appv1.Application)
 				if err == nil && newOK {
 					ctrl.clusterSharding.AddApp(newApp)
+					ctrl.requestDependentAppsRefresh(newApp)
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
@@ -2202,6 +2459,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
 				ctrl.appOperationQueue.AddRateLimited(key)
 				ctrl.clusterSharding.UpdateApp(newApp)
+				if oldOK && newOK && (oldApp.Status.Health.Status != newApp.Status.Health.Status || oldApp.Status.Sync.Status != newApp.Status.Sync.Status) {
+					ctrl.requestDependentAppsRefresh(newApp)
+				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				if !ctrl.canProcessApp(obj) {
@@ -2217,6 +2477,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				delApp, delOK := obj.(*appv1.Application)
 				if err == nil && delOK {
 					ctrl.clusterSharding.DeleteApp(delApp)
+					ctrl.reconcileProfiler.forget(delApp.InstanceName(ctrl.namespace))
+					ctrl.appStateManager.ForgetApp(delApp)
+					ctrl.requestDependentAppsRefresh(delApp)
 				}
 			},
 		},
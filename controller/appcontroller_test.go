@@ -64,6 +64,8 @@ type fakeData struct {
 	metricsCacheExpiration         time.Duration
 	applicationNamespaces          []string
 	updateRevisionForPathsResponse *apiclient.UpdateRevisionForPathsResponse
+	clusterCircuitBreaker          *v1alpha1.ClusterCircuitBreaker
+	clusterResources               []*clustercache.Resource
 }
 
 type MockKubectl struct {
@@ -71,15 +73,33 @@ type MockKubectl struct {
 
 	DeletedResources []kube.ResourceKey
 	CreatedResources []*unstructured.Unstructured
+	// ImpersonatedUsers holds the user impersonated to create, delete or get each resource
+	ImpersonatedUsers []string
+	// Resources are the resources returned by GetResource
+	Resources map[kube.ResourceKey]*unstructured.Unstructured
+}
+
+func (m *MockKubectl) GetResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	if m.Resources == nil {
+		return m.Kubectl.GetResource(ctx, config, gvk, name, namespace)
+	}
+	m.ImpersonatedUsers = append(m.ImpersonatedUsers, config.Impersonate.UserName)
+	res, ok := m.Resources[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)]
+	if !ok {
+		return nil, apierr.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, name)
+	}
+	return res, nil
 }
 
 func (m *MockKubectl) CreateResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, obj *unstructured.Unstructured, createOptions metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
 	m.CreatedResources = append(m.CreatedResources, obj)
+	m.ImpersonatedUsers = append(m.ImpersonatedUsers, config.Impersonate.UserName)
 	return m.Kubectl.CreateResource(ctx, config, gvk, name, namespace, obj, createOptions, subresources...)
 }
 
 func (m *MockKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
 	m.DeletedResources = append(m.DeletedResources, kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name))
+	m.ImpersonatedUsers = append(m.ImpersonatedUsers, config.Impersonate.UserName)
 	return m.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
 }
 
@@ -179,6 +199,22 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 	clusterCacheMock.On("IsNamespaced", mock.Anything).Return(true, nil)
 	clusterCacheMock.On("GetOpenAPISchema").Return(nil, nil)
 	clusterCacheMock.On("GetGVKParser").Return(nil)
+	clusterCacheMock.On("FindResources", mock.Anything, mock.Anything).Return(func(namespace string, predicates ...func(*clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		resources := map[kube.ResourceKey]*clustercache.Resource{}
+		for _, res := range data.clusterResources {
+			if res.Ref.Namespace != namespace {
+				continue
+			}
+			matches := true
+			for _, predicate := range predicates {
+				matches = matches && predicate(res)
+			}
+			if matches {
+				resources[res.ResourceKey()] = res
+			}
+		}
+		return resources
+	})
 
 	mockStateCache := mockstatecache.LiveStateCache{}
 	ctrl.appStateManager.(*appStateManager).liveStateCache = &mockStateCache
@@ -193,6 +229,11 @@ func newFakeController(data *fakeData, repoErr error) *ApplicationController {
 	mockStateCache.On("GetNamespaceTopLevelResources", mock.Anything, mock.Anything).Return(response, nil)
 	mockStateCache.On("IterateResources", mock.Anything, mock.Anything).Return(nil)
 	mockStateCache.On("GetClusterCache", mock.Anything).Return(&clusterCacheMock, nil)
+	clusterCircuitBreaker := v1alpha1.ClusterCircuitBreaker{State: v1alpha1.ClusterCircuitBreakerStateClosed}
+	if data.clusterCircuitBreaker != nil {
+		clusterCircuitBreaker = *data.clusterCircuitBreaker
+	}
+	mockStateCache.On("GetClusterCircuitBreaker", mock.Anything).Return(clusterCircuitBreaker)
 	mockStateCache.On("IterateHierarchy", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		key := args[1].(kube.ResourceKey)
 		action := args[2].(func(child v1alpha1.ResourceNode, appName string) bool)
@@ -411,6 +452,45 @@ var fakePostDeleteHook = `
 }
 `
 
+var fakePreDeleteHook = `
+{
+  "apiVersion": "batch/v1",
+  "kind": "Job",
+  "metadata": {
+    "name": "pre-delete-hook",
+    "namespace": "default",
+    "labels": {
+      "app.kubernetes.io/instance": "my-app"
+    },
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreDelete",
+      "argocd.argoproj.io/hook-delete-policy": "HookSucceeded"
+    }
+  },
+  "spec": {
+    "template": {
+      "metadata": {
+        "name": "pre-delete-hook"
+      },
+      "spec": {
+        "containers": [
+          {
+            "name": "pre-delete-hook",
+            "image": "busybox",
+            "command": [
+              "/bin/sh",
+              "-c",
+              "sleep 5 && echo hello from the pre-delete-hook job"
+            ]
+          }
+        ],
+        "restartPolicy": "Never"
+      }
+    }
+  }
+}
+`
+
 var fakeServiceAccount = `
 {
   "apiVersion": "v1",
@@ -509,6 +589,15 @@ func newFakeCM() map[string]interface{} {
 	return cm
 }
 
+func newFakePreDeleteHook() map[string]interface{} {
+	var hook map[string]interface{}
+	err := yaml.Unmarshal([]byte(fakePreDeleteHook), &hook)
+	if err != nil {
+		panic(err)
+	}
+	return hook
+}
+
 func newFakePostDeleteHook() map[string]interface{} {
 	var hook map[string]interface{}
 	err := yaml.Unmarshal([]byte(fakePostDeleteHook), &hook)
@@ -817,6 +906,45 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		assert.True(t, patched)
 	})
 
+	t.Run("CascadingDeleteWithImpersonation", func(t *testing.T) {
+		impersonationProj := defaultProj.DeepCopy()
+		impersonationProj.Spec.DestinationServiceAccounts = []v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: test.FakeDestNamespace, DefaultServiceAccount: "deployer"}}
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		cm := test.NewConfigMap()
+		cm.SetNamespace(test.FakeDestNamespace)
+		cm.SetLabels(map[string]string{common.LabelKeyAppInstance: app.Name})
+		newController := func(proj *v1alpha1.AppProject) *ApplicationController {
+			ctrl := newFakeController(&fakeData{
+				apps:            []runtime.Object{app, proj},
+				managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(cm): cm},
+				configMapData:   map[string]string{"application.sync.impersonation.enabled": "true"},
+			}, nil)
+			fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+			fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, &v1alpha1.Application{}, nil
+			})
+			return ctrl
+		}
+
+		ctrl := newController(impersonationProj)
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		kubectl := ctrl.kubectl.(*MockKubectl)
+		require.Len(t, kubectl.DeletedResources, 1)
+		assert.Equal(t, []string{"system:serviceaccount:" + test.FakeDestNamespace + ":deployer"}, kubectl.ImpersonatedUsers)
+
+		// nothing is deleted without a service account to impersonate
+		ctrl = newController(defaultProj.DeepCopy())
+		err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.ErrorContains(t, err, "failed to find a matching service account to impersonate")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
 	// Ensure any stray resources irregularly labeled with instance label of app are not deleted upon deleting,
 	// when app project restriction is in place
 	t.Run("ProjectRestrictionEnforced", func(t *testing.T) {
@@ -1091,6 +1219,132 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		// finalizer is not removed
 		assert.False(t, patched)
 	})
+
+	t.Run("PreDelete_HookIsCreatedBeforeResourcesAreDeleted", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveCM := &unstructured.Unstructured{Object: newFakeCM()}
+		ctrl := newFakeController(&fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []*apiclient.Manifest{
+					{
+						CompiledManifest: fakePreDeleteHook,
+					},
+				},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(liveCM): liveCM,
+			},
+		}, nil)
+
+		patched := false
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is not deleted
+		assert.False(t, patched)
+		// pre-delete hook is created and no resource is deleted yet
+		require.Len(t, ctrl.kubectl.(*MockKubectl).CreatedResources, 1)
+		require.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).CreatedResources[0].GetName())
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookIsExecuted", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		conditions := []interface{}{
+			map[string]interface{}{
+				"type":   "Complete",
+				"status": "True",
+			},
+		}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, conditions, "status", "conditions"))
+		ctrl := newFakeController(&fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []*apiclient.Manifest{
+					{
+						CompiledManifest: fakePreDeleteHook,
+					},
+				},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(liveHook): liveHook,
+			},
+		}, nil)
+
+		patched := false
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is removed
+		assert.True(t, patched)
+	})
+
+	t.Run("PreDelete_HookFailureBlocksDeletion", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		conditions := []interface{}{
+			map[string]interface{}{
+				"type":    "Failed",
+				"status":  "True",
+				"message": "Job has reached the specified backoff limit",
+			},
+		}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, conditions, "status", "conditions"))
+		ctrl := newFakeController(&fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []*apiclient.Manifest{
+					{
+						CompiledManifest: fakePreDeleteHook,
+					},
+				},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(liveHook): liveHook,
+			},
+		}, nil)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(project string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.EqualError(t, err, "pre-delete hooks failed, fix the hook spec and delete the failed hooks to run them again, or remove the pre-delete-finalizer.argocd.argoproj.io finalizer from the application to skip them: Job/pre-delete-hook: Job has reached the specified backoff limit")
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.True(t, app.HasPreDeleteFinalizer())
+	})
 }
 
 // TestNormalizeApplication verifies we normalize an application during reconciliation
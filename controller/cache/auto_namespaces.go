@@ -0,0 +1,296 @@
+package cache
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+// EnvClusterAutoNamespacesDebounce is the env variable to configure the time the controller waits for the applications
+// to settle before it updates the namespaces watched in the clusters with automatic namespaces
+const EnvClusterAutoNamespacesDebounce = "ARGOCD_CLUSTER_AUTO_NAMESPACES_DEBOUNCE"
+
+var clusterAutoNamespacesDebounce = env.ParseDurationFromEnv(EnvClusterAutoNamespacesDebounce, 30*time.Second, 0, time.Hour)
+
+// clusterScope holds the namespaces watched in a cluster with automatic namespaces, and the cluster level resources
+// watched in it
+type clusterScope struct {
+	namespaces       []string
+	clusterResources bool
+	// clusterGroupKinds holds the cluster level group kinds the applications manage
+	clusterGroupKinds []schema.GroupKind
+	// excludedGroupKinds holds the cluster level group kinds of the cluster which the applications do not manage
+	excludedGroupKinds map[schema.GroupKind]bool
+}
+
+// equals returns whether the applications need the same namespaces and cluster level resources in both scopes
+func (s clusterScope) equals(other clusterScope) bool {
+	return reflect.DeepEqual(s.namespaces, other.namespaces) && reflect.DeepEqual(s.clusterGroupKinds, other.clusterGroupKinds)
+}
+
+// isAutoNamespaces returns whether the namespaces watched in the given cluster are inferred from the applications. The
+// automatic namespaces cannot be combined with an explicit list of namespaces, which takes precedence if both are set.
+func isAutoNamespaces(cluster *appv1.Cluster) bool {
+	if !cluster.AutoNamespaces {
+		return false
+	}
+	if len(cluster.Namespaces) > 0 {
+		log.WithField("server", cluster.Server).Warnf("Automatic namespaces cannot be combined with the namespaces %v of the cluster, watching these namespaces only", cluster.Namespaces)
+		return false
+	}
+	return true
+}
+
+// getAutoNamespacesRefs returns the destination of the given application and the namespaces and cluster level group
+// kinds of its resources, which the scope of its cluster depends on
+func getAutoNamespacesRefs(app *appv1.Application) map[string]bool {
+	refs := map[string]bool{"destination:" + app.Spec.Destination.Server + "/" + app.Spec.Destination.Name + "/" + app.Spec.Destination.Namespace: true}
+	for _, res := range app.Status.Resources {
+		if res.Namespace != "" {
+			refs["namespace:"+res.Namespace] = true
+		} else {
+			refs["groupKind:"+schema.GroupKind{Group: res.Group, Kind: res.Kind}.String()] = true
+		}
+	}
+	return refs
+}
+
+// getAutoNamespacesScope returns the scope the applications deployed to the given cluster need: the namespaces of
+// their destination and of their resources, and the cluster level group kinds the applications manage. The default
+// namespace is watched if no application is deployed to the cluster, since no namespace means all namespaces.
+func (c *liveStateCache) getAutoNamespacesScope(server string) clusterScope {
+	var scope clusterScope
+	namespaces := make(map[string]bool)
+	groupKinds := make(map[schema.GroupKind]bool)
+	if c.appInformer != nil {
+		for _, obj := range c.appInformer.GetIndexer().List() {
+			app, ok := obj.(*appv1.Application)
+			if !ok {
+				continue
+			}
+			destination := app.Spec.Destination
+			if err := argo.ValidateDestination(context.Background(), &destination, c.db); err != nil || destination.Server != server {
+				continue
+			}
+			if destination.Namespace != "" {
+				namespaces[destination.Namespace] = true
+			}
+			for _, res := range app.Status.Resources {
+				if res.Namespace != "" {
+					namespaces[res.Namespace] = true
+				} else {
+					groupKinds[schema.GroupKind{Group: res.Group, Kind: res.Kind}] = true
+				}
+			}
+		}
+	}
+	if len(namespaces) == 0 {
+		namespaces[metav1.NamespaceDefault] = true
+	}
+	for namespace := range namespaces {
+		scope.namespaces = append(scope.namespaces, namespace)
+	}
+	sort.Strings(scope.namespaces)
+	for gk := range groupKinds {
+		scope.clusterGroupKinds = append(scope.clusterGroupKinds, gk)
+	}
+	sort.Slice(scope.clusterGroupKinds, func(i, j int) bool {
+		return scope.clusterGroupKinds[i].String() < scope.clusterGroupKinds[j].String()
+	})
+	scope.clusterResources = len(scope.clusterGroupKinds) > 0
+	return scope
+}
+
+// getExcludedGroupKinds returns the cluster level group kinds of the given cluster which are not in the given scope.
+// The cluster level resources are not watched at all if the scope has none, so the group kinds of the cluster are
+// only discovered if the applications manage some cluster level resources.
+func (c *liveStateCache) getExcludedGroupKinds(cluster *appv1.Cluster, scope clusterScope) map[schema.GroupKind]bool {
+	if !scope.clusterResources || c.kubectl == nil {
+		return nil
+	}
+	resourcesFilter, err := c.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		log.WithField("server", cluster.Server).Warnf("Failed to get the resources filter, watching all the cluster level resources: %v", err)
+		return nil
+	}
+	apis, err := c.kubectl.GetAPIResources(cluster.RESTConfig(), true, resourcesFilter)
+	if err != nil {
+		log.WithField("server", cluster.Server).Warnf("Failed to discover the APIs, watching all the cluster level resources: %v", err)
+		return nil
+	}
+	used := make(map[schema.GroupKind]bool, len(scope.clusterGroupKinds))
+	for _, gk := range scope.clusterGroupKinds {
+		used[gk] = true
+	}
+	excluded := make(map[schema.GroupKind]bool)
+	for _, api := range apis {
+		if !api.Meta.Namespaced && !used[api.GroupKind] {
+			excluded[api.GroupKind] = true
+		}
+	}
+	return excluded
+}
+
+// isExcludedGroupKind returns whether the given group kind is a cluster level group kind which the applications deployed
+// to the given cluster with automatic namespaces do not manage
+func (c *liveStateCache) isExcludedGroupKind(server string, gk schema.GroupKind) bool {
+	c.autoNamespacesLock.Lock()
+	defer c.autoNamespacesLock.Unlock()
+	return c.autoNamespacesScopes[server].excludedGroupKinds[gk]
+}
+
+// autoNamespacesResourcesFilter excludes the cluster level resources which the applications deployed to the clusters
+// with automatic namespaces do not manage, in addition to the resources excluded by the settings
+type autoNamespacesResourcesFilter struct {
+	kube.ResourceFilter
+	cache *liveStateCache
+}
+
+func (f *autoNamespacesResourcesFilter) IsExcludedResource(group, kind, cluster string) bool {
+	return f.ResourceFilter.IsExcludedResource(group, kind, cluster) || f.cache.isExcludedGroupKind(cluster, schema.GroupKind{Group: group, Kind: kind})
+}
+
+// getClusterScopeSettings returns the settings of the namespaces watched in the given cluster, and records the scope of
+// the clusters with automatic namespaces
+func (c *liveStateCache) getClusterScopeSettings(cluster *appv1.Cluster) []clustercache.UpdateSettingsFunc {
+	if !isAutoNamespaces(cluster) {
+		c.deleteAutoNamespacesScope(cluster.Server)
+		return []clustercache.UpdateSettingsFunc{clustercache.SetNamespaces(cluster.Namespaces), clustercache.SetClusterResources(cluster.ClusterResources)}
+	}
+	scope := c.getAutoNamespacesScope(cluster.Server)
+	scope.excludedGroupKinds = c.getExcludedGroupKinds(cluster, scope)
+	c.autoNamespacesLock.Lock()
+	if c.autoNamespacesScopes == nil {
+		c.autoNamespacesScopes = make(map[string]clusterScope)
+	}
+	c.autoNamespacesScopes[cluster.Server] = scope
+	c.autoNamespacesLock.Unlock()
+	return []clustercache.UpdateSettingsFunc{clustercache.SetNamespaces(scope.namespaces), clustercache.SetClusterResources(scope.clusterResources)}
+}
+
+func (c *liveStateCache) deleteAutoNamespacesScope(server string) {
+	c.autoNamespacesLock.Lock()
+	defer c.autoNamespacesLock.Unlock()
+	delete(c.autoNamespacesScopes, server)
+}
+
+// requestAutoNamespacesUpdate requests the namespaces watched in the clusters with automatic namespaces to be updated
+func (c *liveStateCache) requestAutoNamespacesUpdate() {
+	select {
+	case c.autoNamespacesUpdateRequested <- struct{}{}:
+	default:
+	}
+}
+
+// runAutoNamespacesUpdates updates the namespaces watched in the clusters with automatic namespaces when applications
+// are added or deleted, or when their destination or resources change. The updates are debounced until the
+// applications settle, so that the watches of a cluster are not restarted for each application deployed to it, but
+// no longer than ten times the debounce period so that a constant flow of changes does not delay the updates forever.
+func (c *liveStateCache) runAutoNamespacesUpdates(ctx context.Context) {
+	if c.appInformer == nil {
+		return
+	}
+	_, err := c.appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(_ interface{}) {
+			c.requestAutoNamespacesUpdate()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldApp, oldOK := oldObj.(*appv1.Application)
+			newApp, newOK := newObj.(*appv1.Application)
+			if oldOK && newOK && reflect.DeepEqual(getAutoNamespacesRefs(oldApp), getAutoNamespacesRefs(newApp)) {
+				return
+			}
+			c.requestAutoNamespacesUpdate()
+		},
+		DeleteFunc: func(_ interface{}) {
+			c.requestAutoNamespacesUpdate()
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to watch applications, the namespaces of the clusters with automatic namespaces won't be updated: %v", err)
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.autoNamespacesUpdateRequested:
+		}
+		deadline := time.After(10 * clusterAutoNamespacesDebounce)
+		settled := false
+		for !settled {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.autoNamespacesUpdateRequested:
+				// wait for the applications to settle again
+			case <-time.After(clusterAutoNamespacesDebounce):
+				settled = true
+			case <-deadline:
+				settled = true
+			}
+		}
+		c.updateAutoNamespacesScopes()
+	}
+}
+
+// updateAutoNamespacesScopes invalidates the cache of the clusters with automatic namespaces whose scope changed
+func (c *liveStateCache) updateAutoNamespacesScopes() {
+	c.autoNamespacesLock.Lock()
+	servers := make([]string, 0, len(c.autoNamespacesScopes))
+	for server := range c.autoNamespacesScopes {
+		servers = append(servers, server)
+	}
+	c.autoNamespacesLock.Unlock()
+
+	for _, server := range servers {
+		scope := c.getAutoNamespacesScope(server)
+		c.autoNamespacesLock.Lock()
+		previous, ok := c.autoNamespacesScopes[server]
+		c.autoNamespacesLock.Unlock()
+		if !ok || previous.equals(scope) {
+			continue
+		}
+		if scope.clusterResources && c.db != nil {
+			cluster, err := c.db.GetCluster(context.Background(), server)
+			if err != nil {
+				log.WithField("server", server).Warnf("Failed to get the cluster, watching all the cluster level resources: %v", err)
+			} else {
+				scope.excludedGroupKinds = c.getExcludedGroupKinds(cluster, scope)
+			}
+		}
+		c.autoNamespacesLock.Lock()
+		if _, ok := c.autoNamespacesScopes[server]; !ok {
+			// the cluster no longer has automatic namespaces
+			c.autoNamespacesLock.Unlock()
+			continue
+		}
+		c.autoNamespacesScopes[server] = scope
+		c.autoNamespacesLock.Unlock()
+
+		c.lock.RLock()
+		clusterCache, ok := c.clusters[server]
+		c.lock.RUnlock()
+		if !ok {
+			continue
+		}
+		log.WithField("server", server).Infof("Watching namespaces %v with cluster level resources %t", scope.namespaces, scope.clusterResources)
+		clusterCache.Invalidate(clustercache.SetNamespaces(scope.namespaces), clustercache.SetClusterResources(scope.clusterResources))
+		go func() {
+			// warm up cluster cache
+			_ = clusterCache.EnsureSynced()
+		}()
+	}
+}
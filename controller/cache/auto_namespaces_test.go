@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	argosettings "github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newAutoNamespacesAppInformer(t *testing.T, apps ...*appv1.Application) k8scache.SharedIndexInformer {
+	t.Helper()
+	informer := k8scache.NewSharedIndexInformer(&k8scache.ListWatch{}, &appv1.Application{}, 0, k8scache.Indexers{})
+	for _, app := range apps {
+		require.NoError(t, informer.GetIndexer().Add(app))
+	}
+	return informer
+}
+
+func newAutoNamespacesApp(name string, server string, namespace string, resources ...appv1.ResourceStatus) *appv1.Application {
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: server, Namespace: namespace}},
+		Status:     appv1.ApplicationStatus{Resources: resources},
+	}
+}
+
+func TestGetAutoNamespacesScope(t *testing.T) {
+	c := &liveStateCache{appInformer: newAutoNamespacesAppInformer(t,
+		newAutoNamespacesApp("guestbook", "https://cluster-1", "guestbook",
+			appv1.ResourceStatus{Kind: "Deployment", Namespace: "guestbook", Name: "guestbook-ui"},
+			appv1.ResourceStatus{Kind: "ConfigMap", Namespace: "monitoring", Name: "guestbook-dashboard"},
+		),
+		newAutoNamespacesApp("rbac", "https://cluster-1", "",
+			appv1.ResourceStatus{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "guestbook"},
+		),
+		newAutoNamespacesApp("other", "https://cluster-2", "other"),
+	)}
+
+	assert.Equal(t, clusterScope{
+		namespaces:        []string{"guestbook", "monitoring"},
+		clusterResources:  true,
+		clusterGroupKinds: []schema.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}},
+	}, c.getAutoNamespacesScope("https://cluster-1"))
+	assert.Equal(t, clusterScope{namespaces: []string{"other"}}, c.getAutoNamespacesScope("https://cluster-2"))
+	assert.Equal(t, clusterScope{namespaces: []string{"default"}}, c.getAutoNamespacesScope("https://cluster-3"), "no application")
+}
+
+func TestUpdateAutoNamespacesScopes(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Maybe()
+	c := &liveStateCache{
+		appInformer: newAutoNamespacesAppInformer(t, newAutoNamespacesApp("guestbook", "https://cluster-1", "guestbook")),
+		clusters:    map[string]cache.ClusterCache{"https://cluster-1": clusterCache},
+		autoNamespacesScopes: map[string]clusterScope{
+			"https://cluster-1": {namespaces: []string{"default"}},
+		},
+	}
+
+	c.updateAutoNamespacesScopes()
+	assert.Equal(t, clusterScope{namespaces: []string{"guestbook"}}, c.autoNamespacesScopes["https://cluster-1"])
+
+	// the cache is not invalidated again while the scope is unchanged
+	c.updateAutoNamespacesScopes()
+	clusterCache.AssertNumberOfCalls(t, "Invalidate", 1)
+}
+
+func TestHandleModEvent_AutoNamespaces(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Maybe()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(1)
+	c := &liveStateCache{
+		appInformer:     newAutoNamespacesAppInformer(t, newAutoNamespacesApp("guestbook", "https://mycluster", "guestbook")),
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		clusters:        map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+	}
+
+	c.handleModEvent(&appv1.Cluster{Server: "https://mycluster"}, &appv1.Cluster{Server: "https://mycluster", AutoNamespaces: true})
+	clusterCache.AssertNumberOfCalls(t, "Invalidate", 1)
+	assert.Equal(t, clusterScope{namespaces: []string{"guestbook"}}, c.autoNamespacesScopes["https://mycluster"])
+
+	// the namespaces of the cluster secret take precedence over the automatic namespaces
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	c.handleModEvent(&appv1.Cluster{Server: "https://mycluster", AutoNamespaces: true}, &appv1.Cluster{Server: "https://mycluster", AutoNamespaces: true, Namespaces: []string{"other"}})
+	clusterCache.AssertNumberOfCalls(t, "Invalidate", 2)
+	assert.NotContains(t, c.autoNamespacesScopes, "https://mycluster")
+}
+
+func TestGetAutoNamespacesRefs(t *testing.T) {
+	app := newAutoNamespacesApp("guestbook", "https://cluster-1", "guestbook",
+		appv1.ResourceStatus{Kind: "Deployment", Namespace: "guestbook", Name: "guestbook-ui"},
+	)
+
+	updated := app.DeepCopy()
+	updated.Status.Resources[0].Status = appv1.SyncStatusCodeOutOfSync
+	updated.Status.Resources = append(updated.Status.Resources, appv1.ResourceStatus{Kind: "Service", Namespace: "guestbook", Name: "guestbook-ui"})
+	assert.Equal(t, getAutoNamespacesRefs(app), getAutoNamespacesRefs(updated), "same namespaces")
+
+	updated.Status.Resources = append(updated.Status.Resources, appv1.ResourceStatus{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "guestbook"})
+	assert.NotEqual(t, getAutoNamespacesRefs(app), getAutoNamespacesRefs(updated), "new cluster level resource")
+
+	updated = app.DeepCopy()
+	updated.Spec.Destination.Namespace = "other"
+	assert.NotEqual(t, getAutoNamespacesRefs(app), getAutoNamespacesRefs(updated), "new destination")
+}
+
+func TestAutoNamespacesResourcesFilter(t *testing.T) {
+	kubectl := &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{
+		{GroupKind: schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, Meta: metav1.APIResource{Namespaced: false}},
+		{GroupKind: schema.GroupKind{Kind: "Namespace"}, Meta: metav1.APIResource{Namespaced: false}},
+		{GroupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"}, Meta: metav1.APIResource{Namespaced: true}},
+	}}
+	kubeClient := fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	c := &liveStateCache{
+		appInformer: newAutoNamespacesAppInformer(t, newAutoNamespacesApp("rbac", "https://cluster-1", "guestbook",
+			appv1.ResourceStatus{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "guestbook"},
+		)),
+		kubectl:     kubectl,
+		settingsMgr: argosettings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace),
+	}
+	c.getClusterScopeSettings(&appv1.Cluster{Server: "https://cluster-1", AutoNamespaces: true})
+	filter := &autoNamespacesResourcesFilter{ResourceFilter: &argosettings.ResourcesFilter{}, cache: c}
+
+	assert.False(t, filter.IsExcludedResource("rbac.authorization.k8s.io", "ClusterRole", "https://cluster-1"), "managed by an application")
+	assert.True(t, filter.IsExcludedResource("", "Namespace", "https://cluster-1"), "not managed by any application")
+	assert.False(t, filter.IsExcludedResource("apps", "Deployment", "https://cluster-1"), "namespaced")
+	assert.False(t, filter.IsExcludedResource("", "Namespace", "https://cluster-2"), "no automatic namespaces")
+}
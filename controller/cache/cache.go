@@ -132,6 +132,8 @@ type LiveStateCache interface {
 	Run(ctx context.Context) error
 	// Returns information about monitored clusters
 	GetClustersInfo() []clustercache.ClusterInfo
+	// Returns the state of the circuit breaker of the given cluster
+	GetClusterCircuitBreaker(server string) appv1.ClusterCircuitBreaker
 	// Init must be executed before cache can be used
 	Init() error
 }
@@ -161,6 +163,11 @@ type ResourceInfo struct {
 	PodInfo *PodInfo
 	// NodeInfo is available for nodes only
 	NodeInfo *NodeInfo
+	// Capacity is available for pods, persistent volume claims and workloads only
+	Capacity *appv1.ResourceCapacity
+	// ManagedNamespaceTemplatesHash is available for namespaces only, it is the hash of the resources last applied from
+	// the managed namespace templates
+	ManagedNamespaceTemplatesHash string
 
 	manifestHash string
 }
@@ -175,7 +182,7 @@ func NewLiveStateCache(
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
 ) LiveStateCache {
-	return &liveStateCache{
+	c := &liveStateCache{
 		appInformer:      appInformer,
 		db:               db,
 		clusters:         make(map[string]clustercache.ClusterCache),
@@ -185,7 +192,13 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+
+		autoNamespacesUpdateRequested: make(chan struct{}, 1),
+	}
+	if clusterSharding != nil {
+		clusterSharding.SetClusterReassignedHandler(c.handleClusterReassigned)
 	}
+	return c
 }
 
 type cacheSettings struct {
@@ -213,6 +226,13 @@ type liveStateCache struct {
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
+
+	circuitBreakers     map[string]*circuitBreaker
+	circuitBreakersLock sync.Mutex
+
+	autoNamespacesScopes          map[string]clusterScope
+	autoNamespacesLock            sync.Mutex
+	autoNamespacesUpdateRequested chan struct{}
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -238,7 +258,7 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	}
 	clusterSettings := clustercache.Settings{
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
-		ResourcesFilter:        resourcesFilter,
+		ResourcesFilter:        &autoNamespacesResourcesFilter{ResourceFilter: resourcesFilter, cache: c},
 	}
 
 	return &cacheSettings{clusterSettings, appInstanceLabelKey, argo.GetTrackingMethod(c.settingsMgr), resourceUpdatesOverrides, ignoreResourceUpdatesEnabled}, nil
@@ -276,6 +296,7 @@ func asResourceNode(r *clustercache.Resource) appv1.ResourceNode {
 		Images:          resourceInfo.Images,
 		Health:          resHealth,
 		CreatedAt:       r.CreationTimestamp,
+		Capacity:        resourceInfo.Capacity,
 	}
 
 	if r.Resource != nil {
@@ -502,8 +523,6 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
@@ -537,6 +556,7 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetRetryOptions(clusterCacheAttemptLimit, clusterCacheRetryUseBackoff, isRetryableError),
 		clustercache.SetRespectRBAC(respectRBAC),
 	}
+	clusterCacheOpts = append(clusterCacheOpts, c.getClusterScopeSettings(cluster)...)
 
 	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
 
@@ -606,11 +626,16 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 }
 
 func (c *liveStateCache) getSyncedCluster(server string) (clustercache.ClusterCache, error) {
+	if err := c.allowCluster(server); err != nil {
+		return nil, err
+	}
 	clusterCache, err := c.getCluster(server)
 	if err != nil {
+		c.recordClusterResult(server, err)
 		return nil, fmt.Errorf("error getting cluster: %w", err)
 	}
 	err = clusterCache.EnsureSynced()
+	c.recordClusterResult(server, err)
 	if err != nil {
 		return nil, fmt.Errorf("error synchronizing cache state : %w", err)
 	}
@@ -681,9 +706,14 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster info for %q: %w", a.Spec.Destination.Server, err)
 	}
-	return clusterInfo.GetManagedLiveObjs(targetObjs, func(r *clustercache.Resource) bool {
+	liveObjs, err := clusterInfo.GetManagedLiveObjs(targetObjs, func(r *clustercache.Resource) bool {
 		return resInfo(r).AppName == a.InstanceName(c.settingsMgr.GetNamespace())
 	})
+	if err != nil {
+		// the objects missing from the cache are fetched from the cluster
+		c.recordClusterResult(a.Spec.Destination.Server, err)
+	}
+	return liveObjs, err
 }
 
 func (c *liveStateCache) GetVersionsInfo(serverURL string) (string, []kube.APIResourceInfo, error) {
@@ -756,6 +786,8 @@ func (c *liveStateCache) Init() error {
 // Run watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
+	go c.runCircuitBreakerProbes(ctx)
+	go c.runAutoNamespacesUpdates(ctx)
 
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
@@ -770,6 +802,34 @@ func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
 	return c.clusterSharding.IsManagedCluster(cluster)
 }
 
+// handleClusterReassigned stops caching a cluster moved to another shard by the rebalancing of the clusters, and warms
+// up the cache of a cluster moved to the shard of the controller
+func (c *liveStateCache) handleClusterReassigned(server string) {
+	cluster, err := c.db.GetCluster(context.Background(), server)
+	if err != nil {
+		log.Warnf("Failed to get the reassigned cluster %s: %v", server, err)
+		return
+	}
+	if !c.canHandleCluster(cluster) {
+		c.lock.Lock()
+		clusterCache, ok := c.clusters[server]
+		delete(c.clusters, server)
+		c.lock.Unlock()
+		if ok {
+			log.Infof("Cluster %s has been assigned to another shard, invalidating its cache", server)
+			clusterCache.Invalidate()
+			c.deleteAutoNamespacesScope(server)
+		}
+		return
+	}
+	if c.appInformer != nil && c.isClusterHasApps(c.appInformer.GetStore().List(), cluster) {
+		log.Infof("Cluster %s has been assigned to this shard, warming up its cache", server)
+		go func() {
+			_, _ = c.getSyncedCluster(server)
+		}()
+	}
+}
+
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
 	c.clusterSharding.Add(cluster)
 	if !c.canHandleCluster(cluster) {
@@ -805,6 +865,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
 			c.lock.Unlock()
+			c.deleteAutoNamespacesScope(newCluster.Server)
 			return
 		}
 
@@ -812,11 +873,15 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
 			updateSettings = append(updateSettings, clustercache.SetConfig(newCluster.RESTConfig()))
 		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
-		}
-		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
-			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+		if isAutoNamespaces(oldCluster) != isAutoNamespaces(newCluster) {
+			updateSettings = append(updateSettings, c.getClusterScopeSettings(newCluster)...)
+		} else if !isAutoNamespaces(newCluster) {
+			if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
+				updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+			}
+			if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
+				updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+			}
 		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
@@ -846,6 +911,8 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		delete(c.clusters, clusterServer)
 		c.lock.Unlock()
 	}
+	c.deleteCircuitBreaker(clusterServer)
+	c.deleteAutoNamespacesScope(clusterServer)
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -130,6 +130,27 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	assert.Empty(t, clustersCache.clusters)
 }
 
+func TestHandleClusterReassigned_ClusterExcluded(t *testing.T) {
+	var shard int64 = 1
+	cluster := &appv1.Cluster{Server: "https://mycluster", Shard: &shard}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate").Return(nil).Once()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	clusterSharding := sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm)
+	clusterSharding.Add(cluster)
+	clustersCache := liveStateCache{
+		db:              db,
+		clusters:        map[string]cache.ClusterCache{cluster.Server: clusterCache},
+		clusterSharding: clusterSharding,
+	}
+
+	clustersCache.handleClusterReassigned(cluster.Server)
+
+	assert.Empty(t, clustersCache.clusters)
+	clusterCache.AssertExpectations(t)
+}
+
 func TestHandleDeleteEvent_CacheDeadlock(t *testing.T) {
 	testCluster := &appv1.Cluster{
 		Server: "https://mycluster",
@@ -0,0 +1,261 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+const (
+	// EnvClusterCircuitBreakerFailureThreshold is the env variable to configure the number of consecutive failed
+	// attempts to reach a cluster after which the controller stops reaching it. 0 disables the circuit breaker.
+	EnvClusterCircuitBreakerFailureThreshold = "ARGOCD_CLUSTER_CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	// EnvClusterCircuitBreakerProbeInterval is the env variable to configure the interval at which an unreachable
+	// cluster is probed
+	EnvClusterCircuitBreakerProbeInterval = "ARGOCD_CLUSTER_CIRCUIT_BREAKER_PROBE_INTERVAL"
+)
+
+var (
+	clusterCircuitBreakerFailureThreshold = env.ParseNumFromEnv(EnvClusterCircuitBreakerFailureThreshold, 5, 0, 1000)
+	clusterCircuitBreakerProbeInterval    = env.ParseDurationFromEnv(EnvClusterCircuitBreakerProbeInterval, 1*time.Minute, 1*time.Second, 1*time.Hour)
+)
+
+// ErrClusterUnreachable is returned instead of reaching a cluster while its circuit breaker is open
+var ErrClusterUnreachable = errors.New("cluster is unreachable")
+
+// circuitBreaker stops the controller from reaching a cluster after consecutive network failures. While open, the
+// cluster is only reached once per probe interval, in the half-open state, and the breaker closes again as soon as
+// the cluster responds.
+type circuitBreaker struct {
+	failureThreshold int
+	probeInterval    time.Duration
+	// retryTimeout is the time during which the cluster cache returns the error of its last synchronization instead
+	// of reaching the cluster again: the failures reported during that time are counted once
+	retryTimeout time.Duration
+
+	lock     sync.Mutex
+	state    appv1.ClusterCircuitBreakerState
+	failures int64
+	openedAt time.Time
+	// probedAt is the time the cluster was last probed, or the time the breaker opened
+	probedAt time.Time
+	// failedAt is the time of the last failure counted
+	failedAt time.Time
+	message  string
+}
+
+func newCircuitBreaker(failureThreshold int, probeInterval time.Duration, retryTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		probeInterval:    probeInterval,
+		retryTimeout:     retryTimeout,
+		state:            appv1.ClusterCircuitBreakerStateClosed,
+	}
+}
+
+// allow returns whether the cluster can be reached, and whether the attempt is a probe. Once the probe interval
+// elapsed, an open breaker becomes half-open and lets a single attempt through.
+func (b *circuitBreaker) allow(now time.Time) (bool, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case appv1.ClusterCircuitBreakerStateOpen:
+		if now.Sub(b.probedAt) < b.probeInterval {
+			return false, false
+		}
+		b.state = appv1.ClusterCircuitBreakerStateHalfOpen
+		b.probedAt = now
+		return true, true
+	case appv1.ClusterCircuitBreakerStateHalfOpen:
+		return false, false
+	}
+	return true, false
+}
+
+// probeDue returns whether the breaker is open and the probe interval elapsed
+func (b *circuitBreaker) probeDue(now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.state == appv1.ClusterCircuitBreakerStateOpen && now.Sub(b.probedAt) >= b.probeInterval
+}
+
+// record records the result of an attempt to reach the cluster and returns the previous and the new state of the
+// breaker. Only the network errors count as failures: any other result means the cluster responded.
+func (b *circuitBreaker) record(err error, now time.Time) (appv1.ClusterCircuitBreakerState, appv1.ClusterCircuitBreakerState) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	previous := b.state
+	if err == nil || !isTransientNetworkErr(err) {
+		b.state = appv1.ClusterCircuitBreakerStateClosed
+		b.failures = 0
+		b.message = ""
+		return previous, b.state
+	}
+	b.message = err.Error()
+	if b.state == appv1.ClusterCircuitBreakerStateClosed && b.failures > 0 && now.Sub(b.failedAt) < b.retryTimeout {
+		return previous, b.state
+	}
+	b.failures++
+	b.failedAt = now
+	if b.state == appv1.ClusterCircuitBreakerStateHalfOpen || (b.failureThreshold > 0 && b.failures >= int64(b.failureThreshold)) {
+		if b.state == appv1.ClusterCircuitBreakerStateClosed {
+			b.openedAt = now
+		}
+		b.state = appv1.ClusterCircuitBreakerStateOpen
+		b.probedAt = now
+	}
+	return previous, b.state
+}
+
+func (b *circuitBreaker) info() appv1.ClusterCircuitBreaker {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	info := appv1.ClusterCircuitBreaker{State: b.state, ConsecutiveFailures: b.failures, Message: b.message}
+	if b.state != appv1.ClusterCircuitBreakerStateClosed {
+		openedAt := metav1.NewTime(b.openedAt)
+		info.OpenedAt = &openedAt
+	}
+	return info
+}
+
+func (c *liveStateCache) getCircuitBreaker(server string) *circuitBreaker {
+	c.circuitBreakersLock.Lock()
+	defer c.circuitBreakersLock.Unlock()
+	if c.circuitBreakers == nil {
+		c.circuitBreakers = make(map[string]*circuitBreaker)
+	}
+	breaker, ok := c.circuitBreakers[server]
+	if !ok {
+		breaker = newCircuitBreaker(clusterCircuitBreakerFailureThreshold, clusterCircuitBreakerProbeInterval, clusterSyncRetryTimeoutDuration)
+		c.circuitBreakers[server] = breaker
+	}
+	return breaker
+}
+
+func (c *liveStateCache) deleteCircuitBreaker(server string) {
+	c.circuitBreakersLock.Lock()
+	_, ok := c.circuitBreakers[server]
+	delete(c.circuitBreakers, server)
+	c.circuitBreakersLock.Unlock()
+	if ok && c.metricsServer != nil {
+		c.metricsServer.DeleteClusterCircuitBreakerState(server)
+	}
+}
+
+// GetClusterCircuitBreaker returns the state of the circuit breaker of the given cluster
+func (c *liveStateCache) GetClusterCircuitBreaker(server string) appv1.ClusterCircuitBreaker {
+	return c.getCircuitBreaker(server).info()
+}
+
+// recordClusterResult records the result of an attempt to reach the given cluster in its circuit breaker. When the
+// breaker opens, the cache of the cluster is invalidated to stop its watches, and when it closes again the
+// applications of the cluster are refreshed.
+func (c *liveStateCache) recordClusterResult(server string, err error) {
+	breaker := c.getCircuitBreaker(server)
+	previous, state := breaker.record(err, time.Now())
+	if previous == state {
+		return
+	}
+	if c.metricsServer != nil {
+		c.metricsServer.SetClusterCircuitBreakerState(server, state)
+	}
+	switch state {
+	case appv1.ClusterCircuitBreakerStateOpen:
+		if previous == appv1.ClusterCircuitBreakerStateClosed {
+			log.Warnf("Cluster %s is unreachable, stopping to reach it until it responds to a probe: %v", server, err)
+		} else {
+			log.Infof("Cluster %s is still unreachable: %v", server, err)
+		}
+		c.lock.RLock()
+		clusterCache, ok := c.clusters[server]
+		c.lock.RUnlock()
+		if ok {
+			clusterCache.Invalidate()
+		}
+	case appv1.ClusterCircuitBreakerStateClosed:
+		log.Infof("Cluster %s is reachable again", server)
+		if c.appInformer != nil {
+			c.onObjectUpdated(c.getClusterApps(server), v1.ObjectReference{})
+		}
+	}
+}
+
+// getClusterApps returns the names of the applications deployed to the given cluster
+func (c *liveStateCache) getClusterApps(server string) map[string]bool {
+	apps := make(map[string]bool)
+	for _, obj := range c.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, c.db); err != nil {
+			continue
+		}
+		if app.Spec.Destination.Server == server {
+			apps[app.InstanceName(c.settingsMgr.GetNamespace())] = true
+		}
+	}
+	return apps
+}
+
+// runCircuitBreakerProbes periodically probes the clusters whose circuit breaker is open
+func (c *liveStateCache) runCircuitBreakerProbes(ctx context.Context) {
+	ticker := time.NewTicker(clusterCircuitBreakerProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.probeClusters(time.Now())
+		}
+	}
+}
+
+func (c *liveStateCache) probeClusters(now time.Time) {
+	c.circuitBreakersLock.Lock()
+	var servers []string
+	for server, breaker := range c.circuitBreakers {
+		if breaker.probeDue(now) {
+			servers = append(servers, server)
+		}
+	}
+	c.circuitBreakersLock.Unlock()
+
+	for _, server := range servers {
+		if _, err := c.getSyncedCluster(server); err != nil {
+			log.Debugf("Probe of cluster %s failed: %v", server, err)
+		}
+	}
+}
+
+// allowCluster returns an error if the given cluster must not be reached because its circuit breaker is open
+func (c *liveStateCache) allowCluster(server string) error {
+	breaker := c.getCircuitBreaker(server)
+	allowed, probe := breaker.allow(time.Now())
+	if !allowed {
+		return newClusterUnreachableError(server, breaker.info())
+	}
+	if probe {
+		log.Infof("Probing unreachable cluster %s", server)
+		if c.metricsServer != nil {
+			c.metricsServer.SetClusterCircuitBreakerState(server, appv1.ClusterCircuitBreakerStateHalfOpen)
+		}
+	}
+	return nil
+}
+
+func newClusterUnreachableError(server string, breaker appv1.ClusterCircuitBreaker) error {
+	return fmt.Errorf("%w: %s has been unreachable since %s: %s", ErrClusterUnreachable, server, breaker.OpenedAt.Format(time.RFC3339), breaker.Message)
+}
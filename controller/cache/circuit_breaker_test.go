@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var errDialTimeout = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("i/o timeout")}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		breaker := newCircuitBreaker(3, time.Minute, 10*time.Second)
+		for i := 0; i < 2; i++ {
+			_, state := breaker.record(errDialTimeout, now.Add(time.Duration(i)*time.Minute))
+			assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, state)
+		}
+		previous, state := breaker.record(errDialTimeout, now.Add(2*time.Minute))
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, previous)
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateOpen, state)
+
+		info := breaker.info()
+		assert.Equal(t, int64(3), info.ConsecutiveFailures)
+		require.NotNil(t, info.OpenedAt)
+		assert.Equal(t, now.Add(2*time.Minute), info.OpenedAt.Time)
+		assert.Contains(t, info.Message, "i/o timeout")
+	})
+
+	t.Run("failures within the retry timeout are counted once", func(t *testing.T) {
+		breaker := newCircuitBreaker(2, time.Minute, 10*time.Second)
+		breaker.record(errDialTimeout, now)
+		_, state := breaker.record(errDialTimeout, now.Add(5*time.Second))
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, state)
+		assert.Equal(t, int64(1), breaker.info().ConsecutiveFailures)
+	})
+
+	t.Run("non network errors close the breaker", func(t *testing.T) {
+		breaker := newCircuitBreaker(2, time.Minute, 0)
+		breaker.record(errDialTimeout, now)
+		_, state := breaker.record(errors.New("forbidden"), now.Add(time.Second))
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, state)
+		assert.Equal(t, int64(0), breaker.info().ConsecutiveFailures)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		breaker := newCircuitBreaker(0, time.Minute, 0)
+		for i := 0; i < 10; i++ {
+			_, state := breaker.record(errDialTimeout, now.Add(time.Duration(i)*time.Second))
+			assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, state)
+		}
+	})
+
+	t.Run("probes once per interval", func(t *testing.T) {
+		breaker := newCircuitBreaker(1, time.Minute, 0)
+		breaker.record(errDialTimeout, now)
+
+		allowed, _ := breaker.allow(now.Add(30 * time.Second))
+		assert.False(t, allowed)
+		assert.False(t, breaker.probeDue(now.Add(30*time.Second)))
+		assert.True(t, breaker.probeDue(now.Add(time.Minute)))
+
+		allowed, probe := breaker.allow(now.Add(time.Minute))
+		assert.True(t, allowed)
+		assert.True(t, probe)
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateHalfOpen, breaker.info().State)
+		allowed, _ = breaker.allow(now.Add(time.Minute))
+		assert.False(t, allowed, "a single attempt is allowed while half-open")
+
+		previous, state := breaker.record(errDialTimeout, now.Add(time.Minute))
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateHalfOpen, previous)
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateOpen, state)
+		assert.Equal(t, now, breaker.info().OpenedAt.Time)
+		allowed, _ = breaker.allow(now.Add(90 * time.Second))
+		assert.False(t, allowed)
+
+		breaker.allow(now.Add(2 * time.Minute))
+		_, state = breaker.record(nil, now.Add(2*time.Minute))
+		assert.Equal(t, appv1.ClusterCircuitBreakerStateClosed, state)
+		assert.Nil(t, breaker.info().OpenedAt)
+		allowed, probe = breaker.allow(now.Add(2 * time.Minute))
+		assert.True(t, allowed)
+		assert.False(t, probe)
+	})
+}
+
+func TestGetSyncedCluster_CircuitBreaker(t *testing.T) {
+	server := "https://mycluster"
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("EnsureSynced").Return(errDialTimeout).Once()
+	clusterCache.On("Invalidate").Return().Once()
+	c := &liveStateCache{
+		clusters:        map[string]cache.ClusterCache{server: clusterCache},
+		circuitBreakers: map[string]*circuitBreaker{server: newCircuitBreaker(1, time.Hour, 0)},
+	}
+
+	_, err := c.getSyncedCluster(server)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrClusterUnreachable)
+	assert.Equal(t, appv1.ClusterCircuitBreakerStateOpen, c.GetClusterCircuitBreaker(server).State)
+
+	_, err = c.getSyncedCluster(server)
+	require.ErrorIs(t, err, ErrClusterUnreachable)
+	assert.Contains(t, err.Error(), "i/o timeout")
+	clusterCache.AssertExpectations(t)
+}
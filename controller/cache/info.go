@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/cespare/xxhash/v2"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	resourcehelper "k8s.io/kubectl/pkg/util/resource"
@@ -56,6 +57,22 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 			populateServiceInfo(un, res)
 		case "Node":
 			populateHostNodeInfo(un, res)
+		case kube.PersistentVolumeClaimKind:
+			populatePersistentVolumeClaimInfo(un, res)
+		case kube.NamespaceKind:
+			res.ManagedNamespaceTemplatesHash = un.GetLabels()[common.LabelKeyManagedNamespaceTemplatesHash]
+		}
+	case "apps":
+		switch gvk.Kind {
+		case kube.DeploymentKind, kube.StatefulSetKind, kube.ReplicaSetKind:
+			populateReplicasInfo(un, res)
+		case kube.DaemonSetKind:
+			populateDaemonSetInfo(un, res)
+		}
+	case "argoproj.io":
+		switch gvk.Kind {
+		case "Rollout":
+			populateReplicasInfo(un, res)
 		}
 	case "extensions", "networking.k8s.io":
 		switch gvk.Kind {
@@ -378,8 +395,18 @@ func populatePodInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 		res.Info = append(res.Info, v1alpha1.InfoItem{Name: "Status Reason", Value: reason})
 	}
 
-	req, _ := resourcehelper.PodRequestsAndLimits(&pod)
+	req, limits := resourcehelper.PodRequestsAndLimits(&pod)
 	res.PodInfo = &PodInfo{NodeName: pod.Spec.NodeName, ResourceRequests: req, Phase: pod.Status.Phase}
+	// the pods which are completed don't reserve any resources
+	if pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed {
+		res.Capacity = &v1alpha1.ResourceCapacity{
+			CPURequests:    getQuantity(req, v1.ResourceCPU),
+			CPULimits:      getQuantity(limits, v1.ResourceCPU),
+			MemoryRequests: getQuantity(req, v1.ResourceMemory),
+			MemoryLimits:   getQuantity(limits, v1.ResourceMemory),
+			Pods:           1,
+		}
+	}
 
 	res.Info = append(res.Info, v1alpha1.InfoItem{Name: "Node", Value: pod.Spec.NodeName})
 	res.Info = append(res.Info, v1alpha1.InfoItem{Name: "Containers", Value: fmt.Sprintf("%d/%d", readyContainers, totalContainers)})
@@ -395,6 +422,59 @@ func populatePodInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 	res.NetworkingInfo = &v1alpha1.ResourceNetworkingInfo{Labels: un.GetLabels(), ExternalURLs: urls}
 }
 
+func getQuantity(resources v1.ResourceList, name v1.ResourceName) string {
+	if quantity, ok := resources[name]; ok && !quantity.IsZero() {
+		return quantity.String()
+	}
+	return ""
+}
+
+func populatePersistentVolumeClaimInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	pvc := v1.PersistentVolumeClaim{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &pvc)
+	if err != nil {
+		return
+	}
+	if storage := getQuantity(pvc.Spec.Resources.Requests, v1.ResourceStorage); storage != "" {
+		res.Capacity = &v1alpha1.ResourceCapacity{Storage: storage}
+	}
+}
+
+// populateReplicasInfo records the desired replicas of a workload. The replica sets controlled by a deployment or a
+// rollout are skipped, since their replicas are already counted by their owner.
+func populateReplicasInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	if un.GetKind() == kube.ReplicaSetKind && metav1.GetControllerOf(un) != nil {
+		return
+	}
+	workload := struct {
+		Spec struct {
+			Replicas *int32 `json:"replicas"`
+		} `json:"spec"`
+	}{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &workload)
+	if err != nil {
+		return
+	}
+	replicas := int64(1)
+	if workload.Spec.Replicas != nil {
+		replicas = int64(*workload.Spec.Replicas)
+	}
+	res.Capacity = &v1alpha1.ResourceCapacity{Replicas: replicas}
+}
+
+func populateDaemonSetInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	daemonSet := struct {
+		Status struct {
+			DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+		} `json:"status"`
+	}{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &daemonSet)
+	if err != nil {
+		return
+	}
+	res.Capacity = &v1alpha1.ResourceCapacity{Replicas: int64(daemonSet.Status.DesiredNumberScheduled)}
+}
+
 func populateHostNodeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 	node := v1.Node{}
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &node)
@@ -283,6 +283,109 @@ func TestGetPodInfo(t *testing.T) {
 		ResourceRequests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
 	}, info.PodInfo)
 	assert.Equal(t, &v1alpha1.ResourceNetworkingInfo{Labels: map[string]string{"app": "guestbook"}}, info.NetworkingInfo)
+	assert.Equal(t, &v1alpha1.ResourceCapacity{MemoryRequests: "128Mi", Pods: 1}, info.Capacity)
+}
+
+func TestGetCompletedPodInfo(t *testing.T) {
+	pod := strToUnstructured(`
+  apiVersion: v1
+  kind: Pod
+  metadata:
+    name: my-job-pod
+    namespace: default
+  spec:
+    containers:
+    - image: bar
+      resources:
+        requests:
+          cpu: 100m
+  status:
+    phase: Succeeded
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(pod, info, []string{})
+	assert.Nil(t, info.Capacity)
+}
+
+func TestGetPersistentVolumeClaimInfo(t *testing.T) {
+	pvc := strToUnstructured(`
+  apiVersion: v1
+  kind: PersistentVolumeClaim
+  metadata:
+    name: data
+    namespace: default
+  spec:
+    resources:
+      requests:
+        storage: 10Gi
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(pvc, info, []string{})
+	assert.Equal(t, &v1alpha1.ResourceCapacity{Storage: "10Gi"}, info.Capacity)
+}
+
+func TestGetNamespaceInfo(t *testing.T) {
+	namespace := strToUnstructured(`
+  apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: tenant
+    labels:
+      argocd.argoproj.io/managed-namespace-templates-hash: abc123
+      team: tenant
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(namespace, info, []string{})
+	assert.Equal(t, "abc123", info.ManagedNamespaceTemplatesHash)
+}
+
+func TestGetWorkloadReplicasInfo(t *testing.T) {
+	deployment := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: guestbook
+    namespace: default
+  spec:
+    replicas: 3
+`)
+	info := &ResourceInfo{}
+	populateNodeInfo(deployment, info, []string{})
+	assert.Equal(t, &v1alpha1.ResourceCapacity{Replicas: 3}, info.Capacity)
+
+	replicaSet := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: guestbook-6b8f9c5d4
+    namespace: default
+    ownerReferences:
+    - apiVersion: apps/v1
+      kind: Deployment
+      name: guestbook
+      controller: true
+  spec:
+    replicas: 3
+`)
+	info = &ResourceInfo{}
+	populateNodeInfo(replicaSet, info, []string{})
+	assert.Nil(t, info.Capacity, "the replicas of a controlled replica set are counted by its owner")
+
+	daemonSet := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: DaemonSet
+  metadata:
+    name: agent
+    namespace: default
+  status:
+    desiredNumberScheduled: 5
+`)
+	info = &ResourceInfo{}
+	populateNodeInfo(daemonSet, info, []string{})
+	assert.Equal(t, &v1alpha1.ResourceCapacity{Replicas: 5}, info.Capacity)
 }
 
 func TestGetNodeInfo(t *testing.T) {
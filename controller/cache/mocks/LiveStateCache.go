@@ -55,6 +55,24 @@ func (_m *LiveStateCache) GetClusterCache(server string) (cache.ClusterCache, er
 	return r0, r1
 }
 
+// GetClusterCircuitBreaker provides a mock function with given fields: server
+func (_m *LiveStateCache) GetClusterCircuitBreaker(server string) v1alpha1.ClusterCircuitBreaker {
+	ret := _m.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterCircuitBreaker")
+	}
+
+	var r0 v1alpha1.ClusterCircuitBreaker
+	if rf, ok := ret.Get(0).(func(string) v1alpha1.ClusterCircuitBreaker); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(v1alpha1.ClusterCircuitBreaker)
+	}
+
+	return r0
+}
+
 // GetClustersInfo provides a mock function with given fields:
 func (_m *LiveStateCache) GetClustersInfo() []cache.ClusterInfo {
 	ret := _m.Called()
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// getChildApplications returns the child applications managed by the given app-of-apps. The children are read from
+// the app informer, the children which are not created yet or which are not in a namespace watched by the controller
+// are ignored.
+func (ctrl *ApplicationController) getChildApplications(resources []appv1.ResourceStatus) []*appv1.Application {
+	var children []*appv1.Application
+	for _, res := range resources {
+		if res.Group != application.Group || res.Kind != application.ApplicationKind || res.Hook {
+			continue
+		}
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppQualifiedName(res.Name, res.Namespace))
+		if err != nil || !exists {
+			continue
+		}
+		if child, ok := obj.(*appv1.Application); ok {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// isFailingChildApplication returns true if the child application is degraded, has an error condition or if its last
+// operation failed
+func isFailingChildApplication(child *appv1.Application) bool {
+	if child.Status.Health.Status == health.HealthStatusDegraded {
+		return true
+	}
+	if state := child.Status.OperationState; state != nil && (state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError) {
+		return true
+	}
+	for i := range child.Status.Conditions {
+		if child.Status.Conditions[i].IsError() {
+			return true
+		}
+	}
+	return false
+}
+
+// getChildApplicationsSummary aggregates the sync and health status of the child applications of an app-of-apps
+func getChildApplicationsSummary(children []*appv1.Application) *appv1.ChildApplicationsSummary {
+	summary := &appv1.ChildApplicationsSummary{
+		Total:          int64(len(children)),
+		SyncStatuses:   make(map[string]int64),
+		HealthStatuses: make(map[string]int64),
+	}
+	for _, child := range children {
+		syncStatus := child.Status.Sync.Status
+		if syncStatus == "" {
+			syncStatus = appv1.SyncStatusCodeUnknown
+		}
+		healthStatus := child.Status.Health.Status
+		if healthStatus == "" {
+			healthStatus = health.HealthStatusUnknown
+		}
+		summary.SyncStatuses[string(syncStatus)]++
+		summary.HealthStatuses[string(healthStatus)]++
+
+		if !isFailingChildApplication(child) {
+			continue
+		}
+		failing := appv1.FailingChildApplication{
+			Name:         child.Name,
+			Namespace:    child.Namespace,
+			SyncStatus:   syncStatus,
+			HealthStatus: healthStatus,
+			Conditions:   child.Status.Conditions,
+		}
+		if state := child.Status.OperationState; state != nil && (state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError) {
+			failing.OperationMessage = state.Message
+		}
+		summary.Failing = append(summary.Failing, failing)
+	}
+	sort.Slice(summary.Failing, func(i, j int) bool {
+		if summary.Failing[i].Namespace != summary.Failing[j].Namespace {
+			return summary.Failing[i].Namespace < summary.Failing[j].Namespace
+		}
+		return summary.Failing[i].Name < summary.Failing[j].Name
+	})
+	return summary
+}
+
+// propagatesChildOutOfSync returns true if one of the OutOfSync child applications makes the app-of-apps OutOfSync
+// according to its propagation rule
+func propagatesChildOutOfSync(propagation appv1.ChildOutOfSyncPropagation, children []*appv1.Application) bool {
+	if propagation == appv1.ChildOutOfSyncPropagationNone {
+		return false
+	}
+	for _, child := range children {
+		if child.Status.Sync.Status != appv1.SyncStatusCodeOutOfSync {
+			continue
+		}
+		automated := child.Spec.SyncPolicy != nil && child.Spec.SyncPolicy.Automated != nil
+		if propagation == appv1.ChildOutOfSyncPropagationAll || !automated {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateChildApplicationsStatus sets the aggregated status of the child applications into the summary of the
+// app-of-apps, and marks it OutOfSync if its propagation rule requires it. It is a no-op unless the aggregation is
+// enabled on the app-of-apps.
+func (ctrl *ApplicationController) aggregateChildApplicationsStatus(app *appv1.Application, resources []appv1.ResourceStatus) {
+	if !app.IsChildStatusAggregationEnabled() {
+		app.Status.Summary.ChildApplications = nil
+		return
+	}
+	children := ctrl.getChildApplications(resources)
+	app.Status.Summary.ChildApplications = getChildApplicationsSummary(children)
+	if app.Status.Sync.Status == appv1.SyncStatusCodeSynced && propagatesChildOutOfSync(app.GetChildOutOfSyncPropagation(), children) {
+		app.Status.Sync.Status = appv1.SyncStatusCodeOutOfSync
+	}
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newFakeChildApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, automated bool) *v1alpha1.Application {
+	child := newFakeApp()
+	child.Name = name
+	child.Status.Sync.Status = syncStatus
+	child.Status.Health.Status = healthStatus
+	if automated {
+		child.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+	} else {
+		child.Spec.SyncPolicy = nil
+	}
+	return child
+}
+
+func newFakeAppOfApps(propagation v1alpha1.ChildOutOfSyncPropagation, children ...*v1alpha1.Application) (*v1alpha1.Application, []v1alpha1.ResourceStatus) {
+	parent := newFakeApp()
+	parent.Name = "app-of-apps"
+	parent.Annotations = map[string]string{
+		v1alpha1.AnnotationKeyAggregateChildStatus:      "true",
+		v1alpha1.AnnotationKeyChildOutOfSyncPropagation: string(propagation),
+	}
+	parent.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	resources := []v1alpha1.ResourceStatus{{Kind: "ConfigMap", Namespace: parent.Namespace, Name: "my-map"}}
+	for _, child := range children {
+		resources = append(resources, v1alpha1.ResourceStatus{Group: application.Group, Kind: application.ApplicationKind, Namespace: child.Namespace, Name: child.Name})
+	}
+	return parent, resources
+}
+
+func TestAggregateChildApplicationsStatus(t *testing.T) {
+	synced := newFakeChildApp("synced", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, true)
+	outOfSync := newFakeChildApp("out-of-sync", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, true)
+	degraded := newFakeChildApp("degraded", v1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded, false)
+	failed := newFakeChildApp("failed", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, false)
+	failed.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationFailed, Message: "one or more objects failed to apply"}
+	failed.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionSyncError, Message: "one or more objects failed to apply"}}
+
+	parent, resources := newFakeAppOfApps(v1alpha1.ChildOutOfSyncPropagationNone, synced, outOfSync, degraded, failed)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{parent, synced, outOfSync, degraded, failed, &defaultProj}}, nil)
+	ctrl.aggregateChildApplicationsStatus(parent, resources)
+
+	summary := parent.Status.Summary.ChildApplications
+	require.NotNil(t, summary)
+	assert.Equal(t, int64(4), summary.Total)
+	assert.Equal(t, map[string]int64{"Synced": 3, "OutOfSync": 1}, summary.SyncStatuses)
+	assert.Equal(t, map[string]int64{"Healthy": 3, "Degraded": 1}, summary.HealthStatuses)
+	require.Len(t, summary.Failing, 2)
+	assert.Equal(t, "degraded", summary.Failing[0].Name)
+	assert.Equal(t, "failed", summary.Failing[1].Name)
+	assert.Equal(t, "one or more objects failed to apply", summary.Failing[1].OperationMessage)
+	assert.Equal(t, failed.Status.Conditions, summary.Failing[1].Conditions)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, parent.Status.Sync.Status, "OutOfSync is not propagated by default")
+
+	t.Run("disabled", func(t *testing.T) {
+		parent := parent.DeepCopy()
+		parent.Annotations = nil
+		ctrl.aggregateChildApplicationsStatus(parent, resources)
+		assert.Nil(t, parent.Status.Summary.ChildApplications)
+	})
+}
+
+func TestAggregateChildApplicationsStatus_OutOfSyncPropagation(t *testing.T) {
+	automated := newFakeChildApp("automated", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, true)
+	manual := newFakeChildApp("manual", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, false)
+
+	for _, tc := range []struct {
+		name        string
+		propagation v1alpha1.ChildOutOfSyncPropagation
+		children    []*v1alpha1.Application
+		expected    v1alpha1.SyncStatusCode
+	}{
+		{"all", v1alpha1.ChildOutOfSyncPropagationAll, []*v1alpha1.Application{automated}, v1alpha1.SyncStatusCodeOutOfSync},
+		{"manual child", v1alpha1.ChildOutOfSyncPropagationManual, []*v1alpha1.Application{automated, manual}, v1alpha1.SyncStatusCodeOutOfSync},
+		{"automated child", v1alpha1.ChildOutOfSyncPropagationManual, []*v1alpha1.Application{automated}, v1alpha1.SyncStatusCodeSynced},
+		{"unknown rule", "sometimes", []*v1alpha1.Application{manual}, v1alpha1.SyncStatusCodeSynced},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parent, resources := newFakeAppOfApps(tc.propagation, tc.children...)
+			apps := []runtime.Object{parent, &defaultProj}
+			for _, child := range tc.children {
+				apps = append(apps, child)
+			}
+			ctrl := newFakeController(&fakeData{apps: apps}, nil)
+			ctrl.aggregateChildApplicationsStatus(parent, resources)
+			assert.Equal(t, tc.expected, parent.Status.Sync.Status)
+		})
+	}
+}
+
+func TestAggregateChildApplicationsStatus_TreeReconciliation(t *testing.T) {
+	child := newFakeChildApp("child", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, true)
+	parent, resources := newFakeAppOfApps(v1alpha1.ChildOutOfSyncPropagationNone, child)
+	parent.Status.Resources = resources
+	now := metav1.Now()
+	parent.Status.ReconciledAt = &now
+	parent.Status.Sync.ComparedTo = v1alpha1.ComparedTo{Source: parent.Spec.GetSource(), Destination: parent.Spec.Destination}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{parent, child, &defaultProj}}, nil)
+	require.NoError(t, ctrl.cache.SetAppManagedResources(parent.InstanceName(ctrl.namespace), []*v1alpha1.ResourceDiff{}))
+
+	key, _ := cache.MetaNamespaceKeyFunc(parent)
+	ctrl.requestAppRefresh(parent.QualifiedName(), ComparisonWithNothing.Pointer(), nil)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+	ctrl.processAppRefreshQueueItem()
+
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(parent.Namespace).Get(context.Background(), parent.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	summary := updated.Status.Summary.ChildApplications
+	require.NotNil(t, summary, "the tree reconciliation keeps the aggregated status of the child applications")
+	assert.Equal(t, int64(1), summary.Total)
+	assert.Equal(t, map[string]int64{"OutOfSync": 1}, summary.SyncStatuses)
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// getClusterUnreachableCondition returns a condition reporting that the destination cluster of the application is
+// unreachable, or nil if the circuit breaker of the cluster is closed. The condition does not change while the
+// cluster stays unreachable, so that the application is not updated on every refresh.
+func (ctrl *ApplicationController) getClusterUnreachableCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	destination := app.Spec.Destination
+	if err := argo.ValidateDestination(context.Background(), &destination, ctrl.db); err != nil {
+		return nil
+	}
+	breaker := ctrl.stateCache.GetClusterCircuitBreaker(destination.Server)
+	if breaker.State == "" || breaker.State == appv1.ClusterCircuitBreakerStateClosed || breaker.OpenedAt == nil {
+		return nil
+	}
+	now := metav1.Now()
+	return &appv1.ApplicationCondition{
+		Type:               appv1.ApplicationConditionClusterUnreachable,
+		Message:            fmt.Sprintf("Destination cluster %s has been unreachable since %s, the application is neither refreshed nor synced until the cluster responds", destination.Server, breaker.OpenedAt.Format(time.RFC3339)),
+		LastTransitionTime: &now,
+	}
+}
+
+func hasClusterUnreachableCondition(app *appv1.Application) bool {
+	return len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionClusterUnreachable: true})) > 0
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newOpenCircuitBreaker() *v1alpha1.ClusterCircuitBreaker {
+	openedAt := metav1.NewTime(time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC))
+	return &v1alpha1.ClusterCircuitBreaker{State: v1alpha1.ClusterCircuitBreakerStateOpen, ConsecutiveFailures: 5, OpenedAt: &openedAt, Message: "dial tcp: i/o timeout"}
+}
+
+func TestGetClusterUnreachableCondition(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		assert.Nil(t, ctrl.getClusterUnreachableCondition(app))
+	})
+
+	t.Run("open", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, clusterCircuitBreaker: newOpenCircuitBreaker()}, nil)
+		cond := ctrl.getClusterUnreachableCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionClusterUnreachable, cond.Type)
+		assert.Equal(t, "Destination cluster https://localhost:6443 has been unreachable since 2024-07-01T10:00:00Z, the application is neither refreshed nor synced until the cluster responds", cond.Message)
+		assert.False(t, cond.IsError())
+	})
+}
+
+func TestProcessAppRefreshQueueItem_ClusterUnreachable(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, clusterCircuitBreaker: newOpenCircuitBreaker()}, nil)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+	ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+
+	ctrl.processAppRefreshQueueItem()
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionClusterUnreachable, app.Status.Conditions[0].Type)
+	// the application is not compared, its last known state is kept
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, app.Status.Sync.Status)
+	assert.Nil(t, app.Status.ReconciledAt)
+}
+
+func TestProcessRequestedAppOperation_ClusterUnreachable(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, clusterCircuitBreaker: newOpenCircuitBreaker()}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Status.OperationState)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionClusterUnreachable, app.Status.Conditions[0].Type)
+}
@@ -31,6 +31,11 @@ const (
 
 var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
 
+// clusterCircuitBreakerSource provides the state of the circuit breakers of the clusters
+type clusterCircuitBreakerSource interface {
+	GetClusterCircuitBreaker(server string) appv1.ClusterCircuitBreaker
+}
+
 type clusterInfoUpdater struct {
 	infoSource    metrics.HasClustersInfo
 	db            db.ArgoDB
@@ -120,6 +125,10 @@ func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv
 	}
 
 	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, metav1.Now())
+	if breakerSource, ok := c.infoSource.(clusterCircuitBreakerSource); ok {
+		breaker := breakerSource.GetClusterCircuitBreaker(cluster.Server)
+		updated.CircuitBreaker = &breaker
+	}
 	return c.cache.SetClusterInfo(cluster.Server, &updated)
 }
 
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// comparisonInputsHashed holds everything, besides the live resources, which the diffs of the managed resources of an
+// application depend on
+type comparisonInputsHashed struct {
+	ComparedTo v1alpha1.ComparedTo
+	Manifests  [][]string
+	// NamespaceTemplates is the hash of the resources rendered from the managed namespace templates
+	NamespaceTemplates string
+	ResourceOverrides  map[string]v1alpha1.ResourceOverride
+	CompareOptions     settings.ArgoCDDiffOptions
+	CompareAnnotation  string
+	AppLabelKey        string
+	TrackingMethod     string
+	ServerSideDiff     bool
+}
+
+// newComparisonInputs returns the inputs of the comparison of an application against the given manifests. The live
+// resources are not part of the inputs: the diffs of the resources are only reused if their resource version is
+// unchanged.
+func newComparisonInputs(app *v1alpha1.Application, manifestInfos []*apiclient.ManifestResponse, manifestRevisions []string, namespaceTemplatesHash string, resourceOverrides map[string]v1alpha1.ResourceOverride, compareOptions settings.ArgoCDDiffOptions, appLabelKey string, trackingMethod string, serverSideDiff bool) (*appstatecache.ComparisonInputs, error) {
+	hashed := comparisonInputsHashed{
+		ComparedTo:         app.BuildComparedToStatus(),
+		NamespaceTemplates: namespaceTemplatesHash,
+		ResourceOverrides:  resourceOverrides,
+		CompareOptions:     compareOptions,
+		CompareAnnotation:  app.GetAnnotations()[common.AnnotationCompareOptions],
+		AppLabelKey:        appLabelKey,
+		TrackingMethod:     trackingMethod,
+		ServerSideDiff:     serverSideDiff,
+	}
+	for _, manifestInfo := range manifestInfos {
+		manifests := make([]string, 0, len(manifestInfo.Manifests))
+		for _, manifest := range manifestInfo.Manifests {
+			manifests = append(manifests, manifest.CompiledManifest)
+		}
+		hashed.Manifests = append(hashed.Manifests, manifests)
+	}
+	data, err := json.Marshal(hashed)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling comparison inputs: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return &appstatecache.ComparisonInputs{Revisions: manifestRevisions, Hash: hex.EncodeToString(hash[:])}, nil
+}
+
+// markCompared records that the given application has been compared by this controller and returns whether it is
+// the first comparison of the application since the controller started
+func (m *appStateManager) markCompared(appName string) bool {
+	_, compared := m.comparedApps.LoadOrStore(appName, true)
+	return !compared
+}
+
+// ForgetApp drops what the manager keeps in memory about the given application once it has been deleted
+func (m *appStateManager) ForgetApp(app *v1alpha1.Application) {
+	m.comparedApps.Delete(app.InstanceName(m.namespace))
+}
+
+// usePersistedDiffCache determines if the diffs cached by a previous run of the controller can be reused for the first
+// comparison of an application after the controller started. It is the case if the inputs of the comparison are the
+// same as the ones the cached diffs were computed from.
+func (m *appStateManager) usePersistedDiffCache(app *v1alpha1.Application, noCache bool, inputs *appstatecache.ComparisonInputs, logCtx *log.Entry) bool {
+	if noCache || inputs == nil {
+		return false
+	}
+	if _, refreshRequested := app.IsRefreshRequested(); refreshRequested {
+		return false
+	}
+	var persisted appstatecache.ComparisonInputs
+	if err := m.cache.GetAppComparisonInputs(app.InstanceName(m.namespace), &persisted); err != nil {
+		if !errors.Is(err, appstatecache.ErrCacheMiss) {
+			logCtx.Warnf("Failed to get the inputs of the previous comparison: %v", err)
+		}
+		return false
+	}
+	if persisted.Hash != inputs.Hash || !reflect.DeepEqual(persisted.Revisions, inputs.Revisions) {
+		logCtx.WithField("useDiffCache", "false").Debug("comparison inputs changed since the diffs were persisted")
+		return false
+	}
+	logCtx.WithField("useDiffCache", "true").Debug("using the diffs persisted by a previous comparison")
+	return true
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/test"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func TestNewComparisonInputs(t *testing.T) {
+	app := newFakeApp()
+	manifestInfos := func(manifest string) []*apiclient.ManifestResponse {
+		return []*apiclient.ManifestResponse{{Manifests: []*apiclient.Manifest{{CompiledManifest: manifest}}, Revision: "abc123"}}
+	}
+	newInputs := func(app *v1alpha1.Application, manifest string, overrides map[string]v1alpha1.ResourceOverride) *appstatecache.ComparisonInputs {
+		inputs, err := newComparisonInputs(app, manifestInfos(manifest), []string{"abc123"}, "", overrides, settings.GetDefaultDiffOptions(), common.LabelKeyAppInstance, "label", false)
+		require.NoError(t, err)
+		return inputs
+	}
+
+	inputs := newInputs(app, `{"kind":"ConfigMap"}`, nil)
+	assert.Equal(t, []string{"abc123"}, inputs.Revisions)
+	assert.Equal(t, inputs, newInputs(app, `{"kind":"ConfigMap"}`, nil))
+
+	t.Run("manifests changed", func(t *testing.T) {
+		assert.NotEqual(t, inputs.Hash, newInputs(app, `{"kind":"Secret"}`, nil).Hash)
+	})
+	t.Run("ignore differences changed", func(t *testing.T) {
+		changed := app.DeepCopy()
+		changed.Spec.IgnoreDifferences = v1alpha1.IgnoreDifferences{{Kind: "ConfigMap", JSONPointers: []string{"/data"}}}
+		assert.NotEqual(t, inputs.Hash, newInputs(changed, `{"kind":"ConfigMap"}`, nil).Hash)
+	})
+	t.Run("resource overrides changed", func(t *testing.T) {
+		overrides := map[string]v1alpha1.ResourceOverride{"ConfigMap": {IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/data"}}}}
+		assert.NotEqual(t, inputs.Hash, newInputs(app, `{"kind":"ConfigMap"}`, overrides).Hash)
+	})
+	t.Run("managed namespace templates changed", func(t *testing.T) {
+		changed, err := newComparisonInputs(app, manifestInfos(`{"kind":"ConfigMap"}`), []string{"abc123"}, "templates", nil, settings.GetDefaultDiffOptions(), common.LabelKeyAppInstance, "label", false)
+		require.NoError(t, err)
+		assert.NotEqual(t, inputs.Hash, changed.Hash)
+	})
+}
+
+func TestUsePersistedDiffCache(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: nil}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	inputs := &appstatecache.ComparisonInputs{Revisions: []string{"abc123"}, Hash: "hash"}
+	logCtx := log.WithField("application", app.Name)
+
+	assert.False(t, manager.usePersistedDiffCache(app, false, inputs, logCtx), "no persisted inputs")
+
+	require.NoError(t, ctrl.cache.SetAppComparisonInputs(app.InstanceName(ctrl.namespace), inputs))
+	assert.True(t, manager.usePersistedDiffCache(app, false, inputs, logCtx))
+	assert.False(t, manager.usePersistedDiffCache(app, true, inputs, logCtx), "no cache")
+	assert.False(t, manager.usePersistedDiffCache(app, false, nil, logCtx), "unknown inputs")
+	assert.False(t, manager.usePersistedDiffCache(app, false, &appstatecache.ComparisonInputs{Revisions: []string{"abc123"}, Hash: "other"}, logCtx))
+	assert.False(t, manager.usePersistedDiffCache(app, false, &appstatecache.ComparisonInputs{Revisions: []string{"def456"}, Hash: "hash"}, logCtx))
+
+	refreshed := app.DeepCopy()
+	refreshed.Annotations = map[string]string{v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal)}
+	assert.False(t, manager.usePersistedDiffCache(refreshed, false, inputs, logCtx), "refresh requested")
+}
+
+func TestCompareAppState_ComparisonInputs(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+
+	compRes, err := manager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false, false)
+	require.NoError(t, err)
+	require.NotNil(t, compRes.comparisonInputs)
+	assert.Equal(t, []string{"abc123"}, compRes.comparisonInputs.Revisions)
+	// the application has been compared since the controller started
+	assert.False(t, manager.markCompared(app.InstanceName(ctrl.namespace)))
+	// the application is compared for the first time again once deleted and recreated
+	manager.ForgetApp(app)
+	assert.True(t, manager.markCompared(app.InstanceName(ctrl.namespace)))
+
+	t.Run("local manifests", func(t *testing.T) {
+		compRes, err := manager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-map"}}`}, false, false)
+		require.NoError(t, err)
+		assert.Nil(t, compRes.comparisonInputs)
+	})
+}
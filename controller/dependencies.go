@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// dependsOnIndex indexes applications by the keys of the applications they depend on
+const dependsOnIndex = "dependsOn"
+
+// dependsOnIndexFunc returns the namespace/name keys of the applications the given application depends on
+func dependsOnIndexFunc(obj interface{}) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(app.Spec.DependsOn))
+	for _, dependency := range app.Spec.DependsOn {
+		keys = append(keys, dependency.Key(app))
+	}
+	return keys, nil
+}
+
+// requestDependentAppsRefresh requeues the applications depending on the given application, so that the syncs
+// waiting for it are started as soon as it reaches the required state
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		return
+	}
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, key)
+	if err != nil {
+		getAppLog(app).Warnf("Failed to list dependent applications: %v", err)
+		return
+	}
+	for _, obj := range objs {
+		dependent, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		if dependentKey, err := cache.MetaNamespaceKeyFunc(dependent); err == nil {
+			ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+			ctrl.appOperationQueue.AddRateLimited(dependentKey)
+		}
+	}
+}
+
+// getDependenciesCondition returns a condition describing why the dependencies of the application are not satisfied,
+// or nil if the application can be synced. A dependency cycle is reported as an InvalidSpecError condition.
+func (ctrl *ApplicationController) getDependenciesCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	if cycle := ctrl.findDependencyCycle(app); len(cycle) > 0 {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Application dependencies form a cycle: %s", strings.Join(cycle, " -> ")),
+		}
+	}
+	// the dependencies might be in namespaces or projects the users of the application are not allowed to see, so only
+	// their names are reported, not their status nor whether they exist
+	var unsatisfied []string
+	for _, dependency := range app.Spec.DependsOn {
+		key := dependency.Key(app)
+		dependencyApp, err := ctrl.getAppByKey(key)
+		if err != nil || dependencyApp == nil || !dependency.IsSatisfiedBy(dependencyApp) {
+			unsatisfied = append(unsatisfied, key)
+		}
+	}
+	if len(unsatisfied) == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionDependencyWarning,
+		Message: fmt.Sprintf("Waiting for dependencies to be satisfied: %s", strings.Join(unsatisfied, ", ")),
+	}
+}
+
+// findDependencyCycle returns the keys of the applications forming a dependency cycle through the given application,
+// or nil if there is no such cycle
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) []string {
+	appKey, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		return nil
+	}
+	visited := map[string]bool{}
+	var visit func(current *appv1.Application, path []string) []string
+	visit = func(current *appv1.Application, path []string) []string {
+		for _, dependency := range current.Spec.DependsOn {
+			key := dependency.Key(current)
+			if key == appKey {
+				return append(path, key)
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			dependencyApp, err := ctrl.getAppByKey(key)
+			if err != nil || dependencyApp == nil {
+				continue
+			}
+			if cycle := visit(dependencyApp, append(path, key)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(app, []string{appKey})
+}
+
+func (ctrl *ApplicationController) getAppByKey(key string) (*appv1.Application, error) {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, fmt.Errorf("key '%s' in index is not an application", key)
+	}
+	return app, nil
+}
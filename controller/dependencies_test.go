@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeDependencyApp(name string, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Status.Health.Status = healthStatus
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	for _, dependency := range dependsOn {
+		app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dependency})
+	}
+	return app
+}
+
+func TestGetDependenciesCondition(t *testing.T) {
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newFakeDependencyApp("app", health.HealthStatusHealthy)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.Nil(t, ctrl.getDependenciesCondition(app))
+	})
+
+	t.Run("Satisfied", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", health.HealthStatusHealthy)
+		app := newFakeDependencyApp("app", health.HealthStatusHealthy, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+
+		assert.Nil(t, ctrl.getDependenciesCondition(app))
+	})
+
+	t.Run("NotHealthy", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", health.HealthStatusProgressing)
+		app := newFakeDependencyApp("app", health.HealthStatusHealthy, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+
+		cond := ctrl.getDependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, cond.Type)
+		assert.Equal(t, "Waiting for dependencies to be satisfied: fake-argocd-ns/crds", cond.Message)
+	})
+
+	t.Run("SyncRequirementOnly", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", health.HealthStatusProgressing)
+		app := newFakeDependencyApp("app", health.HealthStatusHealthy)
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "crds", Sync: v1alpha1.SyncStatusCodeSynced}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+
+		assert.Nil(t, ctrl.getDependenciesCondition(app))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		app := newFakeDependencyApp("app", health.HealthStatusHealthy, "crds")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond := ctrl.getDependenciesCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Waiting for dependencies to be satisfied: fake-argocd-ns/crds", cond.Message)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		a := newFakeDependencyApp("a", health.HealthStatusHealthy, "b")
+		b := newFakeDependencyApp("b", health.HealthStatusHealthy, "c")
+		c := newFakeDependencyApp("c", health.HealthStatusHealthy, "a")
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{a, b, c}}, nil)
+
+		cond := ctrl.getDependenciesCondition(a)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, cond.Type)
+		assert.Equal(t, "Application dependencies form a cycle: fake-argocd-ns/a -> fake-argocd-ns/b -> fake-argocd-ns/c -> fake-argocd-ns/a", cond.Message)
+	})
+}
+
+func TestRefreshAppConditions_DependencyCycle(t *testing.T) {
+	a := newFakeDependencyApp("a", health.HealthStatusHealthy, "a")
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{a, &defaultProj}}, nil)
+
+	_, hasErrors := ctrl.refreshAppConditions(a)
+	assert.True(t, hasErrors)
+	require.Len(t, a.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, a.Status.Conditions[0].Type)
+}
+
+func TestAutoSync_WaitsForDependencies(t *testing.T) {
+	crds := newFakeDependencyApp("crds", health.HealthStatusDegraded)
+	app := newFakeDependencyApp("my-app", health.HealthStatusHealthy, "crds")
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestProcessRequestedAppOperation_WaitsForDependencies(t *testing.T) {
+	crds := newFakeDependencyApp("crds", health.HealthStatusProgressing)
+	app := newFakeDependencyApp("my-app", health.HealthStatusHealthy, "crds")
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds, &defaultProj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Status.OperationState)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, app.Status.Conditions[0].Type)
+}
+
+func TestRequestDependentAppsRefresh(t *testing.T) {
+	crds := newFakeDependencyApp("crds", health.HealthStatusHealthy)
+	app := newFakeDependencyApp("my-app", health.HealthStatusHealthy, "crds")
+	other := newFakeDependencyApp("other", health.HealthStatusHealthy)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, crds, other}}, nil)
+
+	ctrl.requestDependentAppsRefresh(crds)
+
+	ok, level := ctrl.isRefreshRequested(ctrl.toAppKey(app.Name))
+	assert.True(t, ok)
+	assert.Equal(t, CompareWithRecent, level)
+	ok, _ = ctrl.isRefreshRequested(ctrl.toAppKey(other.Name))
+	assert.False(t, ok)
+}
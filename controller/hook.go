@@ -2,6 +2,9 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -17,23 +20,42 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
-var (
-	postDeleteHook  = "PostDelete"
-	postDeleteHooks = map[string]string{
-		"argocd.argoproj.io/hook": postDeleteHook,
-		"helm.sh/hook":            "post-delete",
-	}
+// deleteHookType is the type of the hooks executed by the controller while an application is being deleted
+type deleteHookType string
+
+const (
+	preDeleteHookType  deleteHookType = "PreDelete"
+	postDeleteHookType deleteHookType = "PostDelete"
 )
 
+var deleteHookAnnotations = map[deleteHookType]map[string]string{
+	preDeleteHookType: {
+		"argocd.argoproj.io/hook": string(preDeleteHookType),
+		"helm.sh/hook":            "pre-delete",
+	},
+	postDeleteHookType: {
+		"argocd.argoproj.io/hook": string(postDeleteHookType),
+		"helm.sh/hook":            "post-delete",
+	},
+}
+
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
+	return hook.IsHook(obj) || isPreDeleteHook(obj) || isPostDeleteHook(obj)
+}
+
+func isPreDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHookOfType(obj, preDeleteHookType)
 }
 
 func isPostDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHookOfType(obj, postDeleteHookType)
+}
+
+func isDeleteHookOfType(obj *unstructured.Unstructured, hookType deleteHookType) bool {
 	if obj == nil || obj.GetAnnotations() == nil {
 		return false
 	}
-	for k, v := range postDeleteHooks {
+	for k, v := range deleteHookAnnotations[hookType] {
 		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
 			return true
 		}
@@ -41,7 +63,14 @@ func isPostDeleteHook(obj *unstructured.Unstructured) bool {
 	return false
 }
 
-func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+// logName returns the name of the hook type as used in log messages, e.g. post-delete
+func (hookType deleteHookType) logName() string {
+	return deleteHookAnnotations[hookType]["helm.sh/hook"]
+}
+
+// executeDeleteHooks creates the delete hooks of the given type which are not running yet and returns whether all of
+// them have completed. A failed pre-delete hook blocks the deletion of the application and is reported as an error.
+func (ctrl *ApplicationController) executeDeleteHooks(hookType deleteHookType, app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return false, err
@@ -57,7 +86,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if isDeleteHookOfType(obj, hookType) {
 			runningHooks[key] = obj
 		}
 	}
@@ -67,7 +96,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(app.Spec.Destination.Namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !isDeleteHookOfType(obj, hookType) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -83,7 +112,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		createdCnt++
 	}
 	if createdCnt > 0 {
-		logCtx.Infof("Created %d post-delete hooks", createdCnt)
+		logCtx.Infof("Created %d %s hooks", createdCnt, hookType.logName())
 		return false, nil
 	}
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
@@ -93,6 +122,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 
 	progressingHooksCnt := 0
+	var failedHooks []string
 	for _, obj := range runningHooks {
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
 		if err != nil {
@@ -113,16 +143,25 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if hookHealth.Status == health.HealthStatusProgressing {
 			progressingHooksCnt++
 		}
+		if hookHealth.Status == health.HealthStatusDegraded && hookType == preDeleteHookType {
+			failedHooks = append(failedHooks, fmt.Sprintf("%s/%s: %s", obj.GetKind(), obj.GetName(), hookHealth.Message))
+		}
 	}
 	if progressingHooksCnt > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to complete", progressingHooksCnt)
+		logCtx.Infof("Waiting for %d %s hooks to complete", progressingHooksCnt, hookType.logName())
 		return false, nil
 	}
+	if len(failedHooks) > 0 {
+		sort.Strings(failedHooks)
+		return false, fmt.Errorf("%s hooks failed, fix the hook spec and delete the failed hooks to run them again, or remove the %s finalizer from the application to skip them: %s", hookType.logName(), v1alpha1.PreDeleteFinalizerName, strings.Join(failedHooks, ", "))
+	}
 
 	return true, nil
 }
 
-func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+// cleanupDeleteHooks deletes the delete hooks of the given type whose delete policy matches their aggregated health
+// and returns whether they are gone
+func (ctrl *ApplicationController) cleanupDeleteHooks(hookType deleteHookType, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return false, err
@@ -133,7 +172,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 	aggregatedHealth := health.HealthStatusHealthy
 	var hooks []*unstructured.Unstructured
 	for _, obj := range liveObjs {
-		if !isPostDeleteHook(obj) {
+		if !isDeleteHookOfType(obj, hookType) {
 			continue
 		}
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
@@ -158,7 +197,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 				if obj.GetDeletionTimestamp() != nil {
 					continue
 				}
-				logCtx.Infof("Deleting post-delete hook %s/%s", obj.GetNamespace(), obj.GetName())
+				logCtx.Infof("Deleting %s hook %s/%s", hookType.logName(), obj.GetNamespace(), obj.GetName())
 				err = ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), v1.DeleteOptions{})
 				if err != nil {
 					return false, err
@@ -167,7 +206,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 		}
 	}
 	if pendingDeletionCount > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to be deleted", pendingDeletionCount)
+		logCtx.Infof("Waiting for %d %s hooks to be deleted", pendingDeletionCount, hookType.logName())
 		return false, nil
 	}
 	return true, nil
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/sharding"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+	settings_util "github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// LocalAppState computes the live state of applications in the current process, using the comparison logic of the
+// application controller over a local cache of the destination clusters. It allows serving the managed resources and
+// the resource tree of applications without a running application controller.
+type LocalAppState struct {
+	ctrl *ApplicationController
+}
+
+// NewLocalAppState creates a LocalAppState which stores the computed state into the given cache. The informers of the
+// applications and projects are stopped once the given context is done.
+func NewLocalAppState(ctx context.Context, namespace string, settingsMgr *settings_util.SettingsManager, kubeClientset kubernetes.Interface, applicationClientset appclientset.Interface, repoClientset apiclient.Clientset, argoCache *appstatecache.Cache) (*LocalAppState, error) {
+	ctrl, err := NewApplicationController(
+		namespace,
+		settingsMgr,
+		kubeClientset,
+		applicationClientset,
+		repoClientset,
+		argoCache,
+		kubeutil.NewKubectl(),
+		time.Hour,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		nil,
+		0,
+		false,
+		sharding.NewClusterSharding(nil, 0, 1, common.DefaultShardingAlgorithm),
+		nil,
+		nil,
+		false,
+		false,
+		normalizers.IgnoreNormalizerOpts{JQExecutionTimeout: normalizers.DefaultJQExecutionTimeout},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating application controller: %w", err)
+	}
+	go ctrl.appInformer.Run(ctx.Done())
+	go ctrl.projInformer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced, ctrl.projInformer.HasSynced) {
+		return nil, fmt.Errorf("timed out waiting for the application and project informers to sync")
+	}
+	if err := ctrl.stateCache.Init(); err != nil {
+		return nil, fmt.Errorf("error initializing live state cache: %w", err)
+	}
+	return &LocalAppState{ctrl: ctrl}, nil
+}
+
+// Refresh compares the live state of the given application with its target state, and stores the managed resources
+// and the resource tree of the application into the cache.
+func (s *LocalAppState) Refresh(ctx context.Context, appName string) error {
+	name, namespace := argo.ParseInstanceName(appName, s.ctrl.namespace)
+	app, err := s.ctrl.applicationClientset.ArgoprojV1alpha1().Applications(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting application %s: %w", appName, err)
+	}
+	project, err := s.ctrl.getAppProj(app)
+	if err != nil {
+		return fmt.Errorf("error getting project of application %s: %w", appName, err)
+	}
+	var localManifests []string
+	if opState := app.Status.OperationState; opState != nil && opState.Operation.Sync != nil {
+		localManifests = opState.Operation.Sync.Manifests
+	}
+	sources, revisions := getComparedSources(app, CompareWithLatest)
+	compareResult, err := s.ctrl.appStateManager.CompareAppState(app, project, revisions, sources, false, false, localManifests, app.Spec.HasMultipleSources(), false)
+	if err != nil {
+		return fmt.Errorf("error comparing the state of application %s: %w", appName, err)
+	}
+	if _, err := s.ctrl.setAppManagedResources(app, compareResult); err != nil {
+		return fmt.Errorf("error setting the managed resources of application %s: %w", appName, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestLocalAppStateRefresh(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{{CompiledManifest: testingutils.PodManifest}},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	state := &LocalAppState{ctrl: ctrl}
+
+	require.NoError(t, state.Refresh(context.Background(), app.InstanceName(ctrl.namespace)))
+
+	var managedResources []*v1alpha1.ResourceDiff
+	require.NoError(t, ctrl.cache.GetAppManagedResources(app.InstanceName(ctrl.namespace), &managedResources))
+	require.Len(t, managedResources, 1)
+	assert.Equal(t, "Pod", managedResources[0].Kind)
+	var tree v1alpha1.ApplicationTree
+	require.NoError(t, ctrl.cache.GetAppResourcesTree(app.InstanceName(ctrl.namespace), &tree))
+
+	err := state.Refresh(context.Background(), "unknown-app")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error getting application unknown-app")
+}
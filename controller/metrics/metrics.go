@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -29,17 +30,24 @@ import (
 
 type MetricsServer struct {
 	*http.Server
-	syncCounter             *prometheus.CounterVec
-	kubectlExecCounter      *prometheus.CounterVec
-	kubectlExecPendingGauge *prometheus.GaugeVec
-	k8sRequestCounter       *prometheus.CounterVec
-	clusterEventsCounter    *prometheus.CounterVec
-	redisRequestCounter     *prometheus.CounterVec
-	reconcileHistogram      *prometheus.HistogramVec
-	redisRequestHistogram   *prometheus.HistogramVec
-	registry                *prometheus.Registry
-	hostname                string
-	cron                    *cron.Cron
+	syncCounter                 *prometheus.CounterVec
+	kubectlExecCounter          *prometheus.CounterVec
+	kubectlExecPendingGauge     *prometheus.GaugeVec
+	k8sRequestCounter           *prometheus.CounterVec
+	clusterEventsCounter        *prometheus.CounterVec
+	redisRequestCounter         *prometheus.CounterVec
+	reconcileHistogram          *prometheus.HistogramVec
+	operationQueueWaitHistogram *prometheus.HistogramVec
+	reconcilePhaseHistogram     *prometheus.HistogramVec
+	comparisonCacheCounter      *prometheus.CounterVec
+	circuitBreakerGauge         *prometheus.GaugeVec
+	redisRequestHistogram       *prometheus.HistogramVec
+	registry                    *prometheus.Registry
+	hostname                    string
+	cron                        *cron.Cron
+	// reconcilePhaseExemplars tells whether the name of the application is attached as exemplar to the reconcile
+	// phase observations
+	reconcilePhaseExemplars bool
 }
 
 const (
@@ -47,6 +55,17 @@ const (
 	MetricsPath = "/metrics"
 	// EnvVarLegacyControllerMetrics is a env var to re-enable deprecated prometheus metrics
 	EnvVarLegacyControllerMetrics = "ARGOCD_LEGACY_CONTROLLER_METRICS"
+	// EnvVarReconcilePhaseExemplars is a env var to attach the application name as exemplar to the reconcile phase
+	// metrics, which requires the metrics to be scraped in the OpenMetrics format
+	EnvVarReconcilePhaseExemplars = "ARGOCD_CONTROLLER_RECONCILE_PHASE_EXEMPLARS"
+
+	// ComparisonCacheHit is the result of a comparison which reused the diffs of the previous comparison
+	ComparisonCacheHit = "hit"
+	// ComparisonCachePersistedHit is the result of a comparison which reused the diffs persisted by a previous run of
+	// the controller
+	ComparisonCachePersistedHit = "persisted_hit"
+	// ComparisonCacheMiss is the result of a comparison which computed all the diffs
+	ComparisonCacheMiss = "miss"
 )
 
 // Follow Prometheus naming practices
@@ -62,6 +81,12 @@ var (
 		append(descAppDefaultLabels, "autosync_enabled", "repo", "dest_server", "dest_namespace", "sync_status", "health_status", "operation"),
 		nil,
 	)
+	descAppPaused = prometheus.NewDesc(
+		"argocd_app_paused",
+		"Whether the reconciliation of the application is paused.",
+		descAppDefaultLabels,
+		nil,
+	)
 	// Deprecated
 	descAppCreated = prometheus.NewDesc(
 		"argocd_app_created_time",
@@ -84,6 +109,31 @@ var (
 		nil,
 	)
 
+	descAppResourceRequests = prometheus.NewDesc(
+		"argocd_app_resource_requests",
+		"Total of the resources requested by the application child resources. CPU is in cores, memory and storage are in bytes.",
+		append(descAppDefaultLabels, "dest_server", "dest_namespace", "resource", "unit"),
+		nil,
+	)
+	descAppResourceLimits = prometheus.NewDesc(
+		"argocd_app_resource_limits",
+		"Total of the resource limits of the application child resources. CPU is in cores, memory is in bytes.",
+		append(descAppDefaultLabels, "dest_server", "dest_namespace", "resource", "unit"),
+		nil,
+	)
+	descAppPods = prometheus.NewDesc(
+		"argocd_app_pods",
+		"Number of pods of the application which are not completed, i.e. pending or running.",
+		append(descAppDefaultLabels, "dest_server", "dest_namespace"),
+		nil,
+	)
+	descAppReplicas = prometheus.NewDesc(
+		"argocd_app_replicas",
+		"Total of the desired replicas of the application workloads.",
+		append(descAppDefaultLabels, "dest_server", "dest_namespace"),
+		nil,
+	)
+
 	syncCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_sync_total",
@@ -120,6 +170,40 @@ var (
 		[]string{"namespace", "dest_server"},
 	)
 
+	operationQueueWaitHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_operation_queue_wait",
+			Help:    "Time in seconds the application operations waited in the operation queue, by project.",
+			Buckets: []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"project", "priority_class"},
+	)
+
+	reconcilePhaseHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_reconcile_phase",
+			Help:    "Application reconciliation performance in seconds, by reconciliation phase.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, .5, 1, 2, 4, 8, 16},
+		},
+		[]string{"namespace", "dest_server", "phase"},
+	)
+
+	comparisonCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_comparison_cache_total",
+			Help: "Number of application comparisons, by whether they reused the diffs cached by a previous comparison.",
+		},
+		[]string{"namespace", "dest_server", "result"},
+	)
+
+	circuitBreakerGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_cluster_circuit_breaker_state",
+			Help: "State of the circuit breaker of the cluster, 1 for the current state.",
+		},
+		[]string{"server", "state"},
+	)
+
 	clusterEventsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "argocd_cluster_events_total",
 		Help: "Number of processes k8s resource events.",
@@ -162,13 +246,17 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 
 	mux := http.NewServeMux()
 	registry := NewAppRegistry(appLister, appFilter, appLabels)
+	reconcilePhaseExemplars := os.Getenv(EnvVarReconcilePhaseExemplars) == "true"
 
 	mux.Handle(MetricsPath, promhttp.HandlerFor(prometheus.Gatherers{
 		// contains app controller specific metrics
 		registry,
 		// contains workqueue metrics, process and golang metrics
 		ctrl_metrics.Registry,
-	}, promhttp.HandlerOpts{}))
+	}, promhttp.HandlerOpts{
+		// exemplars are only exposed in the OpenMetrics format
+		EnableOpenMetrics: reconcilePhaseExemplars,
+	}))
 	profile.RegisterProfiler(mux)
 	healthz.ServeHealthCheck(mux, healthCheck)
 
@@ -177,6 +265,10 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
 	registry.MustRegister(reconcileHistogram)
+	registry.MustRegister(reconcilePhaseHistogram)
+	registry.MustRegister(operationQueueWaitHistogram)
+	registry.MustRegister(comparisonCacheCounter)
+	registry.MustRegister(circuitBreakerGauge)
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
@@ -187,15 +279,20 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 			Addr:    addr,
 			Handler: mux,
 		},
-		syncCounter:             syncCounter,
-		k8sRequestCounter:       k8sRequestCounter,
-		kubectlExecCounter:      kubectlExecCounter,
-		kubectlExecPendingGauge: kubectlExecPendingGauge,
-		reconcileHistogram:      reconcileHistogram,
-		clusterEventsCounter:    clusterEventsCounter,
-		redisRequestCounter:     redisRequestCounter,
-		redisRequestHistogram:   redisRequestHistogram,
-		hostname:                hostname,
+		syncCounter:                 syncCounter,
+		k8sRequestCounter:           k8sRequestCounter,
+		kubectlExecCounter:          kubectlExecCounter,
+		kubectlExecPendingGauge:     kubectlExecPendingGauge,
+		reconcileHistogram:          reconcileHistogram,
+		reconcilePhaseHistogram:     reconcilePhaseHistogram,
+		operationQueueWaitHistogram: operationQueueWaitHistogram,
+		reconcilePhaseExemplars:     reconcilePhaseExemplars,
+		comparisonCacheCounter:      comparisonCacheCounter,
+		circuitBreakerGauge:         circuitBreakerGauge,
+		clusterEventsCounter:        clusterEventsCounter,
+		redisRequestCounter:         redisRequestCounter,
+		redisRequestHistogram:       redisRequestHistogram,
+		hostname:                    hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
 		// so there is no possibility of panic, but we will add a chain to keep robfig/cron v1 behavior.
@@ -276,6 +373,47 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// ObserveReconcilePhase observes the duration of a phase of the reconciliation of an application
+func (m *MetricsServer) ObserveReconcilePhase(app *argoappv1.Application, phase string, duration time.Duration) {
+	observer := m.reconcilePhaseHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server, phase)
+	exemplar := prometheus.Labels{"namespace": app.Namespace, "name": app.Name}
+	// the exemplar labels are limited in size, the observation is then recorded without exemplar
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && m.reconcilePhaseExemplars &&
+		len("namespace")+len(app.Namespace)+len("name")+len(app.Name) <= prometheus.ExemplarMaxRunes {
+		exemplarObserver.ObserveWithExemplar(duration.Seconds(), exemplar)
+		return
+	}
+	observer.Observe(duration.Seconds())
+}
+
+// ObserveOperationQueueWait observes the time an operation of an application of the given project waited in the
+// operation queue
+func (m *MetricsServer) ObserveOperationQueueWait(project string, priorityClass string, duration time.Duration) {
+	m.operationQueueWaitHistogram.WithLabelValues(project, priorityClass).Observe(duration.Seconds())
+}
+
+// IncComparisonCache increments the comparison cache counter for an application. The result is one of
+// ComparisonCacheHit, ComparisonCachePersistedHit and ComparisonCacheMiss.
+func (m *MetricsServer) IncComparisonCache(app *argoappv1.Application, result string) {
+	m.comparisonCacheCounter.WithLabelValues(app.Namespace, app.Spec.Destination.Server, result).Inc()
+}
+
+// SetClusterCircuitBreakerState sets the state of the circuit breaker of a cluster
+func (m *MetricsServer) SetClusterCircuitBreakerState(server string, state string) {
+	for _, s := range []string{argoappv1.ClusterCircuitBreakerStateClosed, argoappv1.ClusterCircuitBreakerStateOpen, argoappv1.ClusterCircuitBreakerStateHalfOpen} {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		m.circuitBreakerGauge.WithLabelValues(server, s).Set(value)
+	}
+}
+
+// DeleteClusterCircuitBreakerState removes the state of the circuit breaker of a cluster which is not managed anymore
+func (m *MetricsServer) DeleteClusterCircuitBreakerState(server string) {
+	m.circuitBreakerGauge.DeletePartialMatch(prometheus.Labels{"server": server})
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
@@ -296,6 +434,9 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.clusterEventsCounter.Reset()
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
+		m.reconcilePhaseHistogram.Reset()
+		m.operationQueueWaitHistogram.Reset()
+		m.comparisonCacheCounter.Reset()
 		m.redisRequestHistogram.Reset()
 	})
 	if err != nil {
@@ -334,6 +475,11 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 		ch <- descAppLabels
 	}
 	ch <- descAppInfo
+	ch <- descAppPaused
+	ch <- descAppResourceRequests
+	ch <- descAppResourceLimits
+	ch <- descAppPods
+	ch <- descAppReplicas
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
 }
@@ -359,6 +505,18 @@ func boolFloat64(b bool) float64 {
 	return 0
 }
 
+// quantityFloat64 returns the value of a Kubernetes quantity, or 0 if it is empty or cannot be parsed
+func quantityFloat64(value string) float64 {
+	if value == "" {
+		return 0
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0
+	}
+	return quantity.AsApproximateFloat64()
+}
+
 func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 	addConstMetric := func(desc *prometheus.Desc, t prometheus.ValueType, v float64, lv ...string) {
 		project := app.Spec.GetProject()
@@ -387,6 +545,18 @@ func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.A
 	autoSyncEnabled := app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil
 
 	addGauge(descAppInfo, 1, strconv.FormatBool(autoSyncEnabled), git.NormalizeGitURL(app.Spec.GetSource().RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
+	addGauge(descAppPaused, boolFloat64(app.Spec.Pause.IsActive(time.Now())))
+
+	if capacity := app.Status.Summary.Capacity; capacity != nil {
+		dest := app.Spec.Destination
+		addGauge(descAppResourceRequests, quantityFloat64(capacity.CPURequests), dest.Server, dest.Namespace, "cpu", "core")
+		addGauge(descAppResourceRequests, quantityFloat64(capacity.MemoryRequests), dest.Server, dest.Namespace, "memory", "byte")
+		addGauge(descAppResourceRequests, quantityFloat64(capacity.Storage), dest.Server, dest.Namespace, "storage", "byte")
+		addGauge(descAppResourceLimits, quantityFloat64(capacity.CPULimits), dest.Server, dest.Namespace, "cpu", "core")
+		addGauge(descAppResourceLimits, quantityFloat64(capacity.MemoryLimits), dest.Server, dest.Namespace, "memory", "byte")
+		addGauge(descAppPods, float64(capacity.Pods), dest.Server, dest.Namespace)
+		addGauge(descAppReplicas, float64(capacity.Replicas), dest.Server, dest.Namespace)
+	}
 
 	if len(c.appLabels) > 0 {
 		labelValues := []string{}
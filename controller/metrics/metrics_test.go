@@ -74,6 +74,8 @@ spec:
     automated:
       selfHeal: false
       prune: true
+  pause:
+    reason: maintenance
 status:
   sync:
     status: Synced
@@ -248,6 +250,10 @@ func TestMetrics(t *testing.T) {
 argocd_app_info{autosync_enabled="true",dest_namespace="dummy-namespace",dest_server="https://localhost:6443",health_status="Degraded",name="my-app-3",namespace="argocd",operation="delete",project="important-project",repo="https://github.com/argoproj/argocd-example-apps",sync_status="OutOfSync"} 1
 argocd_app_info{autosync_enabled="false",dest_namespace="dummy-namespace",dest_server="https://localhost:6443",health_status="Healthy",name="my-app",namespace="argocd",operation="",project="important-project",repo="https://github.com/argoproj/argocd-example-apps",sync_status="Synced"} 1
 argocd_app_info{autosync_enabled="true",dest_namespace="dummy-namespace",dest_server="https://localhost:6443",health_status="Healthy",name="my-app-2",namespace="argocd",operation="sync",project="important-project",repo="https://github.com/argoproj/argocd-example-apps",sync_status="Synced"} 1
+# HELP argocd_app_paused Whether the reconciliation of the application is paused.
+# TYPE argocd_app_paused gauge
+argocd_app_paused{name="my-app",namespace="argocd",project="important-project"} 0
+argocd_app_paused{name="my-app-2",namespace="argocd",project="important-project"} 1
 `,
 		},
 		{
@@ -265,6 +271,33 @@ argocd_app_info{autosync_enabled="false",dest_namespace="dummy-namespace",dest_s
 	}
 }
 
+func TestMetricsAppCapacity(t *testing.T) {
+	app := fakeApp + `
+  summary:
+    capacity:
+      cpuRequests: 1500m
+      cpuLimits: "3"
+      memoryRequests: 1Gi
+      storage: 10Gi
+      pods: 3
+      replicas: 3
+`
+	expectedResponse := `
+# HELP argocd_app_pods Number of pods of the application which are not completed, i.e. pending or running.
+# TYPE argocd_app_pods gauge
+argocd_app_pods{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project"} 3
+# HELP argocd_app_replicas Total of the desired replicas of the application workloads.
+# TYPE argocd_app_replicas gauge
+argocd_app_replicas{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project"} 3
+argocd_app_resource_limits{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",resource="cpu",unit="core"} 3
+argocd_app_resource_limits{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",resource="memory",unit="byte"} 0
+argocd_app_resource_requests{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",resource="cpu",unit="core"} 1.5
+argocd_app_resource_requests{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",resource="memory",unit="byte"} 1.073741824e+09
+argocd_app_resource_requests{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",resource="storage",unit="byte"} 1.073741824e+10
+`
+	testApp(t, []string{app}, expectedResponse)
+}
+
 func TestMetricLabels(t *testing.T) {
 	type testCases struct {
 		testCombination
@@ -417,6 +450,53 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestReconcilePhaseMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	require.NoError(t, err)
+
+	appReconcilePhaseMetrics := `
+# HELP argocd_app_reconcile_phase Application reconciliation performance in seconds, by reconciliation phase.
+# TYPE argocd_app_reconcile_phase histogram
+argocd_app_reconcile_phase_bucket{dest_server="https://localhost:6443",namespace="argocd",phase="git",le="0.25"} 0
+argocd_app_reconcile_phase_bucket{dest_server="https://localhost:6443",namespace="argocd",phase="git",le="0.5"} 1
+argocd_app_reconcile_phase_sum{dest_server="https://localhost:6443",namespace="argocd",phase="git"} 0.3
+argocd_app_reconcile_phase_count{dest_server="https://localhost:6443",namespace="argocd",phase="git"} 1
+`
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.ObserveReconcilePhase(fakeApp, "git", 300*time.Millisecond)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, appReconcilePhaseMetrics, body)
+	assert.NotContains(t, body, `# {namespace="argocd",name="my-app"}`)
+}
+
+func TestReconcilePhaseMetricsWithExemplars(t *testing.T) {
+	t.Setenv(EnvVarReconcilePhaseExemplars, "true")
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	require.NoError(t, err)
+
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.ObserveReconcilePhase(fakeApp, "diff", 300*time.Millisecond)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	// the order of the exemplar labels is not stable
+	assert.Regexp(t, `argocd_app_reconcile_phase_bucket\{dest_server="https://localhost:6443",namespace="argocd",phase="diff",le="0.5"\} 1 # \{(namespace="argocd",name="my-app"|name="my-app",namespace="argocd")\} 0.3`, rr.Body.String())
+}
+
 func TestMetricsReset(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// operationPriorityClasses lists the priority classes from the highest to the lowest priority
+var operationPriorityClasses = []appv1.OperationPriorityClass{
+	appv1.OperationPriorityClassHigh,
+	appv1.OperationPriorityClassNormal,
+	appv1.OperationPriorityClassLow,
+}
+
+type scheduledOperation struct {
+	key        string
+	project    string
+	priority   appv1.OperationPriorityClass
+	enqueuedAt time.Time
+}
+
+// projectOperations holds the pending operations of a priority class, by project
+type projectOperations struct {
+	// projects lists the projects with pending operations in the order they are served
+	projects []string
+	// next is the index of the project served next
+	next    int
+	pending map[string][]scheduledOperation
+}
+
+// operationScheduler schedules the operations of the applications across projects. The operations of a priority class
+// are only processed once no operation of a higher priority class can be processed. Within a priority class, the
+// projects are served in turn, so that the operations of a project are not delayed by the many operations of another
+// one, and a project never has more operations in progress than its maximum number of concurrent operations. An
+// operation is in progress from the time it is started until it is completed, across the many times it is processed.
+type operationScheduler struct {
+	lock     sync.Mutex
+	cond     *sync.Cond
+	shutdown bool
+	classes  map[appv1.OperationPriorityClass]*projectOperations
+	// maxConcurrent holds the last known maximum number of concurrent operations of the projects
+	maxConcurrent map[string]int64
+	// running holds the number of operations in progress by project
+	running map[string]int64
+	// started holds the projects of the operations in progress, by key
+	started map[string]string
+	// scheduled holds the keys of the operations pending or in progress
+	scheduled map[string]scheduledOperation
+	// observeWait is called with the time an operation waited once it is processed
+	observeWait func(project string, priority appv1.OperationPriorityClass, wait time.Duration)
+	now         func() time.Time
+}
+
+func newOperationScheduler(observeWait func(project string, priority appv1.OperationPriorityClass, wait time.Duration)) *operationScheduler {
+	s := &operationScheduler{
+		classes:       make(map[appv1.OperationPriorityClass]*projectOperations),
+		maxConcurrent: make(map[string]int64),
+		running:       make(map[string]int64),
+		started:       make(map[string]string),
+		scheduled:     make(map[string]scheduledOperation),
+		observeWait:   observeWait,
+		now:           time.Now,
+	}
+	s.cond = sync.NewCond(&s.lock)
+	for _, priority := range operationPriorityClasses {
+		s.classes[priority] = &projectOperations{pending: make(map[string][]scheduledOperation)}
+	}
+	return s
+}
+
+// Add schedules the operation of the application with the given key, using the operations policy of its project. It
+// is a no-op if the operation is already pending or being processed. An operation which was started, e.g. before the
+// controller restarted, counts as in progress and is processed whatever the number of operations in progress.
+func (s *operationScheduler) Add(key string, project string, policy *appv1.ProjectOperationsPolicy, started bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.shutdown {
+		return
+	}
+	s.maxConcurrent[project] = policy.GetMaxConcurrent()
+	if started {
+		s.start(key, project)
+	}
+	if _, ok := s.scheduled[key]; ok {
+		return
+	}
+	priority := policy.GetPriorityClass()
+	operations, ok := s.classes[priority]
+	if !ok {
+		priority = appv1.OperationPriorityClassNormal
+		operations = s.classes[priority]
+	}
+	op := scheduledOperation{key: key, project: project, priority: priority, enqueuedAt: s.now()}
+	s.scheduled[key] = op
+	if len(operations.pending[project]) == 0 {
+		operations.projects = append(operations.projects, project)
+	}
+	operations.pending[project] = append(operations.pending[project], op)
+	s.cond.Signal()
+}
+
+// canRun returns true if another operation of the project can be started
+func (s *operationScheduler) canRun(project string) bool {
+	maxConcurrent := s.maxConcurrent[project]
+	return maxConcurrent == 0 || s.running[project] < maxConcurrent
+}
+
+// start counts the operation with the given key as in progress, unless it already is
+func (s *operationScheduler) start(key string, project string) {
+	if _, ok := s.started[key]; ok {
+		return
+	}
+	s.started[key] = project
+	s.running[project]++
+}
+
+// nextPending returns the index of the next pending operation of the project which can be processed, or -1
+func (s *operationScheduler) nextPending(project string, pending []scheduledOperation) int {
+	if s.canRun(project) {
+		return 0
+	}
+	// the operations in progress are processed until they complete
+	for i := range pending {
+		if _, ok := s.started[pending[i].key]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// pop returns the next operation to process, if any can be processed
+func (s *operationScheduler) pop() (scheduledOperation, bool) {
+	for _, priority := range operationPriorityClasses {
+		operations := s.classes[priority]
+		for i := 0; i < len(operations.projects); i++ {
+			index := (operations.next + i) % len(operations.projects)
+			project := operations.projects[index]
+			pending := operations.pending[project]
+			next := s.nextPending(project, pending)
+			if next < 0 {
+				continue
+			}
+			op := pending[next]
+			operations.pending[project] = append(pending[:next:next], pending[next+1:]...)
+			if len(operations.pending[project]) == 0 {
+				delete(operations.pending, project)
+				operations.projects = append(operations.projects[:index], operations.projects[index+1:]...)
+			} else {
+				index++
+			}
+			operations.next = 0
+			if len(operations.projects) > 0 {
+				operations.next = index % len(operations.projects)
+			}
+			return op, true
+		}
+	}
+	return scheduledOperation{}, false
+}
+
+// Get blocks until an operation can be processed, and returns the key of its application. The caller must call Done
+// once the operation is processed. Returns true if the scheduler is shut down.
+func (s *operationScheduler) Get() (string, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for {
+		if s.shutdown {
+			return "", true
+		}
+		if op, ok := s.pop(); ok {
+			s.start(op.key, op.project)
+			if s.observeWait != nil {
+				s.observeWait(op.project, op.priority, s.now().Sub(op.enqueuedAt))
+			}
+			return op.key, false
+		}
+		s.cond.Wait()
+	}
+}
+
+// Done marks the operation of the application with the given key as processed. The operation keeps counting as in
+// progress until Done is called with inProgress set to false, once the operation is completed or removed.
+func (s *operationScheduler) Done(key string, inProgress bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.scheduled, key)
+	if inProgress {
+		return
+	}
+	project, ok := s.started[key]
+	if !ok {
+		return
+	}
+	delete(s.started, key)
+	if s.running[project] > 0 {
+		s.running[project]--
+	}
+	if s.running[project] == 0 {
+		delete(s.running, project)
+	}
+	// the operations of the project might have been waiting for this one to complete
+	s.cond.Broadcast()
+}
+
+// ShutDown makes the pending and future calls to Get return immediately
+func (s *operationScheduler) ShutDown() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.shutdown = true
+	s.cond.Broadcast()
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func getScheduledOperations(t *testing.T, s *operationScheduler, count int) []string {
+	t.Helper()
+	var keys []string
+	for i := 0; i < count; i++ {
+		key, shutdown := s.Get()
+		require.False(t, shutdown)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestOperationScheduler_FairShare(t *testing.T) {
+	s := newOperationScheduler(nil)
+	for _, key := range []string{"argocd/preview-1", "argocd/preview-2", "argocd/preview-3"} {
+		s.Add(key, "preview", nil, false)
+	}
+	s.Add("argocd/production", "production", nil, false)
+
+	assert.Equal(t, []string{"argocd/preview-1", "argocd/production", "argocd/preview-2", "argocd/preview-3"}, getScheduledOperations(t, s, 4))
+}
+
+func TestOperationScheduler_Priority(t *testing.T) {
+	s := newOperationScheduler(nil)
+	s.Add("argocd/preview", "preview", &v1alpha1.ProjectOperationsPolicy{PriorityClass: v1alpha1.OperationPriorityClassLow}, false)
+	s.Add("argocd/staging", "staging", nil, false)
+	s.Add("argocd/production", "production", &v1alpha1.ProjectOperationsPolicy{PriorityClass: v1alpha1.OperationPriorityClassHigh}, false)
+
+	assert.Equal(t, []string{"argocd/production", "argocd/staging", "argocd/preview"}, getScheduledOperations(t, s, 3))
+}
+
+func TestOperationScheduler_MaxConcurrent(t *testing.T) {
+	s := newOperationScheduler(nil)
+	policy := &v1alpha1.ProjectOperationsPolicy{MaxConcurrent: 1, PriorityClass: v1alpha1.OperationPriorityClassHigh}
+	s.Add("argocd/preview-1", "preview", policy, false)
+	s.Add("argocd/preview-2", "preview", policy, false)
+	s.Add("argocd/staging", "staging", nil, false)
+
+	// the second operation of the project waits for the first one although it has a higher priority
+	assert.Equal(t, []string{"argocd/preview-1", "argocd/staging"}, getScheduledOperations(t, s, 2))
+
+	next := make(chan string)
+	go func() {
+		key, _ := s.Get()
+		next <- key
+	}()
+	select {
+	case key := <-next:
+		t.Fatalf("unexpected operation %s while the project is at its maximum number of concurrent operations", key)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	s.Done("argocd/preview-1", false)
+	select {
+	case key := <-next:
+		assert.Equal(t, "argocd/preview-2", key)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the pending operation was not scheduled")
+	}
+}
+
+func TestOperationScheduler_MaxConcurrent_InProgress(t *testing.T) {
+	s := newOperationScheduler(nil)
+	policy := &v1alpha1.ProjectOperationsPolicy{MaxConcurrent: 1}
+	s.Add("argocd/preview-1", "preview", policy, false)
+	assert.Equal(t, []string{"argocd/preview-1"}, getScheduledOperations(t, s, 1))
+	// the operation is not completed after its first iteration
+	s.Done("argocd/preview-1", true)
+
+	s.Add("argocd/preview-2", "preview", policy, false)
+	s.Add("argocd/preview-1", "preview", policy, false)
+	// the operation in progress is processed again, while the other operation of the project waits for it to complete
+	assert.Equal(t, []string{"argocd/preview-1"}, getScheduledOperations(t, s, 1))
+	s.Done("argocd/preview-1", false)
+	assert.Equal(t, []string{"argocd/preview-2"}, getScheduledOperations(t, s, 1))
+}
+
+func TestOperationScheduler_MaxConcurrent_Started(t *testing.T) {
+	s := newOperationScheduler(nil)
+	policy := &v1alpha1.ProjectOperationsPolicy{MaxConcurrent: 1}
+	// the operations were started before the controller restarted
+	s.Add("argocd/preview-1", "preview", policy, true)
+	s.Add("argocd/preview-2", "preview", policy, true)
+	s.Add("argocd/preview-3", "preview", policy, false)
+
+	assert.Equal(t, []string{"argocd/preview-1", "argocd/preview-2"}, getScheduledOperations(t, s, 2))
+	s.Done("argocd/preview-1", false)
+	s.Done("argocd/preview-2", true)
+	assert.Equal(t, int64(1), s.running["preview"])
+	s.Done("argocd/preview-2", false)
+	assert.Equal(t, []string{"argocd/preview-3"}, getScheduledOperations(t, s, 1))
+}
+
+func TestOperationScheduler_Deduplication(t *testing.T) {
+	s := newOperationScheduler(nil)
+	s.Add("argocd/my-app", "default", nil, false)
+	s.Add("argocd/my-app", "default", nil, false)
+	s.Add("argocd/other-app", "default", nil, false)
+
+	assert.Equal(t, []string{"argocd/my-app", "argocd/other-app"}, getScheduledOperations(t, s, 2))
+}
+
+func TestOperationScheduler_ObserveWait(t *testing.T) {
+	now := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	var observed time.Duration
+	s := newOperationScheduler(func(project string, priority v1alpha1.OperationPriorityClass, wait time.Duration) {
+		assert.Equal(t, "default", project)
+		assert.Equal(t, v1alpha1.OperationPriorityClassNormal, priority)
+		observed = wait
+	})
+	s.now = func() time.Time { return now }
+	s.Add("argocd/my-app", "default", nil, false)
+	now = now.Add(30 * time.Second)
+
+	getScheduledOperations(t, s, 1)
+	assert.Equal(t, 30*time.Second, observed)
+}
+
+func TestOperationScheduler_ShutDown(t *testing.T) {
+	s := newOperationScheduler(nil)
+	done := make(chan bool)
+	go func() {
+		_, shutdown := s.Get()
+		done <- shutdown
+	}()
+	s.ShutDown()
+	select {
+	case shutdown := <-done:
+		assert.True(t, shutdown)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Get did not return after the shutdown")
+	}
+}
@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+const (
+	// EnvVarOrphanedResourcesCleanupInterval is an environment variable which controls the minimum time between two
+	// cleanups of the orphaned resources of an application
+	EnvVarOrphanedResourcesCleanupInterval = "ARGOCD_ORPHANED_RESOURCES_CLEANUP_INTERVAL"
+
+	defaultOrphanedResourcesCleanupInterval = 5 * time.Minute
+)
+
+// getOrphanedResourcesCleanupCandidates returns the top level orphaned resources of the tree which are older than the
+// minimum age of the cleanup policy
+func getOrphanedResourcesCleanupCandidates(tree *appv1.ApplicationTree, minAge time.Duration, now time.Time) []appv1.ResourceNode {
+	var candidates []appv1.ResourceNode
+	for _, node := range tree.OrphanedNodes {
+		// only the top level resources are deleted, their children are garbage collected by Kubernetes
+		if len(node.ParentRefs) > 0 || node.Namespace == "" || node.CreatedAt == nil {
+			continue
+		}
+		if now.Sub(node.CreatedAt.Time) < minAge {
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+	})
+	return candidates
+}
+
+// isProtectedOrphanedResource returns true if the orphaned resource is only cleaned up when its kind is explicitly
+// listed in the allowed selectors: the persistent volume claims, which hold data, the resources owned or managed by
+// another controller, and the resources of the managed namespace templates
+func isProtectedOrphanedResource(gvk schema.GroupVersionKind, ownerRefs []metav1.OwnerReference, resourceLabels map[string]string) bool {
+	if gvk.Group == "" && gvk.Kind == kube.PersistentVolumeClaimKind {
+		return true
+	}
+	if len(ownerRefs) > 0 || resourceLabels[common.LabelKeyManagedNamespaceTemplate] != "" {
+		return true
+	}
+	for key := range resourceLabels {
+		if isKubernetesManagedByLabel(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isKubernetesManagedByLabel returns true for the managed-by labels of the kubernetes.io prefixes, e.g.
+// app.kubernetes.io/managed-by
+func isKubernetesManagedByLabel(key string) bool {
+	prefix, name, ok := strings.Cut(key, "/")
+	return ok && name == "managed-by" && (prefix == "kubernetes.io" || strings.HasSuffix(prefix, ".kubernetes.io"))
+}
+
+// matchesOrphanedResourcesCleanupPolicy returns true if the resource matches one of the allowed selectors of the
+// policy and none of the ignored selectors. Nothing is allowed if there are no allowed selectors, and protected
+// resources are only allowed by the selectors explicitly listing their kind.
+func matchesOrphanedResourcesCleanupPolicy(policy *appv1.OrphanedResourcesCleanupPolicy, gvk schema.GroupVersionKind, name string, resourceLabels map[string]string, protected bool) (bool, error) {
+	allowed := false
+	for i := range policy.Allow {
+		if protected && policy.Allow[i].Kind != gvk.Kind {
+			continue
+		}
+		matches, err := policy.Allow[i].Matches(gvk, name, resourceLabels)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return false, nil
+	}
+	for i := range policy.Ignore {
+		matches, err := policy.Ignore[i].Matches(gvk, name, resourceLabels)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// mayMatchOrphanedResourcesCleanupPolicy returns true if the resource matches one of the allowed selectors of the
+// policy regardless of their label selectors, which is checked before reading the labels of the resource
+func mayMatchOrphanedResourcesCleanupPolicy(policy *appv1.OrphanedResourcesCleanupPolicy, gvk schema.GroupVersionKind, name string) (bool, error) {
+	for _, selector := range policy.Allow {
+		selector.LabelSelector = nil
+		matches, err := selector.Matches(gvk, name, nil)
+		if err != nil || matches {
+			return matches, err
+		}
+	}
+	return false, nil
+}
+
+// getOrphanedResourcesCleanupInterval returns the minimum time between two cleanups of the orphaned resources of an
+// application, which is configured with the ARGOCD_ORPHANED_RESOURCES_CLEANUP_INTERVAL environment variable
+func getOrphanedResourcesCleanupInterval() time.Duration {
+	return env.ParseDurationFromEnv(EnvVarOrphanedResourcesCleanupInterval, defaultOrphanedResourcesCleanupInterval, 0, math.MaxInt64)
+}
+
+// cleanupOrphanedResources deletes the orphaned resources of the application matching the cleanup policy of its
+// project, or reports them as an event if the policy is a dry run. The cleanup runs at most once per cleanup interval,
+// since the deletions themselves trigger reconciliations, and the number of deletions of each cleanup is capped, so
+// that a misconfigured policy cannot wipe out a namespace at once.
+func (ctrl *ApplicationController) cleanupOrphanedResources(app *appv1.Application, proj *appv1.AppProject, tree *appv1.ApplicationTree, logCtx *log.Entry) {
+	appKey := app.QualifiedName()
+	if proj.Spec.OrphanedResources == nil || !proj.Spec.OrphanedResources.IsCleanupEnabled() || app.GetDeletionTimestamp() != nil {
+		ctrl.orphanedResourcesCleanupReports.Delete(appKey)
+		ctrl.orphanedResourcesCleanupTimes.Delete(appKey)
+		return
+	}
+	policy := proj.Spec.OrphanedResources.Cleanup
+	minAge, err := policy.GetMinAge()
+	if err != nil {
+		logCtx.Warnf("Skipping the cleanup of the orphaned resources: %v", err)
+		return
+	}
+	now := time.Now()
+	candidates := getOrphanedResourcesCleanupCandidates(tree, minAge, now)
+	if len(candidates) == 0 {
+		ctrl.orphanedResourcesCleanupReports.Delete(appKey)
+		return
+	}
+	if last, ok := ctrl.orphanedResourcesCleanupTimes.Load(appKey); ok && now.Sub(last.(time.Time)) < getOrphanedResourcesCleanupInterval() {
+		return
+	}
+
+	isValid, cluster := ctrl.isValidDestination(app)
+	if !isValid {
+		return
+	}
+	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, cluster.RESTConfig())
+	// the orphaned resources are read and deleted with the same privileges as the syncs
+	serviceAccount, err := getServiceAccountToImpersonate(ctrl.settingsMgr, app, proj, cluster.Server)
+	if err != nil {
+		logCtx.Warnf("Skipping the cleanup of the orphaned resources: %v", err)
+		return
+	}
+	if serviceAccount != "" {
+		logCtx = logCtx.WithField("impersonatedServiceAccount", serviceAccount)
+		config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+	ctrl.orphanedResourcesCleanupTimes.Store(appKey, now)
+	ctx := context.Background()
+	maxDeletions := policy.GetMaxDeletions()
+
+	var matched []appv1.ResourceNode
+	for _, node := range candidates {
+		if int64(len(matched)) >= maxDeletions {
+			break
+		}
+		gvk := schema.GroupVersionKind{Group: node.Group, Version: node.Version, Kind: node.Kind}
+		mayMatch, err := mayMatchOrphanedResourcesCleanupPolicy(policy, gvk, node.Name)
+		if err != nil {
+			logCtx.Warnf("Skipping the cleanup of the orphaned resources: %v", err)
+			return
+		}
+		if !mayMatch {
+			continue
+		}
+		// the labels of the resources are not kept in the cluster cache, the resource is read from the cluster
+		live, err := ctrl.kubectl.GetResource(ctx, config, gvk, node.Name, node.Namespace)
+		if err != nil {
+			if !apierr.IsNotFound(err) {
+				logCtx.Warnf("Failed to get orphaned resource %s/%s/%s: %v", node.Kind, node.Namespace, node.Name, err)
+			}
+			continue
+		}
+		if live == nil || (node.UID != "" && string(live.GetUID()) != node.UID) {
+			continue
+		}
+		protected := isProtectedOrphanedResource(gvk, live.GetOwnerReferences(), live.GetLabels())
+		matches, err := matchesOrphanedResourcesCleanupPolicy(policy, gvk, node.Name, live.GetLabels(), protected)
+		if err != nil {
+			logCtx.Warnf("Skipping the cleanup of the orphaned resources: %v", err)
+			return
+		}
+		if matches {
+			matched = append(matched, node)
+		}
+	}
+	if len(matched) == 0 {
+		ctrl.orphanedResourcesCleanupReports.Delete(appKey)
+		return
+	}
+
+	if policy.DryRun {
+		names := make([]string, len(matched))
+		for i, node := range matched {
+			names[i] = fmt.Sprintf("%s/%s/%s", node.Kind, node.Namespace, node.Name)
+		}
+		report := strings.Join(names, ", ")
+		// the same orphaned resources are found in every reconciliation, only report them when they change
+		if previous, ok := ctrl.orphanedResourcesCleanupReports.Load(appKey); ok && previous == report {
+			return
+		}
+		ctrl.orphanedResourcesCleanupReports.Store(appKey, report)
+		message := fmt.Sprintf("Orphaned resources would be deleted (dry run): %s", report)
+		logCtx.Info(message)
+		ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOrphanedResourcesFound, Type: v1.EventTypeNormal}, message, ctx)
+		return
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+	for _, node := range matched {
+		gvk := schema.GroupVersionKind{Group: node.Group, Version: node.Version, Kind: node.Kind}
+		deleteOptions := metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+		if node.UID != "" {
+			// the resource might have been recreated since the tree was built
+			uid := types.UID(node.UID)
+			deleteOptions.Preconditions = &metav1.Preconditions{UID: &uid}
+		}
+		if err := ctrl.kubectl.DeleteResource(ctx, config, gvk, node.Name, node.Namespace, deleteOptions); err != nil {
+			if !apierr.IsNotFound(err) && !apierr.IsConflict(err) {
+				logCtx.Warnf("Failed to delete orphaned resource %s/%s/%s: %v", node.Kind, node.Namespace, node.Name, err)
+			}
+			continue
+		}
+		message := fmt.Sprintf("Deleted orphaned resource %s/%s/%s", node.Kind, node.Namespace, node.Name)
+		logCtx.Info(message)
+		ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceDeleted, Type: v1.EventTypeNormal}, message, ctx)
+	}
+}
@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newOrphanedNode(kind string, name string, age time.Duration, parents ...v1alpha1.ResourceRef) v1alpha1.ResourceNode {
+	createdAt := metav1.NewTime(time.Now().Add(-age))
+	return v1alpha1.ResourceNode{
+		ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: kind, Namespace: "default", Name: name, UID: name + "-uid"},
+		ParentRefs:  parents,
+		CreatedAt:   &createdAt,
+	}
+}
+
+func newOrphanedLiveResource(node v1alpha1.ResourceNode, resourceLabels map[string]string, ownerRefs ...metav1.OwnerReference) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(node.Version)
+	obj.SetKind(node.Kind)
+	obj.SetNamespace(node.Namespace)
+	obj.SetName(node.Name)
+	obj.SetUID(types.UID(node.UID))
+	obj.SetLabels(resourceLabels)
+	obj.SetOwnerReferences(ownerRefs)
+	return obj
+}
+
+func TestGetOrphanedResourcesCleanupCandidates(t *testing.T) {
+	parent := newOrphanedNode("ConfigMap", "old", 2*time.Hour)
+	tree := &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{
+		newOrphanedNode("ConfigMap", "recent", time.Minute),
+		parent,
+		newOrphanedNode("Secret", "older", 3*time.Hour),
+		newOrphanedNode("Pod", "child", 2*time.Hour, parent.ResourceRef),
+	}}
+
+	candidates := getOrphanedResourcesCleanupCandidates(tree, time.Hour, time.Now())
+	require.Len(t, candidates, 2)
+	assert.Equal(t, "older", candidates[0].Name)
+	assert.Equal(t, "old", candidates[1].Name)
+}
+
+func TestMatchesOrphanedResourcesCleanupPolicy(t *testing.T) {
+	configMap := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	secret := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	pvc := schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
+	policy := &v1alpha1.OrphanedResourcesCleanupPolicy{
+		Allow:  []v1alpha1.OrphanedResourceSelector{{Kind: "ConfigMap"}, {Name: "data-*"}},
+		Ignore: []v1alpha1.OrphanedResourceSelector{{Name: "keep-*"}, {LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"keep": "true"}}}},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		policy    *v1alpha1.OrphanedResourcesCleanupPolicy
+		gvk       schema.GroupVersionKind
+		resource  string
+		labels    map[string]string
+		protected bool
+		expected  bool
+	}{
+		{"allowed", policy, configMap, "my-map", nil, false, true},
+		{"not allowed", policy, secret, "my-secret", nil, false, false},
+		{"ignored by name", policy, configMap, "keep-me", nil, false, false},
+		{"ignored by labels", policy, configMap, "my-map", map[string]string{"keep": "true"}, false, false},
+		{"nothing allowed", &v1alpha1.OrphanedResourcesCleanupPolicy{}, configMap, "my-map", nil, false, false},
+		{"protected allowed by kind", policy, configMap, "my-map", nil, true, true},
+		{"protected not allowed by kind", policy, pvc, "data-0", nil, true, false},
+		{"unprotected allowed by name", policy, pvc, "data-0", nil, false, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := matchesOrphanedResourcesCleanupPolicy(tc.policy, tc.gvk, tc.resource, tc.labels, tc.protected)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestIsProtectedOrphanedResource(t *testing.T) {
+	configMap := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	assert.False(t, isProtectedOrphanedResource(configMap, nil, map[string]string{"app": "guestbook"}))
+	assert.True(t, isProtectedOrphanedResource(schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}, nil, nil))
+	assert.True(t, isProtectedOrphanedResource(configMap, []metav1.OwnerReference{{Kind: "Deployment", Name: "guestbook"}}, nil))
+	assert.True(t, isProtectedOrphanedResource(configMap, nil, map[string]string{"app.kubernetes.io/managed-by": "Helm"}))
+	assert.True(t, isProtectedOrphanedResource(configMap, nil, map[string]string{"kubernetes.io/managed-by": "controller"}))
+	assert.False(t, isProtectedOrphanedResource(configMap, nil, map[string]string{"example.com/managed-by": "controller"}))
+	assert.True(t, isProtectedOrphanedResource(configMap, nil, map[string]string{common.LabelKeyManagedNamespaceTemplate: "true"}))
+}
+
+func TestMayMatchOrphanedResourcesCleanupPolicy(t *testing.T) {
+	policy := &v1alpha1.OrphanedResourcesCleanupPolicy{Allow: []v1alpha1.OrphanedResourceSelector{
+		{Kind: "ConfigMap", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"cleanup": "true"}}},
+	}}
+	matches, err := mayMatchOrphanedResourcesCleanupPolicy(policy, schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, "my-map")
+	require.NoError(t, err)
+	assert.True(t, matches)
+	matches, err = mayMatchOrphanedResourcesCleanupPolicy(policy, schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, "my-secret")
+	require.NoError(t, err)
+	assert.False(t, matches)
+	assert.NotNil(t, policy.Allow[0].LabelSelector)
+}
+
+func TestCleanupOrphanedResources(t *testing.T) {
+	newProject := func(policy *v1alpha1.OrphanedResourcesCleanupPolicy) *v1alpha1.AppProject {
+		proj := defaultProj.DeepCopy()
+		proj.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Cleanup: policy}
+		return proj
+	}
+	tree := &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{
+		newOrphanedNode("ConfigMap", "map-1", 3*time.Hour),
+		newOrphanedNode("ConfigMap", "map-2", 2*time.Hour),
+		newOrphanedNode("ConfigMap", "map-3", time.Minute),
+	}}
+	var liveResources []*unstructured.Unstructured
+	for _, node := range tree.OrphanedNodes {
+		liveResources = append(liveResources, newOrphanedLiveResource(node, nil))
+	}
+	allowConfigMaps := []v1alpha1.OrphanedResourceSelector{{Kind: "ConfigMap"}}
+	newController := func(app *v1alpha1.Application, configMapData map[string]string, resources ...*unstructured.Unstructured) *ApplicationController {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, configMapData: configMapData}, nil)
+		kubectl := ctrl.kubectl.(*MockKubectl)
+		kubectl.Resources = map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, res := range resources {
+			kubectl.Resources[kube.GetResourceKey(res)] = res
+		}
+		return ctrl
+	}
+
+	t.Run("deletes the resources older than the minimum age", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil, liveResources...)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: allowConfigMaps}), tree, getAppLog(app))
+		assert.Equal(t, []kube.ResourceKey{
+			kube.NewResourceKey("", "ConfigMap", "default", "map-1"),
+			kube.NewResourceKey("", "ConfigMap", "default", "map-2"),
+		}, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("caps the deletions per cleanup interval", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil, liveResources...)
+		proj := newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: allowConfigMaps, MaxDeletions: 1})
+		ctrl.cleanupOrphanedResources(app, proj, tree, getAppLog(app))
+		assert.Equal(t, []kube.ResourceKey{kube.NewResourceKey("", "ConfigMap", "default", "map-1")}, ctrl.kubectl.(*MockKubectl).DeletedResources)
+
+		// the reconciliation triggered by the deletion does not delete more resources
+		ctrl.cleanupOrphanedResources(app, proj, tree, getAppLog(app))
+		assert.Len(t, ctrl.kubectl.(*MockKubectl).DeletedResources, 1)
+
+		// the next resources are deleted once the interval elapsed
+		ctrl.orphanedResourcesCleanupTimes.Store(app.QualifiedName(), time.Now().Add(-defaultOrphanedResourcesCleanupInterval))
+		ctrl.cleanupOrphanedResources(app, proj, tree, getAppLog(app))
+		assert.Len(t, ctrl.kubectl.(*MockKubectl).DeletedResources, 2)
+	})
+
+	t.Run("impersonates the destination service account", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, map[string]string{"application.sync.impersonation.enabled": "true"}, liveResources...)
+		proj := newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: allowConfigMaps, MaxDeletions: 1})
+		proj.Spec.DestinationServiceAccounts = []v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: test.FakeDestNamespace, DefaultServiceAccount: "cleaner"}}
+		ctrl.cleanupOrphanedResources(app, proj, tree, getAppLog(app))
+		kubectl := ctrl.kubectl.(*MockKubectl)
+		require.Len(t, kubectl.DeletedResources, 1)
+		serviceAccount := "system:serviceaccount:" + test.FakeDestNamespace + ":cleaner"
+		assert.Equal(t, []string{serviceAccount, serviceAccount}, kubectl.ImpersonatedUsers)
+
+		// nothing is deleted without a service account to impersonate
+		ctrl = newController(app, map[string]string{"application.sync.impersonation.enabled": "true"}, liveResources...)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: allowConfigMaps}), tree, getAppLog(app))
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil, liveResources...)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: allowConfigMaps, DryRun: true}), tree, getAppLog(app))
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		report, ok := ctrl.orphanedResourcesCleanupReports.Load(app.QualifiedName())
+		require.True(t, ok)
+		assert.Equal(t, "ConfigMap/default/map-1, ConfigMap/default/map-2", report)
+	})
+
+	t.Run("nothing allowed", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil, liveResources...)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true}), tree, getAppLog(app))
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("skips the protected, deleted and recreated resources", func(t *testing.T) {
+		app := newFakeApp()
+		recreated := newOrphanedLiveResource(tree.OrphanedNodes[1], nil)
+		recreated.SetUID("other-uid")
+		ctrl := newController(app, nil, newOrphanedLiveResource(tree.OrphanedNodes[0], map[string]string{"app.kubernetes.io/managed-by": "Helm"}), recreated)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{Enabled: true, Allow: []v1alpha1.OrphanedResourceSelector{{Kind: "Config*"}}}), tree, getAppLog(app))
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil, liveResources...)
+		ctrl.cleanupOrphanedResources(app, newProject(&v1alpha1.OrphanedResourcesCleanupPolicy{}), tree, getAppLog(app))
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// childAppPauseIgnoreDifferences ignores the pause of the child applications managed by an application, which is
+// only set through the pause and resume APIs
+var childAppPauseIgnoreDifferences = appv1.ResourceIgnoreDifferences{
+	Group:        application.Group,
+	Kind:         application.ApplicationKind,
+	JSONPointers: []string{"/spec/pause"},
+}
+
+// isReconcilePaused returns whether the reconciliation of the application is paused. The pause is surfaced with a
+// ReconcilePausedWarning condition, and a refresh is scheduled for when it expires so that the application resumes
+// automatically.
+func (ctrl *ApplicationController) isReconcilePaused(app *appv1.Application) bool {
+	pause := app.Spec.Pause
+	now := time.Now()
+	if !pause.IsActive(now) {
+		return false
+	}
+	getAppLog(app).Debugf("Skipping Application reconcile: %s", pause.Message())
+	ctrl.setAppCondition(app, appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionReconcilePausedWarning,
+		Message: pause.Message(),
+	})
+	if pause.Until != nil {
+		resumeAfter := pause.Until.Sub(now)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &resumeAfter)
+	}
+	return true
+}
+
+// removeChildAppPause removes the pause from the target manifest of a child application, so that syncing the parent
+// application neither resumes nor pauses it, and cannot forge the user who paused it
+func removeChildAppPause(obj *unstructured.Unstructured) {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group == application.Group && gvk.Kind == application.ApplicationKind {
+		unstructured.RemoveNestedField(obj.Object, "spec", "pause")
+	}
+}
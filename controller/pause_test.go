@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestIsReconcilePaused(t *testing.T) {
+	t.Run("not paused", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.isReconcilePaused(app))
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		app := newFakeApp()
+		until := metav1.NewTime(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+		app.Spec.Pause = &v1alpha1.ApplicationPause{Reason: "maintenance", PausedBy: "admin", Until: &until}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.True(t, ctrl.isReconcilePaused(app))
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionReconcilePausedWarning, app.Status.Conditions[0].Type)
+		assert.Equal(t, "Reconciliation paused by admin until 2100-01-01T00:00:00Z: maintenance", app.Status.Conditions[0].Message)
+	})
+
+	t.Run("pause expired", func(t *testing.T) {
+		app := newFakeApp()
+		until := metav1.NewTime(time.Now().Add(-time.Minute))
+		app.Spec.Pause = &v1alpha1.ApplicationPause{Reason: "maintenance", Until: &until}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.isReconcilePaused(app))
+	})
+}
+
+func TestProcessAppOperationQueueItemPaused(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Pause = &v1alpha1.ApplicationPause{Reason: "maintenance"}
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	ctrl.appOperationQueue.Add(ctrl.toAppKey(app.Name))
+
+	ctrl.processAppOperationQueueItem()
+	ctrl.processScheduledAppOperation()
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Equal(t, "successfully synced", app.Status.OperationState.Message)
+}
+
+func TestCompareAppStateIgnoresChildAppPause(t *testing.T) {
+	app := newFakeApp()
+	newChildApp := func(pause *v1alpha1.ApplicationPause) *v1alpha1.Application {
+		child := newFakeApp()
+		child.Name = "child-app"
+		child.Namespace = test.FakeDestNamespace
+		child.Status = v1alpha1.ApplicationStatus{}
+		child.Spec.Pause = pause
+		return child
+	}
+	target, err := json.Marshal(newChildApp(&v1alpha1.ApplicationPause{Reason: "forged", PausedBy: "bob"}))
+	require.NoError(t, err)
+	live := kube.MustToUnstructured(newChildApp(&v1alpha1.ApplicationPause{Reason: "database migration", PausedBy: "alice"}))
+	live.SetLabels(map[string]string{common.LabelKeyAppInstance: app.Name})
+
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{{CompiledManifest: string(target)}},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(live): live,
+		},
+	}, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	require.Len(t, compRes.reconciliationResult.Target, 1)
+	_, found, _ := unstructured.NestedMap(compRes.reconciliationResult.Target[0].Object, "spec", "pause")
+	assert.False(t, found)
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+const (
+	// EnvReconcileProfileHistory is the number of reconciliation profiles kept per application, 0 disables them
+	EnvReconcileProfileHistory = "ARGOCD_RECONCILE_PROFILE_HISTORY"
+)
+
+var reconcileProfileHistory = env.ParseNumFromEnv(EnvReconcileProfileHistory, 10, 0, 100)
+
+// reconcilePhases lists the phases of a reconciliation in the order they run. The phases measured by CompareAppState
+// keep the names they are logged with.
+var reconcilePhases = []string{"conditions", "settings", "git", "dedup", "live", "diff", "sync", "health", "tree", "auto_sync", "persist"}
+
+var comparisonLevelNames = map[CompareWith]string{
+	ComparisonWithNothing:         "Nothing",
+	CompareWithRecent:             "Recent",
+	CompareWithLatest:             "Latest",
+	CompareWithLatestForceResolve: "LatestForceResolve",
+}
+
+// reconcileProfiler keeps the profiles of the last reconciliations of the applications
+type reconcileProfiler struct {
+	historySize int
+
+	lock     sync.Mutex
+	profiles map[string][]*appv1.ReconcileProfile
+}
+
+func newReconcileProfiler(historySize int) *reconcileProfiler {
+	return &reconcileProfiler{historySize: historySize, profiles: make(map[string][]*appv1.ReconcileProfile)}
+}
+
+// add adds the profile of a reconciliation of the given application and returns the last profiles, most recent first
+func (p *reconcileProfiler) add(appName string, profile *appv1.ReconcileProfile) []*appv1.ReconcileProfile {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	profiles := append([]*appv1.ReconcileProfile{profile}, p.profiles[appName]...)
+	if len(profiles) > p.historySize {
+		profiles = profiles[:p.historySize]
+	}
+	p.profiles[appName] = profiles
+	return profiles
+}
+
+func (p *reconcileProfiler) forget(appName string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.profiles, appName)
+}
+
+// newReconcileProfile builds the profile of a reconciliation from the timings of its phases, indexed by the phase
+// name suffixed with _ms
+func newReconcileProfile(startTime time.Time, duration time.Duration, comparisonLevel CompareWith, timings map[string]time.Duration) *appv1.ReconcileProfile {
+	profile := &appv1.ReconcileProfile{
+		StartedAt:       metav1.NewTime(startTime),
+		DurationMs:      duration.Milliseconds(),
+		ComparisonLevel: comparisonLevelNames[comparisonLevel],
+	}
+	for _, phase := range reconcilePhases {
+		if d, ok := timings[phase+"_ms"]; ok {
+			profile.Phases = append(profile.Phases, appv1.ReconcilePhaseTiming{Name: phase, DurationMs: d.Milliseconds()})
+		}
+	}
+	return profile
+}
+
+// recordReconcileProfile exports the time spent in each phase of a reconciliation as metrics, and keeps the profile
+// of the reconciliation in the cache
+func (ctrl *ApplicationController) recordReconcileProfile(app *appv1.Application, startTime time.Time, duration time.Duration, comparisonLevel CompareWith, timings map[string]time.Duration, logCtx *log.Entry) {
+	for _, phase := range reconcilePhases {
+		if d, ok := timings[phase+"_ms"]; ok {
+			ctrl.metricsServer.ObserveReconcilePhase(app, phase, d)
+		}
+	}
+	if ctrl.reconcileProfiler.historySize == 0 {
+		return
+	}
+	appName := app.InstanceName(ctrl.namespace)
+	profiles := ctrl.reconcileProfiler.add(appName, newReconcileProfile(startTime, duration, comparisonLevel, timings))
+	if err := ctrl.cache.SetAppReconcileProfiles(appName, profiles); err != nil {
+		logCtx.Warnf("Failed to cache reconcile profiles: %v", err)
+	}
+}
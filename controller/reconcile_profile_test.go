@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestReconcileProfiler(t *testing.T) {
+	profiler := newReconcileProfiler(2)
+	profiler.add("argocd/my-app", &v1alpha1.ReconcileProfile{DurationMs: 1})
+	profiler.add("argocd/my-app", &v1alpha1.ReconcileProfile{DurationMs: 2})
+	profiles := profiler.add("argocd/my-app", &v1alpha1.ReconcileProfile{DurationMs: 3})
+	// the most recent profiles are kept, most recent first
+	assert.Equal(t, []*v1alpha1.ReconcileProfile{{DurationMs: 3}, {DurationMs: 2}}, profiles)
+
+	profiler.forget("argocd/my-app")
+	assert.Equal(t, []*v1alpha1.ReconcileProfile{{DurationMs: 4}}, profiler.add("argocd/my-app", &v1alpha1.ReconcileProfile{DurationMs: 4}))
+}
+
+func TestNewReconcileProfile(t *testing.T) {
+	startTime := time.Now()
+	profile := newReconcileProfile(startTime, 1500*time.Millisecond, CompareWithLatest, map[string]time.Duration{
+		"persist_ms":    20 * time.Millisecond,
+		"git_ms":        1200 * time.Millisecond,
+		"compare_ms":    1400 * time.Millisecond,
+		"conditions_ms": 5 * time.Millisecond,
+		"diff_ms":       200 * time.Millisecond,
+	})
+	assert.Equal(t, int64(1500), profile.DurationMs)
+	assert.Equal(t, "Latest", profile.ComparisonLevel)
+	// the phases are ordered as they run and the overall comparison is left out in favor of its phases
+	assert.Equal(t, []v1alpha1.ReconcilePhaseTiming{
+		{Name: "conditions", DurationMs: 5},
+		{Name: "git", DurationMs: 1200},
+		{Name: "diff", DurationMs: 200},
+		{Name: "persist", DurationMs: 20},
+	}, profile.Phases)
+}
+
+func TestProcessAppRefreshQueueItem_RecordsReconcileProfile(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []*apiclient.Manifest{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+	ctrl.requestAppRefresh(app.Name, CompareWithLatestForceResolve.Pointer(), nil)
+
+	ctrl.processAppRefreshQueueItem()
+
+	var profiles []*v1alpha1.ReconcileProfile
+	require.NoError(t, ctrl.cache.GetAppReconcileProfiles(app.InstanceName(ctrl.namespace), &profiles))
+	require.Len(t, profiles, 1)
+	assert.Equal(t, "LatestForceResolve", profiles[0].ComparisonLevel)
+	var phases []string
+	for _, phase := range profiles[0].Phases {
+		phases = append(phases, phase.Name)
+	}
+	assert.Equal(t, []string{"conditions", "settings", "git", "dedup", "live", "diff", "sync", "health", "tree", "auto_sync", "persist"}, phases)
+}
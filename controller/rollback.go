@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	v1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// rollbackReasonInfo is the operation info item identifying the syncs initiated by an automated rollback
+const rollbackReasonInfo = "Reason"
+
+// autoRollback rolls the application back to the last healthy revision of its history when it stays degraded for
+// longer than the grace period configured in its rollbackOnDegraded policy after an automated sync. Auto-sync is
+// paused by a RollbackWarning condition until the application gets synced manually. It returns the condition to set
+// on the application, if any, and the time spent setting the operation.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus *appv1.HealthStatus) (*appv1.ApplicationCondition, time.Duration) {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil || app.Spec.SyncPolicy.Automated.RollbackOnDegraded == nil {
+		return nil, 0
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return nil, 0
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || !opState.Operation.InitiatedBy.Automated || opState.Phase != synccommon.OperationSucceeded || opState.FinishedAt == nil {
+		return nil, 0
+	}
+	// never roll back a rollback: the application is left as is and auto-sync stays paused
+	if isAutoRollbackOperation(opState.Operation) || healthStatus.Status != health.HealthStatusDegraded || isAutoSyncPausedByRollback(app) {
+		return nil, 0
+	}
+	logCtx := getAppLog(app)
+
+	// the grace period starts when the application turned degraded, or when the sync completed if it was already
+	// degraded before
+	degradedSince := opState.FinishedAt.Time
+	if healthStatus.LastTransitionTime != nil && healthStatus.LastTransitionTime.After(degradedSince) {
+		degradedSince = healthStatus.LastTransitionTime.Time
+	}
+	gracePeriod := app.Spec.SyncPolicy.Automated.RollbackOnDegraded.GetGracePeriod()
+	if remaining := gracePeriod - time.Since(degradedSince); remaining > 0 {
+		logCtx.Infof("Application is degraded after automated sync, rolling back in %v unless it recovers", remaining)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &remaining)
+		return nil, 0
+	}
+
+	history := getLastHealthyRevisionHistory(app)
+	if history == nil {
+		logCtx.Warnf("Application is degraded after automated sync but there is no healthy revision to roll back to")
+		return nil, 0
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     history.Revision,
+			Revisions:    history.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       history.Source.DeepCopy(),
+			Sources:      history.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Info:        []*appv1.Info{{Name: rollbackReasonInfo, Value: fmt.Sprintf("Rolled back to history ID %d after the application stayed degraded", history.ID)}},
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate rollback to history ID %d: %v", history.ID, err)
+		return nil, setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Application stayed Degraded for more than %v after automated sync to '%s': rolled back to '%s' (history ID %d). Auto-sync is paused until the application is synced manually",
+		gracePeriod, formatOperationRevision(opState.SyncResult), formatRevisionHistory(history), history.ID)
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message, context.TODO())
+	logCtx.Warn(message)
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionRollbackWarning, Message: message}, setOpTime
+}
+
+// isAutoSyncPausedByRollback returns whether auto-sync is paused after an automated rollback. The pause ends as soon
+// as a sync initiated by a user completes after the rollback.
+func isAutoSyncPausedByRollback(app *appv1.Application) bool {
+	for _, condition := range app.Status.Conditions {
+		if condition.Type != appv1.ApplicationConditionRollbackWarning {
+			continue
+		}
+		opState := app.Status.OperationState
+		if opState != nil && !opState.Operation.InitiatedBy.Automated && opState.FinishedAt != nil &&
+			(condition.LastTransitionTime == nil || opState.FinishedAt.After(condition.LastTransitionTime.Time)) {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// isAutoRollbackOperation returns whether the operation was initiated by an automated rollback
+func isAutoRollbackOperation(op appv1.Operation) bool {
+	if !op.InitiatedBy.Automated {
+		return false
+	}
+	for _, info := range op.Info {
+		if info != nil && info.Name == rollbackReasonInfo {
+			return true
+		}
+	}
+	return false
+}
+
+// getLastHealthyRevisionHistory returns the most recent history entry, other than the currently deployed one, which
+// the application has been observed healthy with
+func getLastHealthyRevisionHistory(app *appv1.Application) *appv1.RevisionHistory {
+	for i := len(app.Status.History) - 2; i >= 0; i-- {
+		if app.Status.History[i].Healthy {
+			return &app.Status.History[i]
+		}
+	}
+	return nil
+}
+
+// markRevisionHistoryHealthy flags the currently deployed history entry as healthy once the application is healthy
+// and synced to its revision, so that it can be used as a rollback target later on
+func markRevisionHistoryHealthy(app *appv1.Application) {
+	if len(app.Status.History) == 0 || app.Operation != nil {
+		return
+	}
+	history := &app.Status.History[len(app.Status.History)-1]
+	if history.Healthy || app.Status.Health.Status != health.HealthStatusHealthy || app.Status.Sync.Status != appv1.SyncStatusCodeSynced {
+		return
+	}
+	if app.Spec.HasMultipleSources() {
+		if !reflect.DeepEqual(history.Revisions, app.Status.Sync.Revisions) {
+			return
+		}
+	} else if history.Revision != app.Status.Sync.Revision {
+		return
+	}
+	history.Healthy = true
+}
+
+func formatOperationRevision(syncResult *appv1.SyncOperationResult) string {
+	if syncResult == nil {
+		return ""
+	}
+	if len(syncResult.Revisions) > 0 {
+		return strings.Join(syncResult.Revisions, ", ")
+	}
+	return syncResult.Revision
+}
+
+func formatRevisionHistory(history *appv1.RevisionHistory) string {
+	if len(history.Revisions) > 0 {
+		return strings.Join(history.Revisions, ", ")
+	}
+	return history.Revision
+}
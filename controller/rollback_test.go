@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeDegradedApp(finishedAt time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.RollbackOnDegraded = &v1alpha1.RollbackOnDegraded{GracePeriod: "10m"}
+	app.Status.OperationState.Operation.InitiatedBy.Automated = true
+	app.Status.OperationState.FinishedAt = &metav1.Time{Time: finishedAt}
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "cccccccccccccccccccccccccccccccccccccccc", Source: app.Spec.GetSource(), Healthy: true},
+		{ID: 2, Revision: "dddddddddddddddddddddddddddddddddddddddd", Source: app.Spec.GetSource()},
+		{ID: 3, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: app.Spec.GetSource()},
+	}
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	degraded := &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded}
+
+	t.Run("rolls back to the last healthy revision after the grace period", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionRollbackWarning, cond.Type)
+		assert.Equal(t, "Application stayed Degraded for more than 10m0s after automated sync to 'aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa': "+
+			"rolled back to 'cccccccccccccccccccccccccccccccccccccccc' (history ID 1). Auto-sync is paused until the application is synced manually", cond.Message)
+
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "cccccccccccccccccccccccccccccccccccccccc", app.Operation.Sync.Revision)
+		assert.NotNil(t, app.Operation.Sync.Source)
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+		assert.True(t, isAutoRollbackOperation(*app.Operation))
+	})
+
+	t.Run("waits for the grace period", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now())
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("waits for the grace period since the application turned degraded", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &metav1.Time{Time: time.Now().Add(-time.Minute)}})
+		assert.Nil(t, cond)
+
+		cond, _ = ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: &metav1.Time{Time: time.Now().Add(-20 * time.Minute)}})
+		assert.NotNil(t, cond)
+	})
+
+	t.Run("does nothing when the application is healthy", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy})
+		assert.Nil(t, cond)
+	})
+
+	t.Run("does nothing when the policy is disabled", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		app.Spec.SyncPolicy.Automated.RollbackOnDegraded = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("does nothing after a manual sync", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("does not roll back a rollback", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		app.Status.OperationState.Operation.Info = []*v1alpha1.Info{{Name: rollbackReasonInfo, Value: "rolled back"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		assert.Nil(t, cond)
+	})
+
+	t.Run("does nothing without a healthy revision", func(t *testing.T) {
+		app := newFakeDegradedApp(time.Now().Add(-time.Hour))
+		app.Status.History[0].Healthy = false
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		cond, _ := ctrl.autoRollback(app, degraded)
+		assert.Nil(t, cond)
+	})
+}
+
+func TestIsAutoSyncPausedByRollback(t *testing.T) {
+	rolledBackAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	app := newFakeApp()
+	assert.False(t, isAutoSyncPausedByRollback(app))
+
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionRollbackWarning, Message: "rolled back", LastTransitionTime: &rolledBackAt}}
+	app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Automated: true}
+	app.Status.OperationState.FinishedAt = &metav1.Time{Time: time.Now()}
+	assert.True(t, isAutoSyncPausedByRollback(app))
+
+	app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Username: "admin"}
+	assert.False(t, isAutoSyncPausedByRollback(app))
+
+	app.Status.OperationState.FinishedAt = &metav1.Time{Time: rolledBackAt.Add(-time.Minute)}
+	assert.True(t, isAutoSyncPausedByRollback(app))
+}
+
+func TestAutoSyncPausedByRollback(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionRollbackWarning, Message: "rolled back"}}
+	app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Automated: true}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestMarkRevisionHistoryHealthy(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeDegradedApp(time.Now())
+		app.Status.Health.Status = health.HealthStatusHealthy
+		app.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}
+		return app
+	}
+
+	app := newApp()
+	markRevisionHistoryHealthy(app)
+	assert.True(t, app.Status.History[2].Healthy)
+	assert.False(t, app.Status.History[1].Healthy)
+
+	app = newApp()
+	app.Status.Health.Status = health.HealthStatusProgressing
+	markRevisionHistoryHealthy(app)
+	assert.False(t, app.Status.History[2].Healthy)
+
+	app = newApp()
+	app.Status.Sync.Revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	markRevisionHistoryHealthy(app)
+	assert.False(t, app.Status.History[2].Healthy)
+
+	app = newApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState.Phase = synccommon.OperationRunning
+	markRevisionHistoryHealthy(app)
+	assert.False(t, app.Status.History[2].Healthy)
+}
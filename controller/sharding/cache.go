@@ -1,10 +1,13 @@
 package sharding
 
 import (
+	"context"
+	"sort"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/db"
 )
@@ -20,6 +23,8 @@ type ClusterShardingCache interface {
 	IsManagedCluster(c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
+	RunRebalancing(ctx context.Context, cache ResourceCostCache)
+	SetClusterReassignedHandler(handler func(server string))
 }
 
 type ClusterSharding struct {
@@ -30,6 +35,10 @@ type ClusterSharding struct {
 	Apps            map[string]*v1alpha1.Application
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	// resourceCostBalancer is only set when the resource-cost sharding algorithm is used
+	resourceCostBalancer *resourceCostBalancer
+	// clusterReassignedHandler is called with the clusters moved to another shard by the rebalancing of the clusters
+	clusterReassignedHandler func(server string)
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -44,6 +53,9 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
+		if shardingAlgorithm == common.ResourceCostShardingAlgorithm {
+			clusterSharding.resourceCostBalancer = getResourceCostBalancer(replicas)
+		}
 		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
 	} else {
 		log.Info("Processing all cluster shards")
@@ -139,8 +151,26 @@ func (sharding *ClusterSharding) GetDistribution() map[string]int {
 	return distribution
 }
 
-func (sharding *ClusterSharding) updateDistribution() {
-	for k, c := range sharding.Clusters {
+// SetClusterReassignedHandler sets the function called with the clusters moved to another shard by the rebalancing of
+// the clusters, which is not notified by the cluster events
+func (sharding *ClusterSharding) SetClusterReassignedHandler(handler func(server string)) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	sharding.clusterReassignedHandler = handler
+}
+
+// updateDistribution assigns the clusters to the shards, in the order of their server so that the distribution
+// functions assigning the clusters one after the other are deterministic, and returns the clusters which have
+// changed shard.
+func (sharding *ClusterSharding) updateDistribution() []string {
+	servers := make([]string, 0, len(sharding.Clusters))
+	for k := range sharding.Clusters {
+		servers = append(servers, k)
+	}
+	sort.Strings(servers)
+	var reassigned []string
+	for _, k := range servers {
+		c := sharding.Clusters[k]
 		shard := 0
 		if c.Shard != nil {
 			requestedShard := int(*c.Shard)
@@ -156,6 +186,7 @@ func (sharding *ClusterSharding) updateDistribution() {
 		existingShard, ok := sharding.Shards[k]
 		if ok && existingShard != shard {
 			log.Infof("Cluster %s has changed shard from %d to %d", k, existingShard, shard)
+			reassigned = append(reassigned, k)
 		} else if !ok {
 			log.Infof("Cluster %s has been assigned to shard %d", k, shard)
 		} else {
@@ -163,6 +194,7 @@ func (sharding *ClusterSharding) updateDistribution() {
 		}
 		sharding.Shards[k] = shard
 	}
+	return reassigned
 }
 
 // hasShardingUpdates returns true if the sharding distribution has explicitly changed
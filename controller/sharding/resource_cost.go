@@ -0,0 +1,404 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+// resourceCostPerAPI is the cost of a watched API relative to the cost of a cached resource: each API requires a watch
+// and the bookkeeping of its informer, whatever the number of resources it holds.
+const resourceCostPerAPI = 100
+
+// resourceCostAssignmentsReadDelay is how long the replicas which do not rebalance the clusters wait for the
+// assignments computed by the rebalancing replica to be saved before reading them
+const resourceCostAssignmentsReadDelay = 30 * time.Second
+
+var (
+	// ResourceCostImbalanceThreshold is the relative difference between the load of the most loaded shard and the
+	// average load above which the clusters are rebalanced. The clusters are then moved until the difference falls
+	// below half of the threshold, so that small variations of the loads do not move the clusters back and forth.
+	ResourceCostImbalanceThreshold = env.ParseFloat64FromEnv(common.EnvControllerShardingImbalanceThreshold, 0.2, 0.01, 10)
+	// ResourceCostRebalanceInterval is the interval at which the loads of the shards are evaluated
+	ResourceCostRebalanceInterval = env.ParseDurationFromEnv(common.EnvControllerShardingRebalanceInterval, 5*time.Minute, 1*time.Minute, 24*time.Hour)
+)
+
+// ResourceCostCache is the cache shared by the controller replicas. It holds the info of the clusters reported by the
+// replicas managing them, the assignment of the clusters to the shards computed by the resource-cost algorithm, and
+// the lease of the replica which rebalances the clusters.
+type ResourceCostCache interface {
+	GetClusterInfo(server string, res *v1alpha1.ClusterInfo) error
+	GetClusterShardAssignments(res *map[string]int) error
+	SetClusterShardAssignments(assignments map[string]int) error
+	AcquireClusterShardRebalancingLease(holder string, duration time.Duration) (bool, error)
+}
+
+var (
+	resourceCostBalancersLock sync.Mutex
+	// resourceCostBalancers holds the balancers indexed by number of replicas, so that the distribution functions
+	// of a controller share the assignments of the clusters
+	resourceCostBalancers = map[int]*resourceCostBalancer{}
+)
+
+// getResourceCostBalancer returns the balancer of the resource-cost algorithm for the given number of replicas
+func getResourceCostBalancer(replicas int) *resourceCostBalancer {
+	resourceCostBalancersLock.Lock()
+	defer resourceCostBalancersLock.Unlock()
+	balancer, ok := resourceCostBalancers[replicas]
+	if !ok {
+		balancer = newResourceCostBalancer(replicas, ResourceCostImbalanceThreshold)
+		resourceCostBalancers[replicas] = balancer
+	}
+	return balancer
+}
+
+// resourceCostBalancer assigns the clusters to the shards according to their cost, and keeps track of the assignments
+// so that the clusters only move when the shards become unbalanced.
+type resourceCostBalancer struct {
+	replicas  int
+	threshold float64
+
+	lock sync.Mutex
+	// costs holds the cost of the clusters indexed by server
+	costs map[string]int64
+	// assignments holds the shard of the clusters indexed by server
+	assignments map[string]int
+}
+
+func newResourceCostBalancer(replicas int, threshold float64) *resourceCostBalancer {
+	return &resourceCostBalancer{
+		replicas:    replicas,
+		threshold:   threshold,
+		costs:       make(map[string]int64),
+		assignments: make(map[string]int),
+	}
+}
+
+// ResourceCostDistributionFunction returns a DistributionFunction which distributes the clusters according to the size
+// of their live state cache: a new cluster is assigned to the least loaded shard, and the clusters are moved from the
+// most loaded shards to the least loaded ones when the loads drift apart by more than the imbalance threshold. The
+// costs of the clusters are only known once the resource-cost rebalancing runs, before that each cluster has the
+// same cost.
+func ResourceCostDistributionFunction(clusters clusterAccessor, balancer *resourceCostBalancer, replicas int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shard, ok := balancer.getShard(c, clusters())
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// getClusterResourceCost returns the cost of managing a cluster according to the size of its live state cache
+func getClusterResourceCost(info *v1alpha1.ClusterInfo) int64 {
+	return info.CacheInfo.ResourcesCount + info.CacheInfo.APIsCount*resourceCostPerAPI
+}
+
+// getShard returns the shard of the given cluster, and assigns it to the least loaded shard if it has none yet
+func (b *resourceCostBalancer) getShard(c *v1alpha1.Cluster, clusters []*v1alpha1.Cluster) (int, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	clusters = sortClustersByServer(clusters)
+	found := false
+	for _, cluster := range clusters {
+		if cluster.Server == c.Server {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return -1, false
+	}
+	if shard, ok := b.assignments[c.Server]; ok && shard < b.replicas {
+		return shard, true
+	}
+	loads := b.getLoads(clusters)
+	shard := leastLoadedShard(loads)
+	b.assignments[c.Server] = shard
+	return shard, true
+}
+
+// rebalance updates the costs of the clusters and moves clusters from the most loaded shards to the least loaded ones
+// if the imbalance of the shards exceeds the threshold. It returns whether the assignments have changed.
+func (b *resourceCostBalancer) rebalance(clusters []*v1alpha1.Cluster, costs map[string]int64) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	clusters = sortClustersByServer(clusters)
+	b.costs = costs
+
+	changed := false
+	servers := make(map[string]bool, len(clusters))
+	for _, c := range clusters {
+		servers[c.Server] = true
+	}
+	for server, shard := range b.assignments {
+		if !servers[server] || shard >= b.replicas {
+			delete(b.assignments, server)
+			changed = true
+		}
+	}
+	for _, c := range clusters {
+		if _, ok := b.assignments[c.Server]; !ok && !isPinned(c, b.replicas) {
+			b.assignments[c.Server] = leastLoadedShard(b.getLoads(clusters))
+			changed = true
+		}
+	}
+
+	loads := b.getLoads(clusters)
+	imbalance := getImbalance(loads)
+	if imbalance <= b.threshold {
+		return changed
+	}
+	log.Infof("Shards are unbalanced (imbalance %.2f is greater than %.2f), rebalancing clusters", imbalance, b.threshold)
+	for imbalance > b.threshold/2 {
+		from := mostLoadedShard(loads)
+		to := leastLoadedShard(loads)
+		// the largest cluster which can be moved without making the destination shard the most loaded one
+		var candidate *v1alpha1.Cluster
+		var candidateCost int64
+		for _, c := range clusters {
+			if isPinned(c, b.replicas) || b.assignments[c.Server] != from {
+				continue
+			}
+			cost := b.getCost(c.Server, clusters)
+			if cost < loads[from]-loads[to] && cost > candidateCost {
+				candidate = c
+				candidateCost = cost
+			}
+		}
+		if candidate == nil {
+			break
+		}
+		log.Infof("Moving cluster %s from shard %d to shard %d", candidate.Server, from, to)
+		b.assignments[candidate.Server] = to
+		loads[from] -= candidateCost
+		loads[to] += candidateCost
+		imbalance = getImbalance(loads)
+		changed = true
+	}
+	return changed
+}
+
+// getLoads returns the sum of the costs of the clusters assigned to each shard
+func (b *resourceCostBalancer) getLoads(clusters []*v1alpha1.Cluster) []int64 {
+	loads := make([]int64, b.replicas)
+	for _, c := range clusters {
+		shard := -1
+		if isPinned(c, b.replicas) {
+			shard = int(*c.Shard)
+		} else if assigned, ok := b.assignments[c.Server]; ok && assigned < b.replicas {
+			shard = assigned
+		}
+		if shard >= 0 {
+			loads[shard] += b.getCost(c.Server, clusters)
+		}
+	}
+	return loads
+}
+
+// getCost returns the cost of the given cluster. The clusters whose cost is not known yet, e.g. because they have
+// just been added, are given the average cost of the clusters.
+func (b *resourceCostBalancer) getCost(server string, clusters []*v1alpha1.Cluster) int64 {
+	if cost, ok := b.costs[server]; ok {
+		return max(cost, 1)
+	}
+	var total, count int64
+	for _, c := range clusters {
+		if cost, ok := b.costs[c.Server]; ok {
+			total += max(cost, 1)
+			count++
+		}
+	}
+	if count == 0 {
+		return 1
+	}
+	return max(total/count, 1)
+}
+
+func (b *resourceCostBalancer) getAssignments() map[string]int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	assignments := make(map[string]int, len(b.assignments))
+	for k, v := range b.assignments {
+		assignments[k] = v
+	}
+	return assignments
+}
+
+func (b *resourceCostBalancer) setAssignments(assignments map[string]int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.assignments = make(map[string]int, len(assignments))
+	for k, v := range assignments {
+		b.assignments[k] = v
+	}
+}
+
+func (b *resourceCostBalancer) setCosts(costs map[string]int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.costs = costs
+}
+
+// getImbalance returns the relative difference between the load of the most loaded shard and the average load
+func getImbalance(loads []int64) float64 {
+	var total int64
+	for _, load := range loads {
+		total += load
+	}
+	if total == 0 {
+		return 0
+	}
+	average := float64(total) / float64(len(loads))
+	return (float64(loads[mostLoadedShard(loads)]) - average) / average
+}
+
+func mostLoadedShard(loads []int64) int {
+	shard := 0
+	for i, load := range loads {
+		if load > loads[shard] {
+			shard = i
+		}
+	}
+	return shard
+}
+
+func leastLoadedShard(loads []int64) int {
+	shard := 0
+	var minLoad int64 = math.MaxInt64
+	for i, load := range loads {
+		if load < minLoad {
+			shard = i
+			minLoad = load
+		}
+	}
+	return shard
+}
+
+func isPinned(c *v1alpha1.Cluster, replicas int) bool {
+	return c.Shard != nil && int(*c.Shard) < replicas
+}
+
+func sortClustersByServer(clusters []*v1alpha1.Cluster) []*v1alpha1.Cluster {
+	sorted := make([]*v1alpha1.Cluster, len(clusters))
+	copy(sorted, clusters)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Server < sorted[j].Server
+	})
+	return sorted
+}
+
+// RunRebalancing periodically rebalances the clusters across the shards according to their cost when the resource-cost
+// algorithm is used, and returns immediately otherwise. Only the replica holding the rebalancing lease in the shared
+// cache moves the clusters and saves their assignments, the other replicas use the saved assignments so that all the
+// replicas agree on the distribution of the clusters.
+func (sharding *ClusterSharding) RunRebalancing(ctx context.Context, cache ResourceCostCache) {
+	if sharding.resourceCostBalancer == nil {
+		return
+	}
+	holder, err := os.Hostname()
+	if err != nil {
+		holder = fmt.Sprintf("shard-%d", sharding.Shard)
+	}
+	sharding.rebalance(ctx, cache, holder)
+	for {
+		next := time.Now().Truncate(ResourceCostRebalanceInterval).Add(ResourceCostRebalanceInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+			sharding.rebalance(ctx, cache, holder)
+		}
+	}
+}
+
+func (sharding *ClusterSharding) rebalance(ctx context.Context, cache ResourceCostCache, holder string) {
+	// the lease outlives a few intervals so that it does not expire while its holder is rebalancing the clusters
+	leader, err := cache.AcquireClusterShardRebalancingLease(holder, 3*ResourceCostRebalanceInterval)
+	if err != nil {
+		log.Warnf("Failed to acquire the cluster shard rebalancing lease: %v", err)
+		return
+	}
+	if !leader {
+		select {
+		case <-ctx.Done():
+		case <-time.After(resourceCostAssignmentsReadDelay):
+		}
+	}
+
+	sharding.lock.RLock()
+	clusters := sharding.getClusterAccessor()()
+	sharding.lock.RUnlock()
+
+	costs := make(map[string]int64, len(clusters))
+	for _, c := range clusters {
+		var info v1alpha1.ClusterInfo
+		if err := cache.GetClusterInfo(c.Server, &info); err != nil {
+			if !errors.Is(err, cacheutil.ErrCacheMiss) {
+				log.Warnf("Failed to get the info of cluster %s to compute its cost: %v", c.Server, err)
+			}
+			continue
+		}
+		if info.CacheInfo.LastCacheSyncTime != nil {
+			costs[c.Server] = getClusterResourceCost(&info)
+		}
+	}
+	var stored map[string]int
+	if err := cache.GetClusterShardAssignments(&stored); err != nil && !errors.Is(err, cacheutil.ErrCacheMiss) {
+		log.Warnf("Failed to get the cluster shard assignments: %v", err)
+		return
+	}
+
+	sharding.lock.Lock()
+	if stored != nil {
+		sharding.resourceCostBalancer.setAssignments(stored)
+	}
+	if leader {
+		sharding.resourceCostBalancer.rebalance(clusters, costs)
+	} else {
+		sharding.resourceCostBalancer.setCosts(costs)
+	}
+	assignments := sharding.resourceCostBalancer.getAssignments()
+	reassigned := sharding.updateDistribution()
+	handler := sharding.clusterReassignedHandler
+	sharding.lock.Unlock()
+
+	if handler != nil {
+		for _, server := range reassigned {
+			handler(server)
+		}
+	}
+	if !leader {
+		return
+	}
+	// the assignments are saved even if unchanged so that they do not expire while the controller runs
+	if err := cache.SetClusterShardAssignments(assignments); err != nil {
+		log.Warnf("Failed to save the cluster shard assignments: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+type fakeResourceCostCache struct {
+	infos       map[string]v1alpha1.ClusterInfo
+	assignments map[string]int
+	leaseHolder string
+}
+
+func (c *fakeResourceCostCache) GetClusterInfo(server string, res *v1alpha1.ClusterInfo) error {
+	info, ok := c.infos[server]
+	if !ok {
+		return cacheutil.ErrCacheMiss
+	}
+	*res = info
+	return nil
+}
+
+func (c *fakeResourceCostCache) GetClusterShardAssignments(res *map[string]int) error {
+	if c.assignments == nil {
+		return cacheutil.ErrCacheMiss
+	}
+	*res = c.assignments
+	return nil
+}
+
+func (c *fakeResourceCostCache) SetClusterShardAssignments(assignments map[string]int) error {
+	c.assignments = assignments
+	return nil
+}
+
+func (c *fakeResourceCostCache) AcquireClusterShardRebalancingLease(holder string, _ time.Duration) (bool, error) {
+	if c.leaseHolder == "" {
+		c.leaseHolder = holder
+	}
+	return c.leaseHolder == holder, nil
+}
+
+// resetResourceCostBalancers forgets the assignments of the clusters shared by the distribution functions
+func resetResourceCostBalancers() {
+	resourceCostBalancersLock.Lock()
+	defer resourceCostBalancersLock.Unlock()
+	resourceCostBalancers = map[int]*resourceCostBalancer{}
+}
+
+func newClusterInfo(resourcesCount int64, apisCount int64) v1alpha1.ClusterInfo {
+	now := metav1.Now()
+	return v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: resourcesCount, APIsCount: apisCount, LastCacheSyncTime: &now}}
+}
+
+func TestResourceCostDistributionFunction(t *testing.T) {
+	_, _, cluster1, cluster2, cluster3, cluster4, _ := createTestClusters()
+	clusterAccessor := getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4})
+	appAccessor, _, _, _, _, _ := createTestApps()
+	resetResourceCostBalancers()
+
+	// the clusters are assigned to the least loaded shard, each cluster having the same cost until their cost is known
+	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, common.ResourceCostShardingAlgorithm, 2)
+	assert.Equal(t, 0, distributionFunction(nil))
+	assert.Equal(t, 0, distributionFunction(&cluster1))
+	assert.Equal(t, 1, distributionFunction(&cluster2))
+	assert.Equal(t, 0, distributionFunction(&cluster3))
+	assert.Equal(t, 1, distributionFunction(&cluster4))
+	// the assignments are stable
+	assert.Equal(t, 0, distributionFunction(&cluster1))
+
+	unknownCluster := createCluster("unknown", "6")
+	assert.Equal(t, -1, distributionFunction(&unknownCluster))
+
+	var fixedShard int64 = 1
+	cluster5 := v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
+	assert.Equal(t, 1, distributionFunction(&cluster5))
+}
+
+func TestResourceCostBalancer_Rebalance(t *testing.T) {
+	_, _, cluster1, cluster2, cluster3, cluster4, _ := createTestClusters()
+	clusters := getClusterPointers([]v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4})
+	balancer := newResourceCostBalancer(2, 0.2)
+	balancer.setAssignments(map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 1})
+
+	t.Run("moves clusters away from the most loaded shard", func(t *testing.T) {
+		changed := balancer.rebalance(clusters, map[string]int64{cluster1.Server: 1000, cluster2.Server: 100, cluster3.Server: 900, cluster4.Server: 100})
+		assert.True(t, changed)
+		assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 0, cluster3.Server: 0, cluster4.Server: 1}, balancer.getAssignments())
+		assert.Equal(t, []int64{1000, 1100}, balancer.getLoads(clusters))
+	})
+
+	t.Run("does not move clusters while the imbalance is below the threshold", func(t *testing.T) {
+		changed := balancer.rebalance(clusters, map[string]int64{cluster1.Server: 1000, cluster2.Server: 100, cluster3.Server: 1150, cluster4.Server: 100})
+		assert.False(t, changed)
+		assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 0, cluster3.Server: 0, cluster4.Server: 1}, balancer.getAssignments())
+	})
+
+	t.Run("does not move pinned clusters", func(t *testing.T) {
+		var fixedShard int64 = 0
+		pinned := cluster3
+		pinned.Shard = &fixedShard
+		clusters := getClusterPointers([]v1alpha1.Cluster{cluster1, cluster2, pinned, cluster4})
+		balancer := newResourceCostBalancer(2, 0.2)
+		changed := balancer.rebalance(clusters, map[string]int64{cluster1.Server: 100, cluster2.Server: 100, pinned.Server: 5000, cluster4.Server: 100})
+		assert.True(t, changed)
+		assert.NotContains(t, balancer.getAssignments(), pinned.Server)
+		assert.Equal(t, []int64{5000, 300}, balancer.getLoads(clusters))
+	})
+
+	t.Run("forgets the removed clusters", func(t *testing.T) {
+		changed := balancer.rebalance(clusters[:3], map[string]int64{cluster1.Server: 1000, cluster2.Server: 100, cluster3.Server: 1000})
+		assert.True(t, changed)
+		assert.NotContains(t, balancer.getAssignments(), cluster4.Server)
+	})
+}
+
+func TestClusterSharding_RunRebalancing(t *testing.T) {
+	_, _, cluster1, cluster2, cluster3, cluster4, _ := createTestClusters()
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4}}
+
+	t.Run("rebalances the clusters according to their cost", func(t *testing.T) {
+		resetResourceCostBalancers()
+		sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.ResourceCostShardingAlgorithm).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 1}, sharding.GetDistribution())
+
+		cache := &fakeResourceCostCache{infos: map[string]v1alpha1.ClusterInfo{
+			cluster1.Server: newClusterInfo(9000, 10),
+			cluster2.Server: newClusterInfo(100, 0),
+			cluster3.Server: newClusterInfo(9000, 10),
+			cluster4.Server: newClusterInfo(100, 0),
+		}}
+		var reassigned []string
+		sharding.SetClusterReassignedHandler(func(server string) {
+			reassigned = append(reassigned, server)
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		sharding.RunRebalancing(ctx, cache)
+
+		expected := map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 1}
+		assert.Equal(t, expected, sharding.GetDistribution())
+		assert.Equal(t, expected, cache.assignments)
+		assert.Equal(t, []string{cluster1.Server}, reassigned)
+	})
+
+	t.Run("uses the assignments computed by the replica holding the lease", func(t *testing.T) {
+		resetResourceCostBalancers()
+		sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.ResourceCostShardingAlgorithm).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+
+		stored := map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 0}
+		cache := &fakeResourceCostCache{
+			infos: map[string]v1alpha1.ClusterInfo{
+				cluster1.Server: newClusterInfo(9000, 10),
+				cluster3.Server: newClusterInfo(100, 0),
+			},
+			assignments: stored,
+			leaseHolder: "other-replica",
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		sharding.RunRebalancing(ctx, cache)
+
+		// the clusters are not rebalanced and the assignments are not overwritten
+		assert.Equal(t, stored, sharding.GetDistribution())
+		assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 0}, cache.assignments)
+	})
+
+	t.Run("does nothing with other algorithms", func(t *testing.T) {
+		sharding := NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.RoundRobinShardingAlgorithm).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		cache := &fakeResourceCostCache{}
+		sharding.RunRebalancing(context.Background(), cache)
+		require.Nil(t, cache.assignments)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	goSync "sync"
 	"time"
@@ -69,8 +70,9 @@ type managedResource struct {
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
 	CompareAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string, hasMultipleSources bool, rollback bool) (*comparisonResult, error)
-	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) time.Duration
 	GetRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, rollback bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error)
+	ForgetApp(app *v1alpha1.Application)
 }
 
 // comparisonResult holds the state of an application after the reconciliation
@@ -87,8 +89,11 @@ type comparisonResult struct {
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings            map[string]time.Duration
 	diffResultList     *diff.DiffResultList
+	hasPreDeleteHooks  bool
 	hasPostDeleteHooks bool
 	revisionUpdated    bool
+	// comparisonInputs are the inputs the diffs have been computed from, nil if they are unknown
+	comparisonInputs *appstatecache.ComparisonInputs
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -115,9 +120,11 @@ type appStateManager struct {
 	resourceTracking      argo.ResourceTracking
 	persistResourceHealth bool
 	repoErrorCache        goSync.Map
-	repoErrorGracePeriod  time.Duration
-	serverSideDiff        bool
-	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	// comparedApps holds the names of the applications compared since the controller started
+	comparedApps         goSync.Map
+	repoErrorGracePeriod time.Duration
+	serverSideDiff       bool
+	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -521,6 +528,24 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("git_ms")
 
+	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
+
+	// the resources rendered from the managed namespace templates of the project are managed by the application creating
+	// the namespace, without being tracked by it since they are shared by the applications deployed to the namespace.
+	// The namespace records their hash, and is OutOfSync until the resources of the removed templates are deleted.
+	var namespaceTemplatesHash string
+	createsNamespace := app.Spec.Destination.Namespace != "" && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true")
+	if createsNamespace {
+		var namespaceTemplateObjs []*unstructured.Unstructured
+		namespaceTemplateObjs, namespaceTemplatesHash, err = renderManagedNamespaceTemplates(app, project.Spec.ManagedNamespaceTemplates)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to render managed namespace templates: %s", err.Error())
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			failedToLoadObjs = true
+		}
+		targetObjs = append(targetObjs, namespaceTemplateObjs...)
+	}
+
 	var infoProvider kubeutil.ResourceInfoProvider
 	infoProvider, err = m.liveStateCache.GetClusterCache(app.Spec.Destination.Server)
 	if err != nil {
@@ -550,6 +575,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		if isManagedNamespace(targetObj, app) {
 			targetNsExists = true
 		}
+		removeChildAppPause(targetObj)
 	}
 	ts.AddCheckpoint("dedup_ms")
 
@@ -586,8 +612,6 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		}
 	}
 
-	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
-
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
 			appInstanceName := m.resourceTracking.GetAppName(liveObj, appLabelKey, trackingMethod)
@@ -622,7 +646,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 				}
 
 				// No need to care about the return value here, we just want the modified managedNs
-				_, err = syncNamespace(m.resourceTracking, appLabelKey, trackingMethod, app.Name, app.Spec.SyncPolicy)(managedNs, liveObj)
+				_, err = syncNamespace(m.resourceTracking, appLabelKey, trackingMethod, app.Name, app.Spec.SyncPolicy, newManagedNamespaceTemplates(app, project))(managedNs, liveObj)
 				if err != nil {
 					conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 					failedToLoadObjs = true
@@ -632,8 +656,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			}
 		}
 	}
+	hasPreDeleteHooks := false
 	hasPostDeleteHooks := false
 	for _, obj := range targetObjs {
+		if isPreDeleteHook(obj) {
+			hasPreDeleteHooks = true
+		}
 		if isPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
@@ -665,10 +693,32 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		serverSideDiff = false
 	}
 
+	// the inputs are only known if the manifests have been generated from the sources of the application
+	var comparisonInputs *appstatecache.ComparisonInputs
+	if len(localManifests) == 0 && len(manifestInfos) == len(sources) {
+		comparisonInputs, err = newComparisonInputs(app, manifestInfos, manifestRevisions, namespaceTemplatesHash, resourceOverrides, compareOptions, appLabelKey, string(trackingMethod), serverSideDiff)
+		if err != nil {
+			logCtx.Warnf("Failed to compute the comparison inputs: %v", err)
+		}
+	}
+
+	firstComparison := m.markCompared(app.InstanceName(m.namespace))
 	useDiffCache := useDiffCache(noCache, manifestInfos, sources, app, manifestRevisions, m.statusRefreshTimeout, serverSideDiff, logCtx)
+	comparisonCacheResult := metrics.ComparisonCacheMiss
+	if useDiffCache {
+		comparisonCacheResult = metrics.ComparisonCacheHit
+	} else if firstComparison && m.usePersistedDiffCache(app, noCache, comparisonInputs, logCtx) {
+		// right after a restart, the status of the application may have expired while the cached diffs are still valid
+		useDiffCache = true
+		comparisonCacheResult = metrics.ComparisonCachePersistedHit
+	}
+	if m.metricsServer != nil {
+		m.metricsServer.IncComparisonCache(app, comparisonCacheResult)
+	}
 
+	ignoreDifferences := append(slices.Clone(app.Spec.IgnoreDifferences), childAppPauseIgnoreDifferences)
 	diffConfigBuilder := argodiff.NewDiffConfigBuilder().
-		WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
+		WithDiffSettings(ignoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
 		WithTracking(appLabelKey, string(trackingMethod))
 
 	if useDiffCache {
@@ -835,6 +885,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	} else if app.HasChangedManagedNamespaceMetadata() {
 		logCtx.Infof("Application has changed managedNamespaceMetadata, marking application as out of sync")
 		syncCode = v1alpha1.SyncStatusCodeOutOfSync
+	} else if createsNamespace && m.hasChangedManagedNamespaceTemplates(app, namespaceTemplatesHash) {
+		logCtx.Infof("Application has changed managed namespace templates, marking application as out of sync")
+		syncCode = v1alpha1.SyncStatusCodeOutOfSync
 	}
 	var revision string
 
@@ -880,6 +933,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		}
 	}
 
+	// the diffs are not reusable if some of the resources could not be loaded or compared
+	if failedToLoadObjs {
+		comparisonInputs = nil
+	}
+
 	compRes := comparisonResult{
 		syncStatus:           &syncStatus,
 		healthStatus:         healthStatus,
@@ -888,8 +946,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		reconciliationResult: reconciliation,
 		diffConfig:           diffConfig,
 		diffResultList:       diffResults,
+		hasPreDeleteHooks:    hasPreDeleteHooks,
 		hasPostDeleteHooks:   hasPostDeleteHooks,
 		revisionUpdated:      revisionUpdated,
+		comparisonInputs:     comparisonInputs,
 	}
 
 	if hasMultipleSources {
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -24,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 	"github.com/argoproj/argo-cd/v2/controller/testdata"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -663,6 +665,55 @@ func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *te
 	assert.Len(t, compRes.managedResources, 1)
 }
 
+func TestCompareAppStateManagedNamespaceTemplates(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"CreateNamespace=true"}}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ManagedNamespaceTemplates = []argoappv1.ManagedNamespaceTemplate{{
+		Name:     "quota",
+		Manifest: "{apiVersion: v1, kind: ResourceQuota, metadata: {name: quota, labels: {app: $ARGOCD_APP_NAME}}, spec: {hard: {pods: '10'}}}",
+	}}
+	resources, hash, err := renderManagedNamespaceTemplates(app, proj.Spec.ManagedNamespaceTemplates)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	quota := resources[0]
+	quotaKey := kube.GetResourceKey(quota)
+
+	compare := func(namespaceHash string, liveQuota *unstructured.Unstructured) *comparisonResult {
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []*apiclient.Manifest{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			clusterResources: []*clustercache.Resource{{
+				Ref:  corev1.ObjectReference{APIVersion: "v1", Kind: kube.NamespaceKind, Name: test.FakeDestNamespace},
+				Info: &statecache.ResourceInfo{ManagedNamespaceTemplatesHash: namespaceHash},
+			}},
+		}
+		if liveQuota != nil {
+			data.managedLiveObjs[quotaKey] = liveQuota
+		}
+		ctrl := newFakeController(&data, nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, proj, []string{}, app.Spec.Sources, false, false, nil, false, false)
+		require.NoError(t, err)
+		assert.Empty(t, app.Status.Conditions)
+		require.Len(t, compRes.managedResources, 1, "the resources of the templates are managed by the application")
+		assert.Equal(t, quotaKey, kube.GetResourceKey(compRes.managedResources[0].Target))
+		assert.Empty(t, compRes.managedResources[0].Target.GetAnnotations()[common.AnnotationKeyAppInstance], "the resources of the templates are not tracked by the application")
+		return compRes
+	}
+
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compare(hash, quota.DeepCopy()).syncStatus.Status)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare("stale", quota.DeepCopy()).syncStatus.Status, "the resources of the removed templates are deleted by the next sync")
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare(hash, nil).syncStatus.Status, "the deleted resources are created again by the next sync")
+	edited := quota.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(edited.Object, "20", "spec", "hard", "pods"))
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare(hash, edited).syncStatus.Status, "the edited resources are applied again by the next sync")
+}
+
 var defaultProj = argoappv1.AppProject{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:      "default",
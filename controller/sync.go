@@ -4,8 +4,8 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -13,8 +13,11 @@ import (
 
 	cdcommon "github.com/argoproj/argo-cd/v2/common"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -22,7 +25,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/openapi"
 
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
@@ -33,14 +39,26 @@ import (
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/rand"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 var syncIdPrefix uint64 = 0
 
 const (
 	// EnvVarSyncWaveDelay is an environment variable which controls the delay in seconds between
-	// each sync-wave
+	// the sync-waves which are not waited for until they are ready
 	EnvVarSyncWaveDelay = "ARGOCD_SYNC_WAVE_DELAY"
+
+	// EnvVarSyncWaveTimeout is an environment variable which controls the time to wait for the resources
+	// of each sync-wave to become ready before failing the sync, 0 falls back to the fixed delay
+	EnvVarSyncWaveTimeout = "ARGOCD_SYNC_WAVE_TIMEOUT"
+
+	// syncOptionPruneLimit is the sync option limiting the number of resources a sync is allowed to prune
+	syncOptionPruneLimit = "PruneLimit"
+
+	// syncOptionValidateWithServerDryRun is the sync option validating all the resources with a server-side dry run
+	// before any of them gets applied
+	syncOptionValidateWithServerDryRun = "ValidateWithServerDryRun=true"
 )
 
 func (m *appStateManager) getOpenAPISchema(server string) (openapi.Resources, error) {
@@ -80,7 +98,7 @@ func (m *appStateManager) getResourceOperations(server string) (kube.ResourceOpe
 	return ops, cleanup, nil
 }
 
-func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
+func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) (requeueAfter time.Duration) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult field.
@@ -288,9 +306,118 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
 
+	resourcesFilter := func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+		return (len(syncOp.Resources) == 0 ||
+			isPreDeleteHook(target) ||
+			isPostDeleteHook(target) ||
+			argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
+			m.isSelfReferencedObj(live, target, app.GetName(), appLabelKey, trackingMethod)
+	}
+
+	// The prune limit is only verified before the first resource gets applied, so that an operation which has been
+	// started is not interrupted half way, e.g. when resuming a sync after its hooks have completed.
+	if syncOp.Prune && !syncOp.DryRun && !syncOp.IgnorePruneLimit && len(syncRes.Resources) == 0 && state.Phase != common.OperationTerminating {
+		message, err := getPruneLimitExceededMessage(syncOp.SyncOptions, reconciliationResult, resourcesFilter)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = err.Error()
+			return
+		}
+		if message != "" {
+			state.Phase = common.OperationFailed
+			state.Message = message
+			return
+		}
+	}
+
+	// the logs of the pods failing during the sync are captured before they get deleted by their hook delete policy
+	var logsCapturer *syncLogsCapturer
+	var healthOverride health.HealthOverride = lua.ResourceHealthOverrides(resourceOverrides)
+	if !syncOp.DryRun {
+		if kubeClientset, err := kubernetes.NewForConfig(restConfig); err != nil {
+			logEntry.Warnf("Failed to create a client to capture the logs of failed pods: %v", err)
+		} else {
+			logsCapturer = newSyncLogsCapturer(kubeClientset, logEntry)
+			logsCapturer.skipCaptured(m.getPersistedSyncLogs(app, syncRes))
+			healthOverride = logsCapturer.healthOverride(healthOverride)
+		}
+	}
+
+	serviceAccount, err := getServiceAccountToImpersonate(m.settingsMgr, app, proj, clst.Server)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = err.Error()
+		return
+	}
+	if serviceAccount != "" {
+		logEntry = logEntry.WithField("impersonatedServiceAccount", serviceAccount)
+		if auditLogEnabled, err := m.settingsMgr.IsImpersonationAuditLogEnabled(); err != nil {
+			logEntry.Warnf("Failed to check whether impersonation audit logging is enabled: %v", err)
+		} else if auditLogEnabled {
+			logEntry.WithFields(log.Fields{
+				"project":     proj.Name,
+				"server":      clst.Server,
+				"namespace":   app.Spec.Destination.Namespace,
+				"initiatedBy": state.Operation.InitiatedBy.Username,
+				"automated":   state.Operation.InitiatedBy.Automated,
+			}).Infof("Syncing application as %s", serviceAccount)
+		}
+		// the resources, including the hooks, are applied and pruned with the privileges of the service account only.
+		// The logs of the failed pods are still captured with the privileges of the controller.
+		rawConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+		restConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+
+	// Like the prune limit, the server-side dry run is only performed before the first resource gets applied. It uses
+	// the same credentials as the sync, so that the admission webhooks see the same user.
+	if syncOp.SyncOptions.HasOption(syncOptionValidateWithServerDryRun) && !syncOp.DryRun && len(syncRes.Resources) == 0 && state.Phase != common.OperationTerminating {
+		resourceOps, cleanupOps, err := m.kubectl.ManageResources(rawConfig, openAPISchema)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to initialize the server-side dry run: %v", err)
+			return
+		}
+		dryRunOpts := serverDryRunOptions{
+			validate:                    !syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation),
+			serverSideApply:             syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply),
+			replace:                     syncOp.SyncOptions.HasOption(common.SyncOptionReplace),
+			skipDryRunOnMissingResource: syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource),
+		}
+		if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
+			dryRunOpts.createdNamespace = app.Spec.Destination.Namespace
+		}
+		if clusterCache, err := m.liveStateCache.GetClusterCache(clst.Server); err != nil {
+			logEntry.Warnf("Failed to get the API resources served by the cluster for the server-side dry run: %v", err)
+		} else {
+			dryRunOpts.servedGVKs = make(map[schema.GroupVersionKind]bool)
+			for _, res := range clusterCache.GetAPIResources() {
+				dryRunOpts.servedGVKs[res.GroupKind.WithVersion(res.GroupVersionResource.Version)] = true
+			}
+		}
+		rejected, validated := getServerDryRunRejections(context.Background(), resourceOps, reconciliationResult, resourcesFilter, dryRunOpts)
+		cleanupOps()
+		if len(rejected) > 0 {
+			var messages []string
+			for _, res := range rejected {
+				messages = append(messages, fmt.Sprintf("%s/%s/%s: %s", res.Kind, res.Namespace, res.Name, res.Message))
+			}
+			syncRes.Resources = rejected
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Server-side dry run rejected %d of %d resources, nothing was applied: %s", len(rejected), validated, strings.Join(messages, "; "))
+			return
+		}
+		logEntry.Infof("Server-side dry run validated %d resources", validated)
+	}
+
+	// the resources of the removed managed namespace templates are deleted once the namespace created by the sync exists
+	var namespaceTemplates *managedNamespaceTemplates
+	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
+		namespaceTemplates = newManagedNamespaceTemplates(app, proj)
+	}
+
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(healthOverride),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
@@ -311,14 +438,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		}),
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
-		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
-			return (len(syncOp.Resources) == 0 ||
-				isPostDeleteHook(target) ||
-				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
-				m.isSelfReferencedObj(live, target, app.GetName(), appLabelKey, trackingMethod)
-		}),
+		sync.WithResourcesFilter(resourcesFilter),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveHook(m.withManagedNamespaceTemplates(m.newSyncWaveHook(app, state, reconciliationResult, resourcesFilter, syncOp.DryRun), namespaceTemplates, restConfig, syncOp.DryRun, logEntry)),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -328,7 +450,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
-		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(m.resourceTracking, appLabelKey, trackingMethod, app.Name, app.Spec.SyncPolicy)))
+		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(m.resourceTracking, appLabelKey, trackingMethod, app.Name, app.Spec.SyncPolicy, namespaceTemplates)))
 	}
 
 	syncCtx, cleanup, err := sync.NewSyncContext(
@@ -348,12 +470,29 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 
 	defer cleanup()
+	defer func() {
+		if state.Phase.Completed() {
+			resetSyncWave(state.SyncResult)
+		}
+	}()
 
 	start := time.Now()
 
 	if state.Phase == common.OperationTerminating {
 		syncCtx.Terminate()
 	} else {
+		// the operation is requeued until the last applied wave is ready, the next one is only applied afterwards
+		message, after, err := m.getSyncWaveWait(app, state, reconciliationResult, resourcesFilter, lua.ResourceHealthOverrides(resourceOverrides))
+		if err != nil {
+			state.Phase = common.OperationFailed
+			state.Message = err.Error()
+			return
+		}
+		if after > 0 {
+			logEntry.Info(message)
+			state.Message = message
+			return after
+		}
 		logEntry.Infof("Starting sync operation for revision \"%s\"", compareResult.syncStatus.Revision)
 		syncCtx.Sync()
 	}
@@ -361,6 +500,21 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	state.Phase, state.Message, resState = syncCtx.GetState()
 	state.SyncResult.Resources = nil
 
+	// the sync only completes once the final wave is ready if it is waited for
+	if state.Phase == common.OperationSucceeded {
+		if wave, ok := isWaitingForFinalSyncWave(state); ok {
+			state.Phase = common.OperationRunning
+			state.Message = fmt.Sprintf("waiting for sync wave %d to become ready", wave)
+			requeueAfter = syncWaveReadinessPollInterval
+		}
+	}
+
+	if logsCapturer != nil {
+		if err := m.persistCapturedSyncLogs(app, state.SyncResult, logsCapturer.getCapturedLogs(), syncId); err != nil {
+			logEntry.Warnf("Failed to store the logs captured from failed pods: %v", err)
+		}
+	}
+
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
 	}
@@ -406,6 +560,24 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
 		}
 	}
+	return requeueAfter
+}
+
+// getServiceAccountToImpersonate returns the service account to impersonate in order to manage the resources of the
+// application in the given cluster, or an empty string if impersonation is disabled
+func getServiceAccountToImpersonate(settingsMgr *settings.SettingsManager, app *v1alpha1.Application, proj *v1alpha1.AppProject, server string) (string, error) {
+	impersonationEnabled, err := settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return "", fmt.Errorf("failed to check whether impersonation is enabled: %w", err)
+	}
+	if !impersonationEnabled {
+		return "", nil
+	}
+	serviceAccount, err := proj.GetServiceAccountToImpersonate(v1alpha1.ApplicationDestination{Server: server, Namespace: app.Spec.Destination.Namespace}, app.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to find a matching service account to impersonate: %w", err)
+	}
+	return serviceAccount, nil
 }
 
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
@@ -499,6 +671,165 @@ func applyMergePatch(obj *unstructured.Unstructured, patch []byte, versionedObje
 	return patchedObj, nil
 }
 
+// getPruneLimitExceededMessage returns a message listing the resources which would be pruned by the sync if their
+// number exceeds the limit configured with the PruneLimit sync option, or an empty string if the sync may proceed.
+// The limit is either an absolute number of resources (e.g. PruneLimit=5) or a percentage of the resources currently
+// managed by the application (e.g. PruneLimit=20%).
+func getPruneLimitExceededMessage(syncOptions v1alpha1.SyncOptions, reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool) (string, error) {
+	value, ok := getSyncOptionValue(syncOptions, syncOptionPruneLimit)
+	if !ok {
+		return "", nil
+	}
+	percentage := strings.HasSuffix(value, "%")
+	limit, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || limit < 0 || (percentage && limit > 100) {
+		return "", fmt.Errorf("invalid sync option %s=%s: must be a positive number of resources or a percentage", syncOptionPruneLimit, value)
+	}
+
+	managed := 0
+	var pruned []string
+	for i, live := range reconciliationResult.Live {
+		if live == nil || hook.IsHook(live) {
+			continue
+		}
+		managed++
+		target := reconciliationResult.Target[i]
+		if target != nil || resourceutil.HasAnnotationOption(live, common.AnnotationSyncOptions, common.SyncOptionDisablePrune) {
+			continue
+		}
+		if filter(kube.GetResourceKey(live), target, live) {
+			pruned = append(pruned, fmt.Sprintf("%s/%s/%s", live.GetKind(), live.GetNamespace(), live.GetName()))
+		}
+	}
+
+	allowed := limit
+	if percentage {
+		allowed = managed * limit / 100
+	}
+	if len(pruned) <= allowed {
+		return "", nil
+	}
+	return fmt.Sprintf("Sync would prune %d of %d managed resources, which exceeds the prune limit of %s: %s. Sync with ignorePruneLimit to prune them anyway",
+		len(pruned), managed, value, strings.Join(pruned, ", ")), nil
+}
+
+// serverDryRunOptions holds the sync options of the server-side dry run, the sync options annotations of each resource
+// take precedence
+type serverDryRunOptions struct {
+	validate                    bool
+	serverSideApply             bool
+	replace                     bool
+	skipDryRunOnMissingResource bool
+	// createdNamespace is the destination namespace created by the CreateNamespace sync option
+	createdNamespace string
+	// servedGVKs holds the resource types served by the API server, all of them are assumed to be served if nil
+	servedGVKs map[schema.GroupVersionKind]bool
+}
+
+// getServerDryRunRejections applies the resources of the sync with a server-side dry run, and returns the resources
+// rejected by the API server, its admission webhooks or policy engines along with the number of validated resources.
+// Hooks are not validated, neither are the resources which cannot be validated before the sync creates their
+// namespace or their custom resource definition, nor the resources of a missing type with the
+// SkipDryRunOnMissingResource sync option.
+func getServerDryRunRejections(ctx context.Context, resourceOps kube.ResourceOperations, reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool, opts serverDryRunOptions) ([]*v1alpha1.ResourceResult, int) {
+	createdNamespaces := make(map[string]bool)
+	if opts.createdNamespace != "" {
+		createdNamespaces[opts.createdNamespace] = true
+	}
+	createdGroupKinds := make(map[schema.GroupKind]bool)
+	syncedGroupKinds := make(map[schema.GroupKind]bool)
+	for i, target := range reconciliationResult.Target {
+		if target == nil {
+			continue
+		}
+		if kube.IsCRD(target) {
+			group, _, _ := unstructured.NestedString(target.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(target.Object, "spec", "names", "kind")
+			syncedGroupKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+			if reconciliationResult.Live[i] == nil {
+				createdGroupKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+			}
+		} else if target.GetAPIVersion() == "v1" && target.GetKind() == kube.NamespaceKind && reconciliationResult.Live[i] == nil {
+			createdNamespaces[target.GetName()] = true
+		}
+	}
+
+	validated := 0
+	var rejected []*v1alpha1.ResourceResult
+	for i, target := range reconciliationResult.Target {
+		if target == nil || hook.IsHook(target) {
+			continue
+		}
+		gvk := target.GroupVersionKind()
+		if createdGroupKinds[gvk.GroupKind()] {
+			continue
+		}
+		// like the sync, the resources of a type which is not served yet are not validated if their custom resource
+		// definition is part of the sync or if they skip the dry run when their type is missing
+		if opts.servedGVKs != nil && !opts.servedGVKs[gvk] && (syncedGroupKinds[gvk.GroupKind()] || opts.skipDryRunOnMissingResource ||
+			resourceutil.HasAnnotationOption(target, common.AnnotationSyncOptions, common.SyncOptionSkipDryRunOnMissingResource)) {
+			continue
+		}
+		live := reconciliationResult.Live[i]
+		if !filter(kube.GetResourceKey(target), target, live) {
+			continue
+		}
+		err := serverDryRunResource(ctx, resourceOps, target, live, opts)
+		if err != nil && createdNamespaces[target.GetNamespace()] && strings.Contains(err.Error(), fmt.Sprintf("namespaces %q not found", target.GetNamespace())) {
+			continue
+		}
+		validated++
+		if err != nil {
+			rejected = append(rejected, &v1alpha1.ResourceResult{
+				Group:     gvk.Group,
+				Version:   gvk.Version,
+				Kind:      gvk.Kind,
+				Namespace: target.GetNamespace(),
+				Name:      target.GetName(),
+				Status:    common.ResultCodeSyncFailed,
+				SyncPhase: common.SyncPhaseSync,
+				Message:   err.Error(),
+			})
+		}
+	}
+	return rejected, validated
+}
+
+// serverDryRunResource sends the resource to the API server with a server-side dry run the same way the sync applies
+// it: replaced or created with the Replace sync option, applied server-side with the ServerSideApply sync option, and
+// applied client-side otherwise
+func serverDryRunResource(ctx context.Context, resourceOps kube.ResourceOperations, target *unstructured.Unstructured, live *unstructured.Unstructured, opts serverDryRunOptions) error {
+	validate := opts.validate && !resourceutil.HasAnnotationOption(target, common.AnnotationSyncOptions, common.SyncOptionsDisableValidation)
+	if opts.replace || resourceutil.HasAnnotationOption(target, common.AnnotationSyncOptions, common.SyncOptionReplace) {
+		if live == nil {
+			_, err := resourceOps.CreateResource(ctx, target, cmdutil.DryRunServer, validate)
+			return err
+		}
+		// the custom resource definitions and namespaces are updated, since replacing them deletes their resources
+		if kube.IsCRD(target) || target.GetKind() == kube.NamespaceKind {
+			update := target.DeepCopy()
+			update.SetResourceVersion(live.GetResourceVersion())
+			_, err := resourceOps.UpdateResource(ctx, update, cmdutil.DryRunServer)
+			return err
+		}
+		_, err := resourceOps.ReplaceResource(ctx, target, cmdutil.DryRunServer, false)
+		return err
+	}
+	serverSideApply := opts.serverSideApply || resourceutil.HasAnnotationOption(target, common.AnnotationSyncOptions, common.SyncOptionServerSideApply)
+	_, err := resourceOps.ApplyResource(ctx, target, cmdutil.DryRunServer, false, validate, serverSideApply, cdcommon.ArgoCDSSAManager, false)
+	return err
+}
+
+// getSyncOptionValue returns the value of the sync option with the given name, e.g. "5" for PruneLimit=5
+func getSyncOptionValue(syncOptions v1alpha1.SyncOptions, name string) (string, bool) {
+	for _, option := range syncOptions {
+		if key, value, found := strings.Cut(option, "="); found && strings.TrimSpace(key) == name {
+			return strings.TrimSpace(value), true
+		}
+	}
+	return "", false
+}
+
 // hasSharedResourceCondition will check if the Application has any resource that has already
 // been synced by another Application. If the resource is found in another Application it returns
 // true along with a human readable message of which specific resource has this condition.
@@ -511,28 +842,6 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 	return false, ""
 }
 
-// delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
-// between each sync wave. We introduce an artificial delay in order give other controllers a
-// _chance_ to react to the spec change that we just applied. This is important because without
-// this, Argo CD will likely assess resource health too quickly (against the stale object), causing
-// hooks to fire prematurely. See: https://github.com/argoproj/argo-cd/issues/4669.
-// Note, this is not foolproof, since a proper fix would require the CRD record
-// status.observedGeneration coupled with a health.lua that verifies
-// status.observedGeneration == metadata.generation
-func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) error {
-	if !finalWave {
-		delaySec := 2
-		if delaySecStr := os.Getenv(EnvVarSyncWaveDelay); delaySecStr != "" {
-			if val, err := strconv.Atoi(delaySecStr); err == nil {
-				delaySec = val
-			}
-		}
-		duration := time.Duration(delaySec) * time.Second
-		time.Sleep(duration)
-	}
-	return nil
-}
-
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) bool {
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// newSyncHistoryRecord returns the record of a completed sync operation for the extended sync history, or nil if the
+// operation is not a sync or is a dry run
+func newSyncHistoryRecord(state *appv1.OperationState) *appv1.SyncHistoryRecord {
+	if state.Operation.Sync == nil || state.Operation.Sync.DryRun {
+		return nil
+	}
+	record := &appv1.SyncHistoryRecord{
+		InitiatedBy: state.Operation.InitiatedBy,
+		StartedAt:   state.StartedAt,
+		FinishedAt:  state.FinishedAt,
+		Phase:       state.Phase,
+		Message:     state.Message,
+	}
+	if state.FinishedAt != nil {
+		record.DurationMs = state.FinishedAt.Sub(state.StartedAt.Time).Milliseconds()
+	}
+	if state.SyncResult != nil {
+		if len(state.SyncResult.Revisions) > 0 {
+			record.Revisions = state.SyncResult.Revisions
+		} else {
+			record.Revisions = []string{state.SyncResult.Revision}
+		}
+		record.Resources = state.SyncResult.Resources
+	}
+	return record
+}
+
+// recordSyncHistory keeps the result of a completed sync operation in the extended sync history of the application.
+// The history is only served while the application exists, and expires with its records.
+func (ctrl *ApplicationController) recordSyncHistory(app *appv1.Application, state *appv1.OperationState, logCtx *log.Entry) {
+	record := newSyncHistoryRecord(state)
+	if record == nil {
+		return
+	}
+	if err := ctrl.cache.AddAppSyncHistoryRecord(app.InstanceName(ctrl.namespace), record); err != nil {
+		logCtx.Warnf("Failed to record the sync operation in the sync history: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestNewSyncHistoryRecord(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC))
+	finishedAt := metav1.NewTime(startedAt.Add(90 * time.Second))
+	state := &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}},
+		Phase:      synccommon.OperationFailed,
+		Message:    "one or more objects failed to apply",
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Revision:  "abc123",
+			Resources: v1alpha1.ResourceResults{{Kind: "ConfigMap", Namespace: "default", Name: "my-map", Status: synccommon.ResultCodeSyncFailed}},
+		},
+	}
+
+	record := newSyncHistoryRecord(state)
+	require.NotNil(t, record)
+	assert.Equal(t, []string{"abc123"}, record.Revisions)
+	assert.Equal(t, "admin", record.InitiatedBy.Username)
+	assert.Equal(t, int64(90000), record.DurationMs)
+	assert.Equal(t, synccommon.OperationFailed, record.Phase)
+	assert.Equal(t, state.Message, record.Message)
+	assert.Equal(t, state.SyncResult.Resources, record.Resources)
+
+	t.Run("multiple sources", func(t *testing.T) {
+		multiSource := state.DeepCopy()
+		multiSource.SyncResult.Revisions = []string{"abc123", "def456"}
+		assert.Equal(t, []string{"abc123", "def456"}, newSyncHistoryRecord(multiSource).Revisions)
+	})
+	t.Run("dry run", func(t *testing.T) {
+		dryRun := state.DeepCopy()
+		dryRun.Operation.Sync.DryRun = true
+		assert.Nil(t, newSyncHistoryRecord(dryRun))
+	})
+}
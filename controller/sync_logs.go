@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// capturedLogsTailLines is the number of lines captured from the end of the logs of each container
+	capturedLogsTailLines = int64(100)
+	// capturedLogsLimitBytes bounds the size of the logs captured from each container
+	capturedLogsLimitBytes = int64(16 * 1024)
+	// capturedLogsMaxPods is the number of pods of a failed Job whose logs are captured, most recent first
+	capturedLogsMaxPods = 3
+	// capturedLogsTimeout bounds the time spent capturing the logs of a resource, during which the sync is blocked
+	capturedLogsTimeout = 10 * time.Second
+	// capturedLogsMaxBytes bounds the total size of the logs captured during an operation
+	capturedLogsMaxBytes = int64(256 * 1024)
+)
+
+// syncLogsCapturer captures the tail of the container logs of the Pods and Jobs which fail during a sync, before the
+// sync context gets a chance to delete them according to their hook delete policy
+type syncLogsCapturer struct {
+	kubeClientset kubernetes.Interface
+	logCtx        *log.Entry
+	timeout       time.Duration
+	maxBytes      int64
+
+	lock          sync.Mutex
+	captured      map[kube.ResourceKey]bool
+	logs          []*v1alpha1.CapturedContainerLogs
+	capturedBytes int64
+}
+
+func newSyncLogsCapturer(kubeClientset kubernetes.Interface, logCtx *log.Entry) *syncLogsCapturer {
+	return &syncLogsCapturer{
+		kubeClientset: kubeClientset,
+		logCtx:        logCtx,
+		timeout:       capturedLogsTimeout,
+		maxBytes:      capturedLogsMaxBytes,
+		captured:      map[kube.ResourceKey]bool{},
+	}
+}
+
+// healthOverride wraps the given health override so that the logs of a resource are captured as soon as it is
+// assessed degraded, which is what makes the sync context fail the resource
+func (c *syncLogsCapturer) healthOverride(override health.HealthOverride) health.HealthOverride {
+	return capturingHealthOverride{capturer: c, override: override}
+}
+
+type capturingHealthOverride struct {
+	capturer *syncLogsCapturer
+	override health.HealthOverride
+}
+
+func (o capturingHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	healthStatus, err := health.GetResourceHealth(obj, o.override)
+	if err == nil && healthStatus != nil && healthStatus.Status == health.HealthStatusDegraded {
+		o.capturer.capture(obj)
+	}
+	return healthStatus, err
+}
+
+// capture captures the logs of the given Pod, or of the most recent pods of the given Job, once per resource. The
+// capture is bounded in time and in size since it blocks the health assessment of the sync.
+func (c *syncLogsCapturer) capture(obj *unstructured.Unstructured) {
+	gvk := obj.GroupVersionKind()
+	isPod := gvk.Group == "" && gvk.Kind == kube.PodKind
+	isJob := gvk.Group == "batch" && gvk.Kind == kube.JobKind
+	if !isPod && !isJob {
+		return
+	}
+	key := kube.GetResourceKey(obj)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.captured[key] {
+		return
+	}
+	c.captured[key] = true
+	if c.capturedBytes >= c.maxBytes {
+		c.logCtx.Warnf("Skipping the capture of the logs of %s/%s: %d bytes of logs already captured", obj.GetNamespace(), obj.GetName(), c.capturedBytes)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var pods []corev1.Pod
+	if isPod {
+		pod, err := c.kubeClientset.CoreV1().Pods(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			c.logCtx.Warnf("Failed to get pod %s/%s to capture its logs: %v", obj.GetNamespace(), obj.GetName(), err)
+			return
+		}
+		pods = append(pods, *pod)
+	} else {
+		podList, err := c.kubeClientset.CoreV1().Pods(obj.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("job-name=%s", obj.GetName())})
+		if err != nil {
+			c.logCtx.Warnf("Failed to list the pods of job %s/%s to capture their logs: %v", obj.GetNamespace(), obj.GetName(), err)
+			return
+		}
+		pods = podList.Items
+		sort.Slice(pods, func(i, j int) bool {
+			return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+		})
+		if len(pods) > capturedLogsMaxPods {
+			pods = pods[:capturedLogsMaxPods]
+		}
+	}
+
+	now := metav1.Now()
+	for _, pod := range pods {
+		var containers []string
+		for _, container := range pod.Spec.InitContainers {
+			containers = append(containers, container.Name)
+		}
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+		for _, container := range containers {
+			limitBytes := min(capturedLogsLimitBytes, c.maxBytes-c.capturedBytes)
+			if limitBytes <= 0 {
+				return
+			}
+			logs, err := c.getContainerLogs(ctx, pod.Namespace, pod.Name, container, limitBytes)
+			if err != nil {
+				c.logCtx.Warnf("Failed to capture the logs of container %s of pod %s/%s: %v", container, pod.Namespace, pod.Name, err)
+				if ctx.Err() != nil {
+					return
+				}
+				continue
+			}
+			c.capturedBytes += int64(len(logs))
+			c.logs = append(c.logs, &v1alpha1.CapturedContainerLogs{
+				Group:      gvk.Group,
+				Kind:       gvk.Kind,
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+				PodName:    pod.Name,
+				Container:  container,
+				Logs:       logs,
+				CapturedAt: now,
+			})
+		}
+	}
+}
+
+func (c *syncLogsCapturer) getContainerLogs(ctx context.Context, namespace string, podName string, container string, limitBytes int64) (string, error) {
+	tailLines := capturedLogsTailLines
+	stream, err := c.kubeClientset.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	}).Stream(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(stream, limitBytes)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// skipCaptured prevents the logs of the resources which logs have already been captured by the previous iterations of
+// the operation from being captured again, and counts them against the size bound of the operation
+func (c *syncLogsCapturer) skipCaptured(logs []*v1alpha1.CapturedContainerLogs) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, l := range logs {
+		c.captured[kube.NewResourceKey(l.Group, l.Kind, l.Namespace, l.Name)] = true
+		c.capturedBytes += int64(len(l.Logs))
+	}
+}
+
+// getCapturedLogs returns the logs captured so far
+func (c *syncLogsCapturer) getCapturedLogs() []*v1alpha1.CapturedContainerLogs {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.logs
+}
+
+// getPersistedSyncLogs returns the logs captured by the previous iterations of the operation
+func (m *appStateManager) getPersistedSyncLogs(app *v1alpha1.Application, syncRes *v1alpha1.SyncOperationResult) []*v1alpha1.CapturedContainerLogs {
+	if syncRes == nil || syncRes.CapturedLogsID == "" {
+		return nil
+	}
+	var logs []*v1alpha1.CapturedContainerLogs
+	if err := m.cache.GetAppSyncCapturedLogs(app.InstanceName(m.namespace), syncRes.CapturedLogsID, &logs); err != nil {
+		return nil
+	}
+	return logs
+}
+
+// persistCapturedSyncLogs stores the logs captured during a sync iteration in the cache, along with the logs captured
+// by the previous iterations of the operation, and references them from the sync result. The logs of a container which
+// have already been stored by a previous iteration are kept as is.
+func (m *appStateManager) persistCapturedSyncLogs(app *v1alpha1.Application, syncRes *v1alpha1.SyncOperationResult, logs []*v1alpha1.CapturedContainerLogs, syncId string) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	if syncRes.CapturedLogsID == "" {
+		syncRes.CapturedLogsID = fmt.Sprintf("%d-%s", time.Now().Unix(), syncId)
+	} else if previous := m.getPersistedSyncLogs(app, syncRes); len(previous) > 0 {
+		persisted := map[string]bool{}
+		for _, l := range previous {
+			persisted[capturedContainerLogsKey(l)] = true
+		}
+		merged := previous
+		for _, l := range logs {
+			if !persisted[capturedContainerLogsKey(l)] {
+				merged = append(merged, l)
+			}
+		}
+		if len(merged) == len(previous) {
+			return nil
+		}
+		logs = merged
+	}
+	return m.cache.SetAppSyncCapturedLogs(app.InstanceName(m.namespace), syncRes.CapturedLogsID, logs)
+}
+
+// capturedContainerLogsKey identifies the logs of a container of a pod of a resource
+func capturedContainerLogsKey(l *v1alpha1.CapturedContainerLogs) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", l.Group, l.Kind, l.Namespace, l.Name, l.PodName, l.Container)
+}
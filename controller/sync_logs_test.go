@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newFakeJobPod(name string, createdAt time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{"job-name": "migrate"},
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "main"}},
+		},
+	}
+}
+
+func newFakeJob(failed bool) *unstructured.Unstructured {
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":      "migrate",
+			"namespace": "default",
+		},
+	}}
+	condition := "Complete"
+	if failed {
+		condition = "Failed"
+	}
+	_ = unstructured.SetNestedSlice(job.Object, []interface{}{map[string]interface{}{"type": condition, "status": "True"}}, "status", "conditions")
+	return job
+}
+
+func TestSyncLogsCapturer(t *testing.T) {
+	now := time.Now()
+	var pods []runtime.Object
+	for i, name := range []string{"migrate-a", "migrate-b", "migrate-c", "migrate-d"} {
+		pods = append(pods, newFakeJobPod(name, now.Add(time.Duration(i)*time.Minute)))
+	}
+
+	t.Run("captures the logs of the most recent pods of a failed job", func(t *testing.T) {
+		capturer := newSyncLogsCapturer(fake.NewSimpleClientset(pods...), log.NewEntry(log.New()))
+		override := capturer.healthOverride(nil)
+
+		healthStatus, err := override.GetResourceHealth(newFakeJob(true))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		// the logs are only captured once
+		_, err = override.GetResourceHealth(newFakeJob(true))
+		require.NoError(t, err)
+
+		logs := capturer.getCapturedLogs()
+		require.Len(t, logs, 6)
+		var captured []string
+		for _, l := range logs {
+			assert.Equal(t, "batch", l.Group)
+			assert.Equal(t, "Job", l.Kind)
+			assert.Equal(t, "migrate", l.Name)
+			assert.Equal(t, "fake logs", l.Logs)
+			captured = append(captured, l.PodName+"/"+l.Container)
+		}
+		assert.Equal(t, []string{"migrate-d/init", "migrate-d/main", "migrate-c/init", "migrate-c/main", "migrate-b/init", "migrate-b/main"}, captured)
+	})
+
+	t.Run("bounds the size of the captured logs", func(t *testing.T) {
+		capturer := newSyncLogsCapturer(fake.NewSimpleClientset(pods...), log.NewEntry(log.New()))
+		capturer.maxBytes = 20
+		_, err := capturer.healthOverride(nil).GetResourceHealth(newFakeJob(true))
+		require.NoError(t, err)
+
+		logs := capturer.getCapturedLogs()
+		require.Len(t, logs, 3)
+		assert.Equal(t, "fake logs", logs[0].Logs)
+		assert.Equal(t, "fake logs", logs[1].Logs)
+		assert.Equal(t, "fa", logs[2].Logs)
+
+		// the logs of the other resources are no longer captured once the bound is reached
+		pod := newFakeJob(true)
+		pod.SetKind("Pod")
+		pod.SetAPIVersion("v1")
+		capturer.capture(pod)
+		assert.Len(t, capturer.getCapturedLogs(), 3)
+	})
+
+	t.Run("does not capture the logs of a successful job", func(t *testing.T) {
+		capturer := newSyncLogsCapturer(fake.NewSimpleClientset(pods...), log.NewEntry(log.New()))
+		healthStatus, err := capturer.healthOverride(nil).GetResourceHealth(newFakeJob(false))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Empty(t, capturer.getCapturedLogs())
+	})
+}
+
+func TestPersistCapturedSyncLogs(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	m := ctrl.appStateManager.(*appStateManager)
+	syncRes := &v1alpha1.SyncOperationResult{}
+
+	require.NoError(t, m.persistCapturedSyncLogs(app, syncRes, nil, "00001-abcde"))
+	assert.Empty(t, syncRes.CapturedLogsID)
+
+	require.NoError(t, m.persistCapturedSyncLogs(app, syncRes, []*v1alpha1.CapturedContainerLogs{{Kind: "Job", Name: "first"}}, "00001-abcde"))
+	require.NotEmpty(t, syncRes.CapturedLogsID)
+	id := syncRes.CapturedLogsID
+
+	// the logs captured by the following iterations of the operation are appended
+	require.NoError(t, m.persistCapturedSyncLogs(app, syncRes, []*v1alpha1.CapturedContainerLogs{{Kind: "Pod", Name: "second"}}, "00002-fghij"))
+	assert.Equal(t, id, syncRes.CapturedLogsID)
+
+	var logs []*v1alpha1.CapturedContainerLogs
+	require.NoError(t, m.cache.GetAppSyncCapturedLogs(app.InstanceName(m.namespace), id, &logs))
+	require.Len(t, logs, 2)
+	assert.Equal(t, "first", logs[0].Name)
+	assert.Equal(t, "second", logs[1].Name)
+
+	// the logs already stored by a previous iteration are not duplicated
+	require.NoError(t, m.persistCapturedSyncLogs(app, syncRes, []*v1alpha1.CapturedContainerLogs{{Kind: "Job", Name: "first", Logs: "again"}, {Kind: "Pod", Name: "third"}}, "00003-klmno"))
+	require.NoError(t, m.cache.GetAppSyncCapturedLogs(app.InstanceName(m.namespace), id, &logs))
+	require.Len(t, logs, 3)
+	assert.Equal(t, "first", logs[0].Name)
+	assert.Empty(t, logs[0].Logs)
+	assert.Equal(t, "third", logs[2].Name)
+
+	// the capturer of the next iteration skips the resources whose logs are already stored
+	capturer := newSyncLogsCapturer(fake.NewSimpleClientset(), log.NewEntry(log.New()))
+	capturer.skipCaptured(m.getPersistedSyncLogs(app, syncRes))
+	assert.True(t, capturer.captured[kube.NewResourceKey("", "Job", "", "first")])
+	assert.True(t, capturer.captured[kube.NewResourceKey("", "Pod", "", "third")])
+}
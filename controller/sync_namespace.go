@@ -1,16 +1,30 @@
 package controller
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
 	gitopscommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/v2/common"
+	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 )
 
 // syncNamespace determine if Argo CD should create and/or manage the namespace
 // where the application will be deployed.
-func syncNamespace(resourceTracking argo.ResourceTracking, appLabelKey string, trackingMethod v1alpha1.TrackingMethod, appName string, syncPolicy *v1alpha1.SyncPolicy) func(m, l *unstructured.Unstructured) (bool, error) {
+func syncNamespace(resourceTracking argo.ResourceTracking, appLabelKey string, trackingMethod v1alpha1.TrackingMethod, appName string, syncPolicy *v1alpha1.SyncPolicy, templates *managedNamespaceTemplates) func(m, l *unstructured.Unstructured) (bool, error) {
 	// This function must return true for the managed namespace to be synced.
 	return func(managedNs, liveNs *unstructured.Unstructured) (bool, error) {
 		if managedNs == nil {
@@ -19,10 +33,15 @@ func syncNamespace(resourceTracking argo.ResourceTracking, appLabelKey string, t
 
 		isNewNamespace := liveNs == nil
 		isManagedNamespace := syncPolicy != nil && syncPolicy.ManagedNamespaceMetadata != nil
+		// the namespace is also synced when the resources of its managed namespace templates changed
+		hasChangedTemplates, err := templates.render(liveNs)
+		if err != nil {
+			return false, err
+		}
 
 		// should only sync the namespace if it doesn't exist in k8s or if
 		// syncPolicy is defined to manage the metadata
-		if !isManagedNamespace && !isNewNamespace {
+		if !isManagedNamespace && !isNewNamespace && !hasChangedTemplates {
 			return false, nil
 		}
 
@@ -33,6 +52,7 @@ func syncNamespace(resourceTracking argo.ResourceTracking, appLabelKey string, t
 			// existing labels and annotations in namespaces
 			managedNs.SetAnnotations(appendSSAAnnotation(managedNamespaceMetadata.Annotations))
 		}
+		templates.track(managedNs)
 
 		// TODO: https://github.com/argoproj/argo-cd/issues/11196
 		// err := resourceTracking.SetAppInstance(managedNs, appLabelKey, appName, "", trackingMethod)
@@ -44,6 +64,183 @@ func syncNamespace(resourceTracking argo.ResourceTracking, appLabelKey string, t
 	}
 }
 
+// managedNamespaceTemplateRef references a resource created from a managed namespace template
+type managedNamespaceTemplateRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// managedNamespaceTemplates holds the managed namespace templates of the project of an application creating its
+// destination namespace. The rendered resources are managed by the application without being tracked by it, since the
+// applications of the project deployed to the same namespace share them: the namespace records their hash and
+// references instead, so that the resources of the removed templates are deleted by the next sync.
+type managedNamespaceTemplates struct {
+	app       *v1alpha1.Application
+	templates []v1alpha1.ManagedNamespaceTemplate
+	// resources are the rendered resources, and hash their hash
+	resources []*unstructured.Unstructured
+	hash      string
+	// changed is true if the rendered resources differ from the ones last applied to the namespace
+	changed bool
+	// pruned are the resources of the templates removed since the namespace was last synced
+	pruned  []managedNamespaceTemplateRef
+	deleted bool
+}
+
+func newManagedNamespaceTemplates(app *v1alpha1.Application, proj *v1alpha1.AppProject) *managedNamespaceTemplates {
+	return &managedNamespaceTemplates{app: app, templates: proj.Spec.ManagedNamespaceTemplates}
+}
+
+// render renders the templates for the namespace and returns true if the rendered resources differ from the ones last
+// applied to the live namespace
+func (t *managedNamespaceTemplates) render(liveNs *unstructured.Unstructured) (bool, error) {
+	if t == nil {
+		return false, nil
+	}
+	resources, hash, err := renderManagedNamespaceTemplates(t.app, t.templates)
+	if err != nil {
+		return false, err
+	}
+	t.resources, t.hash, t.pruned = resources, hash, nil
+	var liveRefs []managedNamespaceTemplateRef
+	if liveNs != nil {
+		if value, ok := liveNs.GetAnnotations()[common.AnnotationKeyManagedNamespaceTemplates]; ok {
+			if err := json.Unmarshal([]byte(value), &liveRefs); err != nil {
+				return false, fmt.Errorf("failed to parse the %s annotation of the namespace: %w", common.AnnotationKeyManagedNamespaceTemplates, err)
+			}
+		}
+		t.changed = liveNs.GetLabels()[common.LabelKeyManagedNamespaceTemplatesHash] != hash
+	} else {
+		t.changed = hash != ""
+	}
+	current := make(map[managedNamespaceTemplateRef]bool)
+	for _, ref := range t.refs() {
+		current[ref] = true
+	}
+	for _, ref := range liveRefs {
+		if !current[ref] {
+			t.pruned = append(t.pruned, ref)
+		}
+	}
+	return t.changed, nil
+}
+
+// refs returns the references of the rendered resources
+func (t *managedNamespaceTemplates) refs() []managedNamespaceTemplateRef {
+	refs := make([]managedNamespaceTemplateRef, 0, len(t.resources))
+	for _, obj := range t.resources {
+		refs = append(refs, managedNamespaceTemplateRef{APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind(), Name: obj.GetName()})
+	}
+	return refs
+}
+
+// track records the hash and the references of the rendered resources on the namespace to sync
+func (t *managedNamespaceTemplates) track(managedNs *unstructured.Unstructured) {
+	if t == nil || (t.hash == "" && !t.changed) {
+		return
+	}
+	labels := make(map[string]string)
+	for k, v := range managedNs.GetLabels() {
+		labels[k] = v
+	}
+	annotations := managedNs.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if t.hash != "" {
+		labels[common.LabelKeyManagedNamespaceTemplatesHash] = t.hash
+		refs, _ := json.Marshal(t.refs())
+		annotations[common.AnnotationKeyManagedNamespaceTemplates] = string(refs)
+	}
+	managedNs.SetLabels(labels)
+	// the namespace is applied server-side, so that the labels and annotations of the removed templates are removed
+	// without overriding the ones set by others
+	managedNs.SetAnnotations(appendSSAAnnotation(annotations))
+}
+
+// withManagedNamespaceTemplates wraps the given sync wave hook so that the resources of the removed managed namespace
+// templates are deleted once the namespace has been synced. The resources of the templates themselves are part of the
+// target state of the application and are applied along with its other resources.
+func (m *appStateManager) withManagedNamespaceTemplates(hook gitopscommon.SyncWaveHook, templates *managedNamespaceTemplates, restConfig *rest.Config, dryRun bool, logCtx *log.Entry) gitopscommon.SyncWaveHook {
+	if templates == nil || dryRun {
+		return hook
+	}
+	return func(phase gitopscommon.SyncPhase, wave int, finalWave bool) error {
+		if err := m.deletePrunedManagedNamespaceTemplates(templates, restConfig, logCtx); err != nil {
+			return err
+		}
+		return hook(phase, wave, finalWave)
+	}
+}
+
+// deletePrunedManagedNamespaceTemplates deletes the resources of the managed namespace templates removed since the
+// namespace was last synced
+func (m *appStateManager) deletePrunedManagedNamespaceTemplates(templates *managedNamespaceTemplates, restConfig *rest.Config, logCtx *log.Entry) error {
+	if len(templates.pruned) == 0 || templates.deleted {
+		return nil
+	}
+	namespace := templates.app.Spec.Destination.Namespace
+	for _, ref := range templates.pruned {
+		err := m.kubectl.DeleteResource(context.Background(), restConfig, schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), ref.Name, namespace, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return fmt.Errorf("failed to delete the managed namespace template resource %s/%s: %w", ref.Kind, ref.Name, err)
+		}
+	}
+	logCtx.Infof("Deleted %d resources of removed managed namespace templates", len(templates.pruned))
+	templates.deleted = true
+	return nil
+}
+
+// hasChangedManagedNamespaceTemplates returns true if the destination namespace of the application exists and the
+// hash of the resources last applied from the managed namespace templates differs from the given one. The namespace
+// is read from the cluster cache.
+func (m *appStateManager) hasChangedManagedNamespaceTemplates(app *v1alpha1.Application, hash string) bool {
+	clusterCache, err := m.liveStateCache.GetClusterCache(app.Spec.Destination.Server)
+	if err != nil {
+		return false
+	}
+	key := kube.NewResourceKey("", kube.NamespaceKind, "", app.Spec.Destination.Namespace)
+	res, ok := clusterCache.FindResources("", func(r *clustercache.Resource) bool {
+		return r.ResourceKey() == key
+	})[key]
+	if !ok {
+		return false
+	}
+	info, ok := res.Info.(*statecache.ResourceInfo)
+	if !ok {
+		return false
+	}
+	return info.ManagedNamespaceTemplatesHash != hash
+}
+
+// renderManagedNamespaceTemplates renders the managed namespace templates for the destination namespace of the
+// application, and returns the rendered resources along with their hash, which is empty if there are no templates
+func renderManagedNamespaceTemplates(app *v1alpha1.Application, templates []v1alpha1.ManagedNamespaceTemplate) ([]*unstructured.Unstructured, string, error) {
+	if len(templates) == 0 {
+		return nil, "", nil
+	}
+	var resources []*unstructured.Unstructured
+	for _, template := range templates {
+		obj, err := template.Render(app)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to render the managed namespace template %s: %w", template.Name, err)
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[common.LabelKeyManagedNamespaceTemplate] = "true"
+		obj.SetLabels(labels)
+		resources = append(resources, obj)
+	}
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to hash the managed namespace templates: %w", err)
+	}
+	return resources, fmt.Sprintf("%x", sha256.Sum224(data)), nil
+}
+
 // appendSSAAnnotation will set the managed namespace to be synced
 // with server-side apply
 func appendSSAAnnotation(in map[string]string) map[string]string {
@@ -3,10 +3,14 @@ package controller
 import (
 	"testing"
 
+	gitopscommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -250,7 +254,7 @@ func Test_shouldNamespaceSync(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, err := syncNamespace(argo.NewResourceTracking(), common.LabelKeyAppInstance, argo.TrackingMethodAnnotation, "some-app", tt.syncPolicy)(tt.managedNs, tt.liveNs)
+			actual, err := syncNamespace(argo.NewResourceTracking(), common.LabelKeyAppInstance, argo.TrackingMethodAnnotation, "some-app", tt.syncPolicy, nil)(tt.managedNs, tt.liveNs)
 			require.NoError(t, err)
 
 			if tt.managedNs != nil {
@@ -262,3 +266,132 @@ func Test_shouldNamespaceSync(t *testing.T) {
 		})
 	}
 }
+
+func newManagedNamespaceTemplatesApp() (*v1alpha1.Application, *v1alpha1.AppProject) {
+	quota := v1alpha1.ManagedNamespaceTemplate{Name: "quota", Manifest: `apiVersion: v1
+kind: ResourceQuota
+metadata:
+  name: quota
+  labels:
+    team: $ARGOCD_APP_PROJECT_NAME
+spec:
+  hard:
+    pods: "10"
+`}
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{ManagedNamespaceTemplates: []v1alpha1.ManagedNamespaceTemplate{quota}}}
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+		Project:     "tenant",
+		Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "tenant-ns"},
+		SyncPolicy:  &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"}},
+	}}
+	app.Name = "some-app"
+	app.Namespace = "argocd"
+	return app, proj
+}
+
+func Test_renderManagedNamespaceTemplates(t *testing.T) {
+	app, proj := newManagedNamespaceTemplatesApp()
+
+	resources, hash, err := renderManagedNamespaceTemplates(app, proj.Spec.ManagedNamespaceTemplates)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, map[string]string{"team": "tenant", common.LabelKeyManagedNamespaceTemplate: "true"}, resources[0].GetLabels())
+	assert.NotEmpty(t, hash)
+
+	t.Run("same hash", func(t *testing.T) {
+		_, other, err := renderManagedNamespaceTemplates(app, proj.Spec.ManagedNamespaceTemplates)
+		require.NoError(t, err)
+		assert.Equal(t, hash, other)
+	})
+	t.Run("no templates", func(t *testing.T) {
+		resources, hash, err := renderManagedNamespaceTemplates(app, nil)
+		require.NoError(t, err)
+		assert.Empty(t, resources)
+		assert.Empty(t, hash)
+	})
+	t.Run("invalid template", func(t *testing.T) {
+		_, _, err := renderManagedNamespaceTemplates(app, []v1alpha1.ManagedNamespaceTemplate{{Name: "broken", Manifest: "kind: ResourceQuota"}})
+		require.ErrorContains(t, err, "failed to render the managed namespace template broken")
+	})
+}
+
+func Test_syncNamespaceManagedNamespaceTemplates(t *testing.T) {
+	app, proj := newManagedNamespaceTemplatesApp()
+	_, hash, err := renderManagedNamespaceTemplates(app, proj.Spec.ManagedNamespaceTemplates)
+	require.NoError(t, err)
+	sync := func(templates *managedNamespaceTemplates, liveNs *unstructured.Unstructured) (*unstructured.Unstructured, bool) {
+		managedNs := createFakeNamespace("", "", nil, nil)
+		synced, err := syncNamespace(argo.NewResourceTracking(), common.LabelKeyAppInstance, argo.TrackingMethodAnnotation, app.Name, app.Spec.SyncPolicy, templates)(managedNs, liveNs)
+		require.NoError(t, err)
+		return managedNs, synced
+	}
+
+	t.Run("new namespace", func(t *testing.T) {
+		templates := newManagedNamespaceTemplates(app, proj)
+		managedNs, synced := sync(templates, nil)
+		assert.True(t, synced)
+		assert.True(t, templates.changed)
+		assert.Equal(t, map[string]string{common.LabelKeyManagedNamespaceTemplatesHash: hash}, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{
+			common.AnnotationKeyManagedNamespaceTemplates: `[{"apiVersion":"v1","kind":"ResourceQuota","name":"quota"}]`,
+			"argocd.argoproj.io/sync-options":             "ServerSideApply=true",
+		}, managedNs.GetAnnotations())
+	})
+	t.Run("unchanged templates", func(t *testing.T) {
+		templates := newManagedNamespaceTemplates(app, proj)
+		liveNs := createFakeNamespace("something", "1", map[string]string{common.LabelKeyManagedNamespaceTemplatesHash: hash}, nil)
+		_, synced := sync(templates, liveNs)
+		assert.False(t, synced)
+		assert.False(t, templates.changed)
+	})
+	t.Run("changed templates", func(t *testing.T) {
+		templates := newManagedNamespaceTemplates(app, proj)
+		liveNs := createFakeNamespace("something", "1", map[string]string{common.LabelKeyManagedNamespaceTemplatesHash: "stale"}, map[string]string{
+			common.AnnotationKeyManagedNamespaceTemplates: `[{"apiVersion":"v1","kind":"ResourceQuota","name":"quota"},{"apiVersion":"v1","kind":"LimitRange","name":"limits"}]`,
+		})
+		managedNs, synced := sync(templates, liveNs)
+		assert.True(t, synced)
+		assert.True(t, templates.changed)
+		assert.Equal(t, []managedNamespaceTemplateRef{{APIVersion: "v1", Kind: "LimitRange", Name: "limits"}}, templates.pruned)
+		assert.Equal(t, hash, managedNs.GetLabels()[common.LabelKeyManagedNamespaceTemplatesHash])
+	})
+	t.Run("removed templates", func(t *testing.T) {
+		templates := newManagedNamespaceTemplates(app, &v1alpha1.AppProject{})
+		liveNs := createFakeNamespace("something", "1", map[string]string{common.LabelKeyManagedNamespaceTemplatesHash: hash}, map[string]string{
+			common.AnnotationKeyManagedNamespaceTemplates: `[{"apiVersion":"v1","kind":"ResourceQuota","name":"quota"}]`,
+		})
+		managedNs, synced := sync(templates, liveNs)
+		assert.True(t, synced)
+		assert.Equal(t, []managedNamespaceTemplateRef{{APIVersion: "v1", Kind: "ResourceQuota", Name: "quota"}}, templates.pruned)
+		assert.Empty(t, managedNs.GetLabels())
+		assert.Equal(t, map[string]string{"argocd.argoproj.io/sync-options": "ServerSideApply=true"}, managedNs.GetAnnotations())
+	})
+	t.Run("invalid annotation", func(t *testing.T) {
+		liveNs := createFakeNamespace("something", "1", nil, map[string]string{common.AnnotationKeyManagedNamespaceTemplates: "invalid"})
+		_, err := syncNamespace(argo.NewResourceTracking(), common.LabelKeyAppInstance, argo.TrackingMethodAnnotation, app.Name, app.Spec.SyncPolicy, newManagedNamespaceTemplates(app, proj))(createFakeNamespace("", "", nil, nil), liveNs)
+		require.ErrorContains(t, err, "failed to parse the "+common.AnnotationKeyManagedNamespaceTemplates)
+	})
+}
+
+func Test_deletePrunedManagedNamespaceTemplates(t *testing.T) {
+	app, proj := newManagedNamespaceTemplatesApp()
+	ctrl := newFakeController(&fakeData{}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	templates := newManagedNamespaceTemplates(app, proj)
+	_, err := templates.render(createFakeNamespace("something", "1", map[string]string{common.LabelKeyManagedNamespaceTemplatesHash: "stale"}, map[string]string{
+		common.AnnotationKeyManagedNamespaceTemplates: `[{"apiVersion":"v1","kind":"LimitRange","name":"limits"}]`,
+	}))
+	require.NoError(t, err)
+
+	var waves []int
+	hook := manager.withManagedNamespaceTemplates(func(phase gitopscommon.SyncPhase, wave int, finalWave bool) error {
+		waves = append(waves, wave)
+		return nil
+	}, templates, &rest.Config{}, false, log.NewEntry(log.StandardLogger()))
+	require.NoError(t, hook(gitopscommon.SyncPhasePreSync, 0, false))
+	require.NoError(t, hook(gitopscommon.SyncPhaseSync, 1, true))
+
+	assert.Equal(t, []int{0, 1}, waves)
+	assert.True(t, templates.deleted)
+	assert.Equal(t, []kube.ResourceKey{kube.NewResourceKey("", "LimitRange", "tenant-ns", "limits")}, ctrl.kubectl.(*MockKubectl).DeletedResources, "the pruned resources are only deleted once")
+}
@@ -2,16 +2,20 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/controller/testdata"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -660,3 +664,313 @@ func dig[T any](obj interface{}, path []interface{}) T {
 
 	return i.(T)
 }
+
+func TestGetPruneLimitExceededMessage(t *testing.T) {
+	newObj := func(name string, annotations map[string]string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		obj.SetNamespace(test.FakeDestNamespace)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	// four managed resources, three of which are no longer in the target state
+	result := sync.ReconciliationResult{
+		Live: []*unstructured.Unstructured{
+			newObj("kept", nil),
+			newObj("pruned-1", nil),
+			newObj("pruned-2", nil),
+			newObj("prune-disabled", map[string]string{common.AnnotationSyncOptions: common.SyncOptionDisablePrune}),
+		},
+		Target: []*unstructured.Unstructured{newObj("kept", nil), nil, nil, nil},
+	}
+	all := func(kube.ResourceKey, *unstructured.Unstructured, *unstructured.Unstructured) bool {
+		return true
+	}
+
+	t.Run("no prune limit configured", func(t *testing.T) {
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"Validate=false"}, result, all)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+	t.Run("within absolute limit", func(t *testing.T) {
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=2"}, result, all)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+	t.Run("exceeds absolute limit", func(t *testing.T) {
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=1"}, result, all)
+		require.NoError(t, err)
+		assert.Equal(t, "Sync would prune 2 of 4 managed resources, which exceeds the prune limit of 1: "+
+			"Deployment/"+test.FakeDestNamespace+"/pruned-1, Deployment/"+test.FakeDestNamespace+"/pruned-2. Sync with ignorePruneLimit to prune them anyway", message)
+	})
+	t.Run("within percentage limit", func(t *testing.T) {
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=50%"}, result, all)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+	t.Run("exceeds percentage limit", func(t *testing.T) {
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=25%"}, result, all)
+		require.NoError(t, err)
+		assert.Contains(t, message, "Sync would prune 2 of 4 managed resources, which exceeds the prune limit of 25%")
+	})
+	t.Run("resources excluded from the sync are not counted", func(t *testing.T) {
+		onlyFirst := func(key kube.ResourceKey, _ *unstructured.Unstructured, _ *unstructured.Unstructured) bool {
+			return key.Name != "pruned-2"
+		}
+		message, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=1"}, result, onlyFirst)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=lots"}, result, all)
+		require.Error(t, err)
+		_, err = getPruneLimitExceededMessage(v1alpha1.SyncOptions{"PruneLimit=150%"}, result, all)
+		require.Error(t, err)
+	})
+}
+
+func TestSyncAppState_PruneLimit(t *testing.T) {
+	newConfigMap := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": test.FakeDestNamespace,
+				"uid":       name,
+				"labels":    map[string]interface{}{"app.kubernetes.io/instance": "my-app"},
+			},
+		}}
+	}
+	newController := func() (*ApplicationController, *v1alpha1.Application) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{Namespace: test.FakeArgoCDNamespace, Name: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"*"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			},
+		}
+		kept := newConfigMap("kept")
+		keptJSON, err := json.Marshal(kept)
+		require.NoError(t, err)
+		managedLiveObjs := map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(kept): kept}
+		for _, name := range []string{"removed-1", "removed-2"} {
+			obj := newConfigMap(name)
+			managedLiveObjs[kube.GetResourceKey(obj)] = obj
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []*apiclient.Manifest{{CompiledManifest: string(keptJSON)}},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: managedLiveObjs,
+		}
+		return newFakeController(&data, nil), app
+	}
+
+	t.Run("fails the sync pruning more resources than the limit", func(t *testing.T) {
+		ctrl, app := newController()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Prune: true, SyncOptions: []string{"PruneLimit=1"}}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Sync would prune 2 of 3 managed resources, which exceeds the prune limit of 1")
+		if opState.SyncResult != nil {
+			assert.Empty(t, opState.SyncResult.Resources, "no resource is applied nor pruned")
+		}
+	})
+
+	t.Run("proceeds pruning no more resources than the limit", func(t *testing.T) {
+		ctrl, app := newController()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Prune: true, SyncOptions: []string{"PruneLimit=70%"}}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.NotContains(t, opState.Message, "exceeds the prune limit")
+		require.NotNil(t, opState.SyncResult)
+		assert.NotEmpty(t, opState.SyncResult.Resources, "the sync proceeds to apply and prune the resources")
+	})
+
+	t.Run("proceeds ignoring the limit", func(t *testing.T) {
+		ctrl, app := newController()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Prune: true, IgnorePruneLimit: true, SyncOptions: []string{"PruneLimit=1"}}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.NotContains(t, opState.Message, "exceeds the prune limit")
+		require.NotNil(t, opState.SyncResult)
+		assert.NotEmpty(t, opState.SyncResult.Resources, "the sync proceeds to apply and prune the resources")
+	})
+}
+
+func TestSyncWithImpersonation(t *testing.T) {
+	newController := func(destinationServiceAccounts []v1alpha1.ApplicationDestinationServiceAccount) (*ApplicationController, *v1alpha1.Application) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:                []string{"*"},
+				Destinations:               []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				DestinationServiceAccounts: destinationServiceAccounts,
+			},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []*apiclient.Manifest{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			configMapData: map[string]string{
+				"application.sync.impersonation.enabled": "true",
+			},
+		}
+		return newFakeController(&data, nil), app
+	}
+
+	t.Run("fails without a matching service account", func(t *testing.T) {
+		ctrl, app := newController([]v1alpha1.ApplicationDestinationServiceAccount{{Server: "https://other-cluster", Namespace: "*", DefaultServiceAccount: "deployer"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.Equal(t, common.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "failed to find a matching service account to impersonate: no matching service account found for destination server "+app.Spec.Destination.Server+" and namespace "+app.Spec.Destination.Namespace)
+	})
+
+	t.Run("syncs with a matching service account", func(t *testing.T) {
+		ctrl, app := newController([]v1alpha1.ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"}})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.Equal(t, common.OperationSucceeded, opState.Phase)
+	})
+}
+
+func TestGetServerDryRunRejections(t *testing.T) {
+	newObj := func(name string, namespace string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+		return obj
+	}
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind(kube.NamespaceKind)
+	namespace.SetName("new-namespace")
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec":       map[string]interface{}{"group": "example.com", "names": map[string]interface{}{"kind": "Widget"}},
+	}}
+	widget := &unstructured.Unstructured{}
+	widget.SetAPIVersion("example.com/v1")
+	widget.SetKind("Widget")
+	widget.SetName("my-widget")
+	widget.SetNamespace(test.FakeDestNamespace)
+	hook := newObj("hook", test.FakeDestNamespace)
+	hook.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "PreSync"})
+
+	result := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{
+			newObj("valid", test.FakeDestNamespace),
+			newObj("rejected", test.FakeDestNamespace),
+			newObj("in-new-namespace", "new-namespace"),
+			namespace,
+			crd,
+			widget,
+			hook,
+			nil,
+		},
+		Live: make([]*unstructured.Unstructured, 8),
+	}
+	result.Live[7] = newObj("pruned", test.FakeDestNamespace)
+	resourceOps := &kubetest.MockResourceOps{Commands: map[string]kubetest.KubectlOutput{
+		"rejected":         {Err: errors.New(`admission webhook "validate.kyverno.svc" denied the request: image tag latest is not allowed`)},
+		"in-new-namespace": {Err: errors.New(`namespaces "new-namespace" not found`)},
+		"my-widget":        {Err: errors.New(`no matches for kind "Widget" in version "example.com/v1"`)},
+		"hook":             {Err: errors.New("must not be validated")},
+	}}
+	all := func(kube.ResourceKey, *unstructured.Unstructured, *unstructured.Unstructured) bool {
+		return true
+	}
+
+	rejected, validated := getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true})
+	assert.Equal(t, 4, validated)
+	require.Len(t, rejected, 1)
+	assert.Equal(t, "rejected", rejected[0].Name)
+	assert.Equal(t, "Deployment", rejected[0].Kind)
+	assert.Equal(t, common.ResultCodeSyncFailed, rejected[0].Status)
+	assert.Equal(t, `admission webhook "validate.kyverno.svc" denied the request: image tag latest is not allowed`, rejected[0].Message)
+	assert.Empty(t, resourceOps.GetLastResourceCommand(kube.GetResourceKey(widget)), "the resources of a created CRD are not validated")
+	assert.Empty(t, resourceOps.GetLastResourceCommand(kube.GetResourceKey(hook)), "hooks are not validated")
+
+	t.Run("namespace created by the sync option", func(t *testing.T) {
+		result := sync.ReconciliationResult{
+			Target: []*unstructured.Unstructured{newObj("in-new-namespace", "new-namespace")},
+			Live:   []*unstructured.Unstructured{nil},
+		}
+		rejected, _ := getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true, createdNamespace: "new-namespace"})
+		assert.Empty(t, rejected)
+		rejected, _ = getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true})
+		assert.Len(t, rejected, 1)
+	})
+	t.Run("resources excluded from the sync are not validated", func(t *testing.T) {
+		notRejected := func(key kube.ResourceKey, _ *unstructured.Unstructured, _ *unstructured.Unstructured) bool {
+			return key.Name != "rejected"
+		}
+		rejected, _ := getServerDryRunRejections(context.Background(), resourceOps, result, notRejected, serverDryRunOptions{validate: true})
+		assert.Empty(t, rejected)
+	})
+	t.Run("resources of a missing type", func(t *testing.T) {
+		gadget := widget.DeepCopy()
+		gadget.SetKind("Gadget")
+		gadget.SetName("my-gadget")
+		gadget.SetAnnotations(map[string]string{common.AnnotationSyncOptions: common.SyncOptionSkipDryRunOnMissingResource})
+		result := sync.ReconciliationResult{
+			Target: []*unstructured.Unstructured{crd, widget, gadget},
+			Live:   []*unstructured.Unstructured{crd, nil, nil},
+		}
+		resourceOps := &kubetest.MockResourceOps{Commands: map[string]kubetest.KubectlOutput{
+			"my-widget": {Err: errors.New(`no matches for kind "Widget" in version "example.com/v1"`)},
+			"my-gadget": {Err: errors.New(`no matches for kind "Gadget" in version "example.com/v1"`)},
+		}}
+		rejected, validated := getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true, servedGVKs: map[schema.GroupVersionKind]bool{}})
+		assert.Empty(t, rejected)
+		assert.Equal(t, 1, validated, "only the custom resource definition is validated")
+
+		gadget.SetAnnotations(nil)
+		rejected, _ = getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true, servedGVKs: map[schema.GroupVersionKind]bool{}})
+		require.Len(t, rejected, 1)
+		assert.Equal(t, "my-gadget", rejected[0].Name)
+	})
+	t.Run("sync options annotations", func(t *testing.T) {
+		withSyncOptions := func(name string, options string) *unstructured.Unstructured {
+			obj := newObj(name, test.FakeDestNamespace)
+			obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: options})
+			return obj
+		}
+		replaced := withSyncOptions("replaced", common.SyncOptionReplace)
+		created := withSyncOptions("created", common.SyncOptionReplace)
+		serverSideApplied := withSyncOptions("server-side-applied", common.SyncOptionServerSideApply+","+common.SyncOptionsDisableValidation)
+		result := sync.ReconciliationResult{
+			Target: []*unstructured.Unstructured{replaced, created, serverSideApplied},
+			Live:   []*unstructured.Unstructured{replaced, nil, nil},
+		}
+		resourceOps := &kubetest.MockResourceOps{}
+		rejected, validated := getServerDryRunRejections(context.Background(), resourceOps, result, all, serverDryRunOptions{validate: true})
+		assert.Empty(t, rejected)
+		assert.Equal(t, 3, validated)
+		assert.Equal(t, "replace", resourceOps.GetLastResourceCommand(kube.GetResourceKey(replaced)))
+		assert.Equal(t, "create", resourceOps.GetLastResourceCommand(kube.GetResourceKey(created)))
+		assert.Equal(t, "apply", resourceOps.GetLastResourceCommand(kube.GetResourceKey(serverSideApplied)))
+		assert.True(t, resourceOps.GetLastServerSideApply())
+		assert.False(t, resourceOps.GetLastValidate())
+	})
+}
@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// defaultSyncWaveTimeout is the time the resources of a sync wave are waited for by default before failing the sync
+	defaultSyncWaveTimeout = 5 * time.Minute
+	// defaultSyncWaveDelay is the fixed delay between sync waves which are not waited for until they are ready
+	defaultSyncWaveDelay = 2 * time.Second
+)
+
+// syncWaveReadinessPollInterval is the interval at which the readiness of the resources of a sync wave is checked
+var syncWaveReadinessPollInterval = 5 * time.Second
+
+// newSyncWaveHook returns a gitops-engine SyncWaveHook which records each wave once it has been applied, so that the
+// next iterations of the operation wait for it before moving on to the next wave. Without this, Argo CD would likely
+// assess the health of the wave against stale objects and start the next wave or fire hooks prematurely, see
+// https://github.com/argoproj/argo-cd/issues/4669. By default, the resources of a wave are waited for until they are
+// ready or the timeout of the wave expires. A fixed delay is only waited for between the waves which are not waited
+// for, e.g. hook waves, or all waves if waiting for readiness is disabled with a zero timeout. The final wave is only
+// waited for if it has the sync-wave-timeout annotation, as the sync otherwise succeeds as soon as it is applied. The
+// wave is recorded in the sync result of the operation, so that it is still waited for after a restart of the
+// controller. The hook does not block: the operation is requeued while it waits.
+func (m *appStateManager) newSyncWaveHook(app *v1alpha1.Application, state *v1alpha1.OperationState, reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool, dryRun bool) common.SyncWaveHook {
+	return func(phase common.SyncPhase, wave int, finalWave bool) error {
+		if dryRun {
+			return nil
+		}
+		_, waitReady, err := getSyncWaveReadinessTimeout(phase, getSyncWaveTargets(reconciliationResult, filter, wave), finalWave)
+		if err != nil {
+			return err
+		}
+		if !waitReady && (finalWave || getSyncWaveDelay() <= 0) {
+			return nil
+		}
+		now := metav1.Now()
+		state.SyncResult.SyncWave = int64(wave)
+		state.SyncResult.SyncWavePhase = phase
+		state.SyncResult.SyncWaveAppliedAt = &now
+		return nil
+	}
+}
+
+// getSyncWaveWait returns a message along with the time after which the operation should be resumed if the last wave
+// applied by the operation is still waited for, or an error if the wave was not ready within its timeout
+func (m *appStateManager) getSyncWaveWait(app *v1alpha1.Application, state *v1alpha1.OperationState, reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool, healthOverride health.HealthOverride) (string, time.Duration, error) {
+	syncRes := state.SyncResult
+	if syncRes == nil || syncRes.SyncWaveAppliedAt == nil {
+		return "", 0, nil
+	}
+	wave := int(syncRes.SyncWave)
+	elapsed := time.Since(syncRes.SyncWaveAppliedAt.Time)
+	targets := getSyncWaveTargets(reconciliationResult, filter, wave)
+	timeout, waitReady, err := getSyncWaveReadinessTimeout(syncRes.SyncWavePhase, targets, false)
+	if err != nil {
+		return "", 0, err
+	}
+	if !waitReady {
+		delay := getSyncWaveDelay()
+		if remaining := delay - elapsed; remaining > 0 {
+			return fmt.Sprintf("waiting %v before applying the next sync wave", delay), remaining, nil
+		}
+		resetSyncWave(syncRes)
+		return "", 0, nil
+	}
+
+	liveObjs, err := m.liveStateCache.GetManagedLiveObjs(app, targets)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get the live state of sync wave %d: %w", wave, err)
+	}
+	notReady := getSyncWaveNotReadyResources(targets, liveObjs, healthOverride)
+	if len(notReady) == 0 {
+		resetSyncWave(syncRes)
+		return "", 0, nil
+	}
+	remaining := timeout - elapsed
+	if remaining <= 0 {
+		resetSyncWave(syncRes)
+		return "", 0, fmt.Errorf("sync wave %d was not ready within %v: %s", wave, timeout, strings.Join(notReady, ", "))
+	}
+	return fmt.Sprintf("waiting for sync wave %d to become ready: %s", wave, strings.Join(notReady, ", ")), min(remaining, syncWaveReadinessPollInterval), nil
+}
+
+// isWaitingForFinalSyncWave returns whether the final wave applied by the operation is waited for. Only the waves which
+// are waited for are recorded, and the final wave only if it is waited for until it is ready.
+func isWaitingForFinalSyncWave(state *v1alpha1.OperationState) (int, bool) {
+	if state.SyncResult == nil || state.SyncResult.SyncWaveAppliedAt == nil {
+		return 0, false
+	}
+	return int(state.SyncResult.SyncWave), true
+}
+
+// resetSyncWave clears the wave recorded in the sync result once it is no longer waited for
+func resetSyncWave(syncRes *v1alpha1.SyncOperationResult) {
+	syncRes.SyncWave = 0
+	syncRes.SyncWavePhase = ""
+	syncRes.SyncWaveAppliedAt = nil
+}
+
+// getSyncWaveReadinessTimeout returns the time the resources of a wave are waited for until they are ready, along with
+// whether they are waited for. The resources of the waves of the sync phase are waited for, unless the timeout is
+// disabled or the wave is the final one and has no sync-wave-timeout annotation.
+func getSyncWaveReadinessTimeout(phase common.SyncPhase, targets []*unstructured.Unstructured, finalWave bool) (time.Duration, bool, error) {
+	if phase != common.SyncPhaseSync || len(targets) == 0 {
+		return 0, false, nil
+	}
+	timeout, annotated, err := getSyncWaveTimeout(targets)
+	if err != nil {
+		return 0, false, err
+	}
+	if !annotated {
+		if finalWave {
+			return 0, false, nil
+		}
+		timeout = getDefaultSyncWaveTimeout()
+	}
+	return timeout, timeout > 0, nil
+}
+
+// getSyncWaveDelay returns the fixed delay between sync waves configured with the ARGOCD_SYNC_WAVE_DELAY environment
+// variable, in seconds
+func getSyncWaveDelay() time.Duration {
+	if value := os.Getenv(EnvVarSyncWaveDelay); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return defaultSyncWaveDelay
+}
+
+// getSyncWaveTargets returns the target objects of the given wave of the sync phase, leaving out hooks whose
+// completion is already tracked by the sync itself
+func getSyncWaveTargets(reconciliationResult sync.ReconciliationResult, filter func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool, wave int) []*unstructured.Unstructured {
+	var targets []*unstructured.Unstructured
+	for i, target := range reconciliationResult.Target {
+		if target == nil || hook.IsHook(target) || syncwaves.Wave(target) != wave {
+			continue
+		}
+		if filter(kube.GetResourceKey(target), target, reconciliationResult.Live[i]) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// getSyncWaveTimeout returns the largest timeout annotated on the resources of a wave, along with whether an
+// annotation was found
+func getSyncWaveTimeout(targets []*unstructured.Unstructured) (time.Duration, bool, error) {
+	timeout := time.Duration(0)
+	annotated := false
+	for _, target := range targets {
+		value, ok := target.GetAnnotations()[cdcommon.AnnotationSyncWaveTimeout]
+		if !ok {
+			continue
+		}
+		duration, err := parseSyncWaveTimeout(value)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %s annotation on %s/%s: %w", cdcommon.AnnotationSyncWaveTimeout, target.GetKind(), target.GetName(), err)
+		}
+		annotated = true
+		if duration > timeout {
+			timeout = duration
+		}
+	}
+	return timeout, annotated, nil
+}
+
+// getDefaultSyncWaveTimeout returns the time the resources of each wave are waited for, which is configured with the
+// ARGOCD_SYNC_WAVE_TIMEOUT environment variable. A zero timeout disables waiting for the waves to become ready.
+func getDefaultSyncWaveTimeout() time.Duration {
+	value := os.Getenv(EnvVarSyncWaveTimeout)
+	if value == "" {
+		return defaultSyncWaveTimeout
+	}
+	timeout, err := parseSyncWaveTimeout(value)
+	if err != nil {
+		log.Warnf("Ignoring invalid %s environment variable: %v", EnvVarSyncWaveTimeout, err)
+		return defaultSyncWaveTimeout
+	}
+	return timeout
+}
+
+// parseSyncWaveTimeout parses a duration such as 5m, or a number of seconds if it has no unit
+func parseSyncWaveTimeout(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("%q is not a valid duration", value)
+	}
+	return duration, nil
+}
+
+// getSyncWaveNotReadyResources returns a description of each target whose live object is missing, has not been
+// observed by its controller yet or is still progressing
+func getSyncWaveNotReadyResources(targets []*unstructured.Unstructured, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, healthOverride health.HealthOverride) []string {
+	var notReady []string
+	for _, target := range targets {
+		name := fmt.Sprintf("%s/%s", target.GetKind(), target.GetName())
+		if target.GetNamespace() != "" {
+			name = fmt.Sprintf("%s/%s/%s", target.GetKind(), target.GetNamespace(), target.GetName())
+		}
+		live := liveObjs[kube.GetResourceKey(target)]
+		if live == nil {
+			notReady = append(notReady, fmt.Sprintf("%s (missing)", name))
+			continue
+		}
+		observedGeneration, found, err := unstructured.NestedInt64(live.Object, "status", "observedGeneration")
+		if err == nil && found && observedGeneration < live.GetGeneration() {
+			notReady = append(notReady, fmt.Sprintf("%s (generation %d not observed yet)", name, live.GetGeneration()))
+			continue
+		}
+		healthStatus, err := health.GetResourceHealth(live, healthOverride)
+		if err != nil {
+			notReady = append(notReady, fmt.Sprintf("%s (%v)", name, err))
+			continue
+		}
+		// degraded resources fail the sync as soon as the sync context assesses their health, there is no point in waiting
+		if healthStatus != nil && healthStatus.Status != health.HealthStatusHealthy && healthStatus.Status != health.HealthStatusDegraded {
+			message := string(healthStatus.Status)
+			if healthStatus.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, healthStatus.Message)
+			}
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", name, message))
+		}
+	}
+	return notReady
+}
@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newSyncWaveDeployment(name string, wave string, generation int64, observedGeneration int64, available bool) *unstructured.Unstructured {
+	obj := test.NewDeployment()
+	obj.SetName(name)
+	obj.SetNamespace(test.FakeDestNamespace)
+	obj.SetGeneration(generation)
+	obj.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: wave})
+	replicas := int64(0)
+	if available {
+		replicas = 1
+	}
+	_ = unstructured.SetNestedField(obj.Object, int64(1), "spec", "replicas")
+	_ = unstructured.SetNestedMap(obj.Object, map[string]interface{}{
+		"observedGeneration": observedGeneration,
+		"replicas":           int64(1),
+		"updatedReplicas":    int64(1),
+		"availableReplicas":  replicas,
+	}, "status")
+	return obj
+}
+
+func TestGetSyncWaveTimeout(t *testing.T) {
+	first := test.NewConfigMap()
+	second := test.NewConfigMap()
+
+	timeout, annotated, err := getSyncWaveTimeout([]*unstructured.Unstructured{first, second})
+	require.NoError(t, err)
+	assert.False(t, annotated)
+	assert.Zero(t, timeout)
+
+	first.SetAnnotations(map[string]string{common.AnnotationSyncWaveTimeout: "30"})
+	second.SetAnnotations(map[string]string{common.AnnotationSyncWaveTimeout: "1m"})
+	timeout, annotated, err = getSyncWaveTimeout([]*unstructured.Unstructured{first, second})
+	require.NoError(t, err)
+	assert.True(t, annotated)
+	assert.Equal(t, time.Minute, timeout)
+
+	second.SetAnnotations(map[string]string{common.AnnotationSyncWaveTimeout: "soon"})
+	_, _, err = getSyncWaveTimeout([]*unstructured.Unstructured{first, second})
+	assert.EqualError(t, err, `invalid argocd.argoproj.io/sync-wave-timeout annotation on ConfigMap/my-configmap: "soon" is not a valid duration`)
+}
+
+func TestGetDefaultSyncWaveTimeout(t *testing.T) {
+	assert.Equal(t, defaultSyncWaveTimeout, getDefaultSyncWaveTimeout(), "the waves are waited for by default")
+
+	t.Setenv(EnvVarSyncWaveTimeout, "2m")
+	assert.Equal(t, 2*time.Minute, getDefaultSyncWaveTimeout())
+
+	t.Setenv(EnvVarSyncWaveTimeout, "0")
+	assert.Zero(t, getDefaultSyncWaveTimeout())
+
+	t.Setenv(EnvVarSyncWaveTimeout, "soon")
+	assert.Equal(t, defaultSyncWaveTimeout, getDefaultSyncWaveTimeout())
+}
+
+func TestGetSyncWaveDelay(t *testing.T) {
+	assert.Equal(t, defaultSyncWaveDelay, getSyncWaveDelay())
+
+	t.Setenv(EnvVarSyncWaveDelay, "5")
+	assert.Equal(t, 5*time.Second, getSyncWaveDelay())
+}
+
+func TestParseSyncWaveTimeout(t *testing.T) {
+	for value, expected := range map[string]time.Duration{"0": 0, "90": 90 * time.Second, "90s": 90 * time.Second, "1h30m": 90 * time.Minute} {
+		duration, err := parseSyncWaveTimeout(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, duration, value)
+	}
+	for _, value := range []string{"", "-1", "-1m", "1d"} {
+		_, err := parseSyncWaveTimeout(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGetSyncWaveNotReadyResources(t *testing.T) {
+	ready := newSyncWaveDeployment("ready", "1", 2, 2, true)
+	stale := newSyncWaveDeployment("stale", "1", 2, 1, true)
+	progressing := newSyncWaveDeployment("progressing", "1", 2, 2, false)
+	missing := newSyncWaveDeployment("missing", "1", 1, 1, true)
+	configMap := test.NewConfigMap()
+
+	targets := []*unstructured.Unstructured{ready, stale, progressing, missing, configMap}
+	liveObjs := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, obj := range []*unstructured.Unstructured{ready, stale, progressing, configMap} {
+		liveObjs[kube.GetResourceKey(obj)] = obj
+	}
+
+	notReady := getSyncWaveNotReadyResources(targets, liveObjs, nil)
+	require.Len(t, notReady, 3)
+	assert.Equal(t, "Deployment/"+test.FakeDestNamespace+"/stale (generation 2 not observed yet)", notReady[0])
+	assert.Contains(t, notReady[1], "Deployment/"+test.FakeDestNamespace+"/progressing (Progressing")
+	assert.Equal(t, "Deployment/"+test.FakeDestNamespace+"/missing (missing)", notReady[2])
+}
+
+func TestSyncWaveHook(t *testing.T) {
+	app := newFakeApp()
+	first := newSyncWaveDeployment("first", "0", 1, 1, true)
+	second := newSyncWaveDeployment("second", "1", 1, 1, false)
+	second.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1", common.AnnotationSyncWaveTimeout: "1m"})
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{first, second},
+		Live:   []*unstructured.Unstructured{first, second},
+	}
+	filter := func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+		return true
+	}
+	newStateManager := func() *appStateManager {
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(first):  first,
+				kube.GetResourceKey(second): second,
+			},
+		}, nil)
+		return ctrl.appStateManager.(*appStateManager)
+	}
+	newState := func() *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{StartedAt: metav1.Now(), SyncResult: &v1alpha1.SyncOperationResult{}}
+	}
+
+	t.Run("waits for a wave to become ready by default", func(t *testing.T) {
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, false)
+
+		// the first wave is ready
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 0, false))
+		require.NotNil(t, state.SyncResult.SyncWaveAppliedAt)
+		_, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Zero(t, after)
+		assert.Nil(t, state.SyncResult.SyncWaveAppliedAt)
+
+		// the final wave is waited for as it has the timeout annotation
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 1, true))
+		wave, ok := isWaitingForFinalSyncWave(state)
+		assert.True(t, ok)
+		assert.Equal(t, 1, wave)
+		message, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Contains(t, message, "waiting for sync wave 1 to become ready: Deployment/"+test.FakeDestNamespace+"/second (Progressing")
+		assert.Equal(t, syncWaveReadinessPollInterval, after)
+	})
+
+	t.Run("keeps waiting for a wave after a restart", func(t *testing.T) {
+		state := newState()
+		require.NoError(t, newStateManager().newSyncWaveHook(app, state, reconciliationResult, filter, false)(synccommon.SyncPhaseSync, 1, true))
+
+		// the operation state is persisted in the application and read back by the restarted controller
+		data, err := json.Marshal(state)
+		require.NoError(t, err)
+		restored := &v1alpha1.OperationState{}
+		require.NoError(t, json.Unmarshal(data, restored))
+
+		message, after, err := newStateManager().getSyncWaveWait(app, restored, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Contains(t, message, "waiting for sync wave 1 to become ready")
+		assert.Positive(t, after)
+	})
+
+	t.Run("waits for a fixed delay when readiness is disabled", func(t *testing.T) {
+		t.Setenv(EnvVarSyncWaveTimeout, "0")
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, false)
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 0, false))
+
+		message, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "waiting 2s before applying the next sync wave", message)
+		assert.Positive(t, after)
+		assert.LessOrEqual(t, after, defaultSyncWaveDelay)
+	})
+
+	t.Run("waits for a fixed delay between hook waves", func(t *testing.T) {
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, false)
+		require.NoError(t, hook(synccommon.SyncPhasePreSync, 1, false))
+
+		message, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "waiting 2s before applying the next sync wave", message)
+		assert.Positive(t, after)
+	})
+
+	t.Run("does not wait without delay", func(t *testing.T) {
+		t.Setenv(EnvVarSyncWaveTimeout, "0")
+		t.Setenv(EnvVarSyncWaveDelay, "0")
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, false)
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 0, false))
+
+		_, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Zero(t, after)
+	})
+
+	t.Run("fails when a wave is not ready within its timeout", func(t *testing.T) {
+		m := newStateManager()
+		state := newState()
+		appliedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		state.SyncResult.SyncWave, state.SyncResult.SyncWavePhase, state.SyncResult.SyncWaveAppliedAt = 1, synccommon.SyncPhaseSync, &appliedAt
+
+		_, _, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sync wave 1 was not ready within 1m0s: Deployment/"+test.FakeDestNamespace+"/second (Progressing")
+		assert.Nil(t, state.SyncResult.SyncWaveAppliedAt)
+	})
+
+	t.Run("does not wait for the final wave without annotation", func(t *testing.T) {
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, false)
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 0, true))
+		require.NoError(t, hook(synccommon.SyncPhasePostSync, 1, true))
+		_, ok := isWaitingForFinalSyncWave(state)
+		assert.False(t, ok)
+		_, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Zero(t, after)
+	})
+
+	t.Run("does not wait for dry runs", func(t *testing.T) {
+		m := newStateManager()
+		state := newState()
+		hook := m.newSyncWaveHook(app, state, reconciliationResult, filter, true)
+		require.NoError(t, hook(synccommon.SyncPhaseSync, 0, false))
+		_, after, err := m.getSyncWaveWait(app, state, reconciliationResult, filter, nil)
+		require.NoError(t, err)
+		assert.Zero(t, after)
+	})
+}
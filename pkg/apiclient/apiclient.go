@@ -122,6 +122,7 @@ type ClientOptions struct {
 	GRPCWeb              bool
 	GRPCWebRootPath      string
 	Core                 bool
+	CoreLocal            bool
 	PortForward          bool
 	PortForwardNamespace string
 	Headers              []string
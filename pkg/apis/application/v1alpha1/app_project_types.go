@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
 )
 
 type ErrApplicationNotAllowedToUseProject struct {
@@ -194,6 +195,27 @@ func (p *AppProject) ValidateProject() error {
 		destKeys[key] = true
 	}
 
+	destServiceAccounts := make(map[string]bool)
+	for _, destServiceAccount := range p.Spec.DestinationServiceAccounts {
+		if strings.Contains(destServiceAccount.Server, "!") {
+			return status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAccount.Server)
+		}
+
+		if strings.Contains(destServiceAccount.Namespace, "!") {
+			return status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAccount.Namespace)
+		}
+
+		if strings.TrimSpace(destServiceAccount.DefaultServiceAccount) == "" || strings.Contains(destServiceAccount.DefaultServiceAccount, " ") {
+			return status.Errorf(codes.InvalidArgument, "defaultServiceAccount has an invalid format, '%s'", destServiceAccount.DefaultServiceAccount)
+		}
+
+		key := fmt.Sprintf("%s/%s", destServiceAccount.Server, destServiceAccount.Namespace)
+		if _, ok := destServiceAccounts[key]; ok {
+			return status.Errorf(codes.InvalidArgument, "destinationServiceAccount '%s' already added", key)
+		}
+		destServiceAccounts[key] = true
+	}
+
 	srcNamespaces := make(map[string]bool)
 	for _, ns := range p.Spec.SourceNamespaces {
 		if _, ok := srcNamespaces[ns]; ok {
@@ -265,9 +287,81 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	if operations := p.Spec.Operations; operations != nil {
+		if operations.MaxConcurrent < 0 {
+			return status.Errorf(codes.InvalidArgument, "operations: the maximum number of concurrent operations cannot be negative")
+		}
+		switch operations.PriorityClass {
+		case "", OperationPriorityClassHigh, OperationPriorityClassNormal, OperationPriorityClassLow:
+		default:
+			return status.Errorf(codes.InvalidArgument, "operations: unknown priority class '%s', must be one of high, normal or low", operations.PriorityClass)
+		}
+	}
+
+	templateNames := make(map[string]bool)
+	for _, template := range p.Spec.ManagedNamespaceTemplates {
+		if template.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "managed namespace template has no name")
+		}
+		if templateNames[template.Name] {
+			return status.Errorf(codes.InvalidArgument, "managed namespace template '%s' already exists", template.Name)
+		}
+		templateNames[template.Name] = true
+		// the template is rendered with placeholder values to verify that it is a valid manifest
+		placeholder := &Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app"},
+			Spec:       ApplicationSpec{Project: p.Name, Destination: ApplicationDestination{Server: "https://kubernetes.default.svc", Name: "in-cluster", Namespace: "namespace"}},
+		}
+		if _, err := template.Render(placeholder); err != nil {
+			return status.Errorf(codes.InvalidArgument, "managed namespace template '%s' is invalid: %v", template.Name, err)
+		}
+	}
+
+	if p.Spec.OrphanedResources != nil && p.Spec.OrphanedResources.Cleanup != nil {
+		cleanup := p.Spec.OrphanedResources.Cleanup
+		if _, err := cleanup.GetMinAge(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "orphaned resources cleanup: %v", err)
+		}
+		if cleanup.Enabled && len(cleanup.Allow) == 0 {
+			return status.Errorf(codes.InvalidArgument, "orphaned resources cleanup: at least one allowed selector is required when enabled")
+		}
+		for _, selector := range append(append([]OrphanedResourceSelector{}, cleanup.Allow...), cleanup.Ignore...) {
+			if selector.LabelSelector == nil {
+				continue
+			}
+			if _, err := metav1.LabelSelectorAsSelector(selector.LabelSelector); err != nil {
+				return status.Errorf(codes.InvalidArgument, "orphaned resources cleanup: invalid label selector: %v", err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// GetServiceAccountToImpersonate returns the Kubernetes username of the service account to impersonate when syncing
+// the given destination, from the first destination service account of the project matching it
+func (p *AppProject) GetServiceAccountToImpersonate(destination ApplicationDestination, appNamespace string) (string, error) {
+	// the service account is looked up in the application namespace if the destination has no namespace
+	serviceAccountNamespace := destination.Namespace
+	if serviceAccountNamespace == "" {
+		serviceAccountNamespace = appNamespace
+	}
+	for _, item := range p.Spec.DestinationServiceAccounts {
+		if !glob.Match(item.Server, destination.Server) || !glob.Match(item.Namespace, destination.Namespace) {
+			continue
+		}
+		if strings.TrimSpace(item.DefaultServiceAccount) == "" || strings.Contains(item.DefaultServiceAccount, " ") {
+			return "", fmt.Errorf("default service account contains invalid chars '%s'", item.DefaultServiceAccount)
+		}
+		if strings.Contains(item.DefaultServiceAccount, ":") {
+			// the service account is specified along with its namespace
+			return fmt.Sprintf("system:serviceaccount:%s", item.DefaultServiceAccount), nil
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, item.DefaultServiceAccount), nil
+	}
+	return "", fmt.Errorf("no matching service account found for destination server %s and namespace %s", destination.Server, destination.Namespace)
+}
+
 // AddGroupToRole adds an OIDC group to a role
 func (p *AppProject) AddGroupToRole(roleName, group string) (bool, error) {
 	role, roleIndex, err := p.GetRoleByName(roleName)
@@ -564,3 +658,38 @@ func (p AppProject) IsAppNamespacePermitted(app *Application, controllerNs strin
 
 	return glob.MatchStringInList(p.Spec.SourceNamespaces, app.Namespace, glob.REGEXP)
 }
+
+// managedNamespaceTemplateEnv returns the variables which can be referenced by the managed namespace templates
+func managedNamespaceTemplateEnv(app *Application) Env {
+	return Env{
+		&EnvEntry{Name: "ARGOCD_APP_NAME", Value: app.Name},
+		&EnvEntry{Name: "ARGOCD_APP_NAMESPACE", Value: app.Spec.Destination.Namespace},
+		&EnvEntry{Name: "ARGOCD_APP_PROJECT_NAME", Value: app.Spec.GetProject()},
+		&EnvEntry{Name: "ARGOCD_APP_DESTINATION_SERVER", Value: app.Spec.Destination.Server},
+		&EnvEntry{Name: "ARGOCD_APP_DESTINATION_NAME", Value: app.Spec.Destination.Name},
+	}
+}
+
+// Render interpolates the variables of the template with the values of the given application, and returns the
+// resource to create in the destination namespace of the application
+func (t ManagedNamespaceTemplate) Render(app *Application) (*unstructured.Unstructured, error) {
+	manifest, err := yaml.YAMLToJSON([]byte(managedNamespaceTemplateEnv(app).Envsubst(t.Manifest)))
+	if err != nil {
+		return nil, err
+	}
+	obj, err := UnmarshalToUnstructured(string(manifest))
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+		return nil, fmt.Errorf("the manifest must define the apiVersion, kind and name of a resource")
+	}
+	if obj.GetAPIVersion() == "v1" && obj.GetKind() == "Namespace" {
+		return nil, fmt.Errorf("the manifest cannot define a namespace, use managedNamespaceMetadata instead")
+	}
+	if obj.GetNamespace() != "" && obj.GetNamespace() != app.Spec.Destination.Namespace {
+		return nil, fmt.Errorf("the resource can only be created in the destination namespace of the application")
+	}
+	obj.SetNamespace(app.Spec.Destination.Namespace)
+	return obj, nil
+}
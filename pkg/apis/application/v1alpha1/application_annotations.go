@@ -10,4 +10,26 @@ const (
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
 	// source path within the repository.
 	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
+
+	// AnnotationKeyAggregateChildStatus is the annotation which enables the aggregation of the status of the child
+	// applications of an app-of-apps into its summary, if set to "true"
+	AnnotationKeyAggregateChildStatus = "argocd.argoproj.io/aggregate-child-status"
+
+	// AnnotationKeyChildOutOfSyncPropagation is the annotation of an app-of-apps which decides whether its child
+	// applications being OutOfSync makes the app-of-apps OutOfSync. Only applies if the aggregation of the status of the
+	// child applications is enabled.
+	AnnotationKeyChildOutOfSyncPropagation = "argocd.argoproj.io/child-out-of-sync-propagation"
+)
+
+// ChildOutOfSyncPropagation decides which OutOfSync child applications make an app-of-apps OutOfSync
+type ChildOutOfSyncPropagation string
+
+const (
+	// ChildOutOfSyncPropagationNone never propagates the OutOfSync status of the child applications. This is the default.
+	ChildOutOfSyncPropagationNone ChildOutOfSyncPropagation = "none"
+	// ChildOutOfSyncPropagationAll propagates the OutOfSync status of all the child applications
+	ChildOutOfSyncPropagationAll ChildOutOfSyncPropagation = "all"
+	// ChildOutOfSyncPropagationManual propagates the OutOfSync status of the child applications without automated
+	// sync, since the drift of the other child applications is expected to be fixed without intervention
+	ChildOutOfSyncPropagationManual ChildOutOfSyncPropagation = "manual"
 )
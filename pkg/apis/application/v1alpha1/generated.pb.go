@@ -235,10 +235,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{7}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{7}
+	return fileDescriptor_030104ce3b95bcac, []int{8}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,10 +291,38 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
+func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
+func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{9}
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.Merge(m, src)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{10}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +350,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,10 +375,38 @@ func (m *ApplicationMatchExpression) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 
+func (m *ApplicationPause) Reset()      { *m = ApplicationPause{} }
+func (*ApplicationPause) ProtoMessage() {}
+func (*ApplicationPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{12}
+}
+func (m *ApplicationPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPause.Merge(m, src)
+}
+func (m *ApplicationPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPause proto.InternalMessageInfo
+
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +632,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{22}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +688,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{25}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +772,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{27}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{31}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +940,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1248,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1276,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1304,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{45}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,10 +1329,38 @@ func (m *BearerTokenBitbucketCloud) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 
+func (m *CapturedContainerLogs) Reset()      { *m = CapturedContainerLogs{} }
+func (*CapturedContainerLogs) ProtoMessage() {}
+func (*CapturedContainerLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{46}
+}
+func (m *CapturedContainerLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapturedContainerLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CapturedContainerLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapturedContainerLogs.Merge(m, src)
+}
+func (m *CapturedContainerLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapturedContainerLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapturedContainerLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapturedContainerLogs proto.InternalMessageInfo
+
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{47}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,10 +1385,38 @@ func (m *ChartDetails) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 
+func (m *ChildApplicationsSummary) Reset()      { *m = ChildApplicationsSummary{} }
+func (*ChildApplicationsSummary) ProtoMessage() {}
+func (*ChildApplicationsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{48}
+}
+func (m *ChildApplicationsSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildApplicationsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildApplicationsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildApplicationsSummary.Merge(m, src)
+}
+func (m *ChildApplicationsSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildApplicationsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildApplicationsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildApplicationsSummary proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1444,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,10 +1469,38 @@ func (m *ClusterCacheInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 
+func (m *ClusterCircuitBreaker) Reset()      { *m = ClusterCircuitBreaker{} }
+func (*ClusterCircuitBreaker) ProtoMessage() {}
+func (*ClusterCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{51}
+}
+func (m *ClusterCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCircuitBreaker.Merge(m, src)
+}
+func (m *ClusterCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCircuitBreaker proto.InternalMessageInfo
+
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1528,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1556,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+	return fileDescriptor_030104ce3b95bcac, []int{54}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1584,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{55}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1612,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1640,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1668,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{58}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1696,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{59}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1724,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{60}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1752,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{61}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1780,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1808,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1836,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1693,10 +1861,38 @@ func (m *ExecProviderConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 
+func (m *FailingChildApplication) Reset()      { *m = FailingChildApplication{} }
+func (*FailingChildApplication) ProtoMessage() {}
+func (*FailingChildApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{65}
+}
+func (m *FailingChildApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailingChildApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FailingChildApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailingChildApplication.Merge(m, src)
+}
+func (m *FailingChildApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailingChildApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailingChildApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailingChildApplication proto.InternalMessageInfo
+
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1920,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1948,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1976,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +2004,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2032,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2060,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2088,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2116,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2144,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{75}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2172,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{76}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2200,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2228,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2256,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2284,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2312,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2340,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2368,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2396,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2424,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2452,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2480,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2508,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2536,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2564,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{90}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2393,10 +2589,38 @@ func (m *ManagedNamespaceMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 
+func (m *ManagedNamespaceTemplate) Reset()      { *m = ManagedNamespaceTemplate{} }
+func (*ManagedNamespaceTemplate) ProtoMessage() {}
+func (*ManagedNamespaceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{91}
+}
+func (m *ManagedNamespaceTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedNamespaceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedNamespaceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedNamespaceTemplate.Merge(m, src)
+}
+func (m *ManagedNamespaceTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedNamespaceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedNamespaceTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedNamespaceTemplate proto.InternalMessageInfo
+
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{92}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2648,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{93}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2676,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{94}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2704,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2732,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{96}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2760,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{89}
+	return fileDescriptor_030104ce3b95bcac, []int{97}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2788,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{90}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2816,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{91}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2844,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{92}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2872,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{93}
+	return fileDescriptor_030104ce3b95bcac, []int{101}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,10 +2897,66 @@ func (m *OrphanedResourceKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 
+func (m *OrphanedResourceSelector) Reset()      { *m = OrphanedResourceSelector{} }
+func (*OrphanedResourceSelector) ProtoMessage() {}
+func (*OrphanedResourceSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{102}
+}
+func (m *OrphanedResourceSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourceSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourceSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourceSelector.Merge(m, src)
+}
+func (m *OrphanedResourceSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourceSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourceSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourceSelector proto.InternalMessageInfo
+
+func (m *OrphanedResourcesCleanupPolicy) Reset()      { *m = OrphanedResourcesCleanupPolicy{} }
+func (*OrphanedResourcesCleanupPolicy) ProtoMessage() {}
+func (*OrphanedResourcesCleanupPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{103}
+}
+func (m *OrphanedResourcesCleanupPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourcesCleanupPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourcesCleanupPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourcesCleanupPolicy.Merge(m, src)
+}
+func (m *OrphanedResourcesCleanupPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourcesCleanupPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourcesCleanupPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourcesCleanupPolicy proto.InternalMessageInfo
+
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{94}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2984,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{95}
+	return fileDescriptor_030104ce3b95bcac, []int{105}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +3012,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{96}
+	return fileDescriptor_030104ce3b95bcac, []int{106}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3040,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{97}
+	return fileDescriptor_030104ce3b95bcac, []int{107}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3068,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{98}
+	return fileDescriptor_030104ce3b95bcac, []int{108}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2813,10 +3093,38 @@ func (m *PluginInput) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 
+func (m *ProjectOperationsPolicy) Reset()      { *m = ProjectOperationsPolicy{} }
+func (*ProjectOperationsPolicy) ProtoMessage() {}
+func (*ProjectOperationsPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{109}
+}
+func (m *ProjectOperationsPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectOperationsPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectOperationsPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectOperationsPolicy.Merge(m, src)
+}
+func (m *ProjectOperationsPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectOperationsPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectOperationsPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectOperationsPolicy proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{99}
+	return fileDescriptor_030104ce3b95bcac, []int{110}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3152,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{100}
+	return fileDescriptor_030104ce3b95bcac, []int{111}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3180,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{101}
+	return fileDescriptor_030104ce3b95bcac, []int{112}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3208,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{102}
+	return fileDescriptor_030104ce3b95bcac, []int{113}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3236,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{103}
+	return fileDescriptor_030104ce3b95bcac, []int{114}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3264,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{104}
+	return fileDescriptor_030104ce3b95bcac, []int{115}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3292,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{105}
+	return fileDescriptor_030104ce3b95bcac, []int{116}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{106}
+	return fileDescriptor_030104ce3b95bcac, []int{117}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{107}
+	return fileDescriptor_030104ce3b95bcac, []int{118}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3065,10 +3373,66 @@ func (m *PullRequestGeneratorGithub) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 
+func (m *ReconcilePhaseTiming) Reset()      { *m = ReconcilePhaseTiming{} }
+func (*ReconcilePhaseTiming) ProtoMessage() {}
+func (*ReconcilePhaseTiming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{119}
+}
+func (m *ReconcilePhaseTiming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcilePhaseTiming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReconcilePhaseTiming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcilePhaseTiming.Merge(m, src)
+}
+func (m *ReconcilePhaseTiming) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcilePhaseTiming) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcilePhaseTiming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcilePhaseTiming proto.InternalMessageInfo
+
+func (m *ReconcileProfile) Reset()      { *m = ReconcileProfile{} }
+func (*ReconcileProfile) ProtoMessage() {}
+func (*ReconcileProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{120}
+}
+func (m *ReconcileProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReconcileProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileProfile.Merge(m, src)
+}
+func (m *ReconcileProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileProfile proto.InternalMessageInfo
+
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{108}
+	return fileDescriptor_030104ce3b95bcac, []int{121}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3460,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{109}
+	return fileDescriptor_030104ce3b95bcac, []int{122}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3488,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{110}
+	return fileDescriptor_030104ce3b95bcac, []int{123}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3516,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{111}
+	return fileDescriptor_030104ce3b95bcac, []int{124}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3544,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{112}
+	return fileDescriptor_030104ce3b95bcac, []int{125}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3572,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{113}
+	return fileDescriptor_030104ce3b95bcac, []int{126}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3600,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{114}
+	return fileDescriptor_030104ce3b95bcac, []int{127}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3628,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{115}
+	return fileDescriptor_030104ce3b95bcac, []int{128}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3656,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{116}
+	return fileDescriptor_030104ce3b95bcac, []int{129}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3684,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{117}
+	return fileDescriptor_030104ce3b95bcac, []int{130}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3712,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{118}
+	return fileDescriptor_030104ce3b95bcac, []int{131}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3373,10 +3737,38 @@ func (m *ResourceActions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 
+func (m *ResourceCapacity) Reset()      { *m = ResourceCapacity{} }
+func (*ResourceCapacity) ProtoMessage() {}
+func (*ResourceCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{132}
+}
+func (m *ResourceCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceCapacity.Merge(m, src)
+}
+func (m *ResourceCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceCapacity proto.InternalMessageInfo
+
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{119}
+	return fileDescriptor_030104ce3b95bcac, []int{133}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3796,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{120}
+	return fileDescriptor_030104ce3b95bcac, []int{134}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3824,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{121}
+	return fileDescriptor_030104ce3b95bcac, []int{135}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3852,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{122}
+	return fileDescriptor_030104ce3b95bcac, []int{136}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3880,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{123}
+	return fileDescriptor_030104ce3b95bcac, []int{137}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3908,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{124}
+	return fileDescriptor_030104ce3b95bcac, []int{138}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3936,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{125}
+	return fileDescriptor_030104ce3b95bcac, []int{139}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3964,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{126}
+	return fileDescriptor_030104ce3b95bcac, []int{140}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3992,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{127}
+	return fileDescriptor_030104ce3b95bcac, []int{141}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4020,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{128}
+	return fileDescriptor_030104ce3b95bcac, []int{142}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +4048,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{129}
+	return fileDescriptor_030104ce3b95bcac, []int{143}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +4073,38 @@ func (m *RevisionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 
+func (m *RollbackOnDegraded) Reset()      { *m = RollbackOnDegraded{} }
+func (*RollbackOnDegraded) ProtoMessage() {}
+func (*RollbackOnDegraded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{144}
+}
+func (m *RollbackOnDegraded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackOnDegraded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RollbackOnDegraded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackOnDegraded.Merge(m, src)
+}
+func (m *RollbackOnDegraded) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackOnDegraded) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackOnDegraded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackOnDegraded proto.InternalMessageInfo
+
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{130}
+	return fileDescriptor_030104ce3b95bcac, []int{145}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4132,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{131}
+	return fileDescriptor_030104ce3b95bcac, []int{146}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4160,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{132}
+	return fileDescriptor_030104ce3b95bcac, []int{147}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{133}
+	return fileDescriptor_030104ce3b95bcac, []int{148}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{134}
+	return fileDescriptor_030104ce3b95bcac, []int{149}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{135}
+	return fileDescriptor_030104ce3b95bcac, []int{150}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{136}
+	return fileDescriptor_030104ce3b95bcac, []int{151}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{137}
+	return fileDescriptor_030104ce3b95bcac, []int{152}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{138}
+	return fileDescriptor_030104ce3b95bcac, []int{153}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{139}
+	return fileDescriptor_030104ce3b95bcac, []int{154}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4384,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{140}
+	return fileDescriptor_030104ce3b95bcac, []int{155}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3989,10 +4409,38 @@ func (m *SignatureKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 
+func (m *SyncHistoryRecord) Reset()      { *m = SyncHistoryRecord{} }
+func (*SyncHistoryRecord) ProtoMessage() {}
+func (*SyncHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{156}
+}
+func (m *SyncHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHistoryRecord.Merge(m, src)
+}
+func (m *SyncHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHistoryRecord proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{141}
+	return fileDescriptor_030104ce3b95bcac, []int{157}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4468,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{142}
+	return fileDescriptor_030104ce3b95bcac, []int{158}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4496,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{143}
+	return fileDescriptor_030104ce3b95bcac, []int{159}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4524,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{144}
+	return fileDescriptor_030104ce3b95bcac, []int{160}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4552,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{145}
+	return fileDescriptor_030104ce3b95bcac, []int{161}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4580,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{146}
+	return fileDescriptor_030104ce3b95bcac, []int{162}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4608,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{147}
+	return fileDescriptor_030104ce3b95bcac, []int{163}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4636,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{148}
+	return fileDescriptor_030104ce3b95bcac, []int{164}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4664,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{149}
+	return fileDescriptor_030104ce3b95bcac, []int{165}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4692,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{150}
+	return fileDescriptor_030104ce3b95bcac, []int{166}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4720,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{151}
+	return fileDescriptor_030104ce3b95bcac, []int{167}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4748,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{152}
+	return fileDescriptor_030104ce3b95bcac, []int{168}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4334,9 +4782,12 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
+	proto.RegisterType((*ApplicationPause)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationPause")
 	proto.RegisterType((*ApplicationPreservedFields)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationPreservedFields")
 	proto.RegisterType((*ApplicationSet)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSet")
 	proto.RegisterType((*ApplicationSetApplicationStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSetApplicationStatus")
@@ -4374,11 +4825,16 @@ func init() {
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucketCloud)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.BearerTokenBitbucketCloud")
+	proto.RegisterType((*CapturedContainerLogs)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.CapturedContainerLogs")
 	proto.RegisterType((*ChartDetails)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ChartDetails")
+	proto.RegisterType((*ChildApplicationsSummary)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ChildApplicationsSummary")
+	proto.RegisterMapType((map[string]int64)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ChildApplicationsSummary.HealthStatusesEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ChildApplicationsSummary.SyncStatusesEntry")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
 	proto.RegisterType((*ClusterCacheInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterCacheInfo")
+	proto.RegisterType((*ClusterCircuitBreaker)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterCircuitBreaker")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterGenerator.ValuesEntry")
@@ -4395,6 +4851,7 @@ func init() {
 	proto.RegisterType((*ErrApplicationNotAllowedToUseProject)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ErrApplicationNotAllowedToUseProject")
 	proto.RegisterType((*ExecProviderConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ExecProviderConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ExecProviderConfig.EnvEntry")
+	proto.RegisterType((*FailingChildApplication)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.FailingChildApplication")
 	proto.RegisterType((*GitDirectoryGeneratorItem)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GitDirectoryGeneratorItem")
 	proto.RegisterType((*GitFileGeneratorItem)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GitFileGeneratorItem")
 	proto.RegisterType((*GitGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GitGenerator")
@@ -4424,6 +4881,7 @@ func init() {
 	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
+	proto.RegisterType((*ManagedNamespaceTemplate)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceTemplate")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.MergeGenerator")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
@@ -4435,6 +4893,8 @@ func init() {
 	proto.RegisterType((*OptionalMap)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
+	proto.RegisterType((*OrphanedResourceSelector)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceSelector")
+	proto.RegisterType((*OrphanedResourcesCleanupPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesCleanupPolicy")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
 	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginConfigMapRef")
@@ -4442,6 +4902,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginGenerator.ValuesEntry")
 	proto.RegisterType((*PluginInput)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput")
 	proto.RegisterMapType((PluginParameters)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput.ParametersEntry")
+	proto.RegisterType((*ProjectOperationsPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectOperationsPolicy")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*PullRequestGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGenerator")
 	proto.RegisterType((*PullRequestGeneratorAzureDevOps)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorAzureDevOps")
@@ -4451,6 +4912,8 @@ func init() {
 	proto.RegisterType((*PullRequestGeneratorGitLab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitLab")
 	proto.RegisterType((*PullRequestGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGitea")
 	proto.RegisterType((*PullRequestGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorGithub")
+	proto.RegisterType((*ReconcilePhaseTiming)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ReconcilePhaseTiming")
+	proto.RegisterType((*ReconcileProfile)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ReconcileProfile")
 	proto.RegisterType((*RefTarget)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RefTarget")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCreds")
 	proto.RegisterType((*RepoCredsList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCredsList")
@@ -4462,6 +4925,7 @@ func init() {
 	proto.RegisterType((*ResourceActionDefinition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceActionDefinition")
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceActions")
+	proto.RegisterType((*ResourceCapacity)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceCapacity")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceDiff")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
@@ -4477,6 +4941,7 @@ func init() {
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionMetadata")
+	proto.RegisterType((*RollbackOnDegraded)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RollbackOnDegraded")
 	proto.RegisterType((*SCMProviderGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGenerator.ValuesEntry")
 	proto.RegisterType((*SCMProviderGeneratorAWSCodeCommit)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorAWSCodeCommit")
@@ -4489,6 +4954,7 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SignatureKey")
+	proto.RegisterType((*SyncHistoryRecord)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncHistoryRecord")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -4508,709 +4974,797 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 11230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0xdd, 0xf5, 0xdd, 0x91, 0xb8, 0x13, 0x49, 0x9c,
-	0x87, 0x36, 0x45, 0x47, 0x24, 0x60, 0x9e, 0x48, 0x99, 0x11, 0x2d, 0xca, 0xf8, 0xb8, 0xc3, 0xe1,
-	0x0e, 0x38, 0x80, 0x0d, 0xdc, 0x9d, 0x44, 0x99, 0xa2, 0x06, 0xbb, 0x8d, 0xc5, 0x1c, 0x66, 0x67,
-	0x86, 0x33, 0xb3, 0x38, 0x80, 0x96, 0x64, 0xc9, 0x92, 0x6c, 0x25, 0xfa, 0xa0, 0x2c, 0x25, 0x65,
-	0x3a, 0xb1, 0x14, 0xd9, 0x72, 0x52, 0x71, 0x25, 0xaa, 0x38, 0xc9, 0x8f, 0x38, 0x72, 0xaa, 0x5c,
-	0xb1, 0x53, 0x29, 0x25, 0x8e, 0xcb, 0x8e, 0xcb, 0x65, 0x39, 0x89, 0x8d, 0x48, 0x97, 0x4a, 0x25,
-	0x95, 0xaa, 0xb8, 0xca, 0x89, 0x7f, 0x24, 0x97, 0xfc, 0x48, 0xf5, 0x77, 0xcf, 0xec, 0x2c, 0xb0,
-	0x00, 0x06, 0x77, 0x27, 0x85, 0xff, 0x76, 0xfb, 0xbd, 0x79, 0xaf, 0xa7, 0xa7, 0xfb, 0xbd, 0xd7,
-	0xaf, 0xdf, 0x7b, 0x0d, 0x0b, 0x4d, 0x37, 0xd9, 0x68, 0xaf, 0x4d, 0xd4, 0x83, 0xd6, 0xa4, 0x13,
-	0x35, 0x83, 0x30, 0x0a, 0x6e, 0xb1, 0x1f, 0x4f, 0xd7, 0x1b, 0x93, 0x5b, 0x17, 0x26, 0xc3, 0xcd,
-	0xe6, 0xa4, 0x13, 0xba, 0xf1, 0xa4, 0x13, 0x86, 0x9e, 0x5b, 0x77, 0x12, 0x37, 0xf0, 0x27, 0xb7,
-	0x9e, 0x71, 0xbc, 0x70, 0xc3, 0x79, 0x66, 0xb2, 0x49, 0x7c, 0x12, 0x39, 0x09, 0x69, 0x4c, 0x84,
-	0x51, 0x90, 0x04, 0xe8, 0xc7, 0x34, 0xb5, 0x09, 0x49, 0x8d, 0xfd, 0x78, 0xb5, 0xde, 0x98, 0xd8,
-	0xba, 0x30, 0x11, 0x6e, 0x36, 0x27, 0x28, 0xb5, 0x09, 0x83, 0xda, 0x84, 0xa4, 0x76, 0xee, 0x69,
-	0xa3, 0x2f, 0xcd, 0xa0, 0x19, 0x4c, 0x32, 0xa2, 0x6b, 0xed, 0x75, 0xf6, 0x8f, 0xfd, 0x61, 0xbf,
-	0x38, 0xb3, 0x73, 0xf6, 0xe6, 0xf3, 0xf1, 0x84, 0x1b, 0xd0, 0xee, 0x4d, 0xd6, 0x83, 0x88, 0x4c,
-	0x6e, 0x75, 0x74, 0xe8, 0xdc, 0x65, 0x8d, 0x43, 0xb6, 0x13, 0xe2, 0xc7, 0x6e, 0xe0, 0xc7, 0x4f,
-	0xd3, 0x2e, 0x90, 0x68, 0x8b, 0x44, 0xe6, 0xeb, 0x19, 0x08, 0x79, 0x94, 0x9e, 0xd5, 0x94, 0x5a,
-	0x4e, 0x7d, 0xc3, 0xf5, 0x49, 0xb4, 0xa3, 0x1f, 0x6f, 0x91, 0xc4, 0xc9, 0x7b, 0x6a, 0xb2, 0xdb,
-	0x53, 0x51, 0xdb, 0x4f, 0xdc, 0x16, 0xe9, 0x78, 0xe0, 0xdd, 0xfb, 0x3d, 0x10, 0xd7, 0x37, 0x48,
-	0xcb, 0xe9, 0x78, 0xee, 0x5d, 0xdd, 0x9e, 0x6b, 0x27, 0xae, 0x37, 0xe9, 0xfa, 0x49, 0x9c, 0x44,
-	0xd9, 0x87, 0xec, 0x5f, 0xb4, 0x60, 0x78, 0xea, 0xe6, 0xca, 0x54, 0x3b, 0xd9, 0x98, 0x09, 0xfc,
-	0x75, 0xb7, 0x89, 0x9e, 0x83, 0xc1, 0xba, 0xd7, 0x8e, 0x13, 0x12, 0x5d, 0x73, 0x5a, 0x64, 0xcc,
-	0x3a, 0x6f, 0x3d, 0x59, 0x9b, 0x3e, 0xf5, 0xad, 0xdd, 0xf1, 0xb7, 0xdd, 0xd9, 0x1d, 0x1f, 0x9c,
-	0xd1, 0x20, 0x6c, 0xe2, 0xa1, 0x1f, 0x86, 0x81, 0x28, 0xf0, 0xc8, 0x14, 0xbe, 0x36, 0x56, 0x62,
-	0x8f, 0x8c, 0x8a, 0x47, 0x06, 0x30, 0x6f, 0xc6, 0x12, 0x4e, 0x51, 0xc3, 0x28, 0x58, 0x77, 0x3d,
-	0x32, 0x56, 0x4e, 0xa3, 0x2e, 0xf3, 0x66, 0x2c, 0xe1, 0xf6, 0x1f, 0x95, 0x00, 0xa6, 0xc2, 0x70,
-	0x39, 0x0a, 0x6e, 0x91, 0x7a, 0x82, 0x3e, 0x0c, 0x55, 0x3a, 0xcc, 0x0d, 0x27, 0x71, 0x58, 0xc7,
-	0x06, 0x2f, 0xfc, 0xc8, 0x04, 0x7f, 0xeb, 0x09, 0xf3, 0xad, 0xf5, 0x24, 0xa3, 0xd8, 0x13, 0x5b,
-	0xcf, 0x4c, 0x2c, 0xad, 0xd1, 0xe7, 0x17, 0x49, 0xe2, 0x4c, 0x23, 0xc1, 0x0c, 0x74, 0x1b, 0x56,
-	0x54, 0x91, 0x0f, 0x7d, 0x71, 0x48, 0xea, 0xec, 0x1d, 0x06, 0x2f, 0x2c, 0x4c, 0x1c, 0x65, 0x36,
-	0x4f, 0xe8, 0x9e, 0xaf, 0x84, 0xa4, 0x3e, 0x3d, 0x24, 0x38, 0xf7, 0xd1, 0x7f, 0x98, 0xf1, 0x41,
-	0x5b, 0xd0, 0x1f, 0x27, 0x4e, 0xd2, 0x8e, 0xd9, 0x50, 0x0c, 0x5e, 0xb8, 0x56, 0x18, 0x47, 0x46,
-	0x75, 0x7a, 0x44, 0xf0, 0xec, 0xe7, 0xff, 0xb1, 0xe0, 0x66, 0xff, 0xa9, 0x05, 0x23, 0x1a, 0x79,
-	0xc1, 0x8d, 0x13, 0xf4, 0x13, 0x1d, 0x83, 0x3b, 0xd1, 0xdb, 0xe0, 0xd2, 0xa7, 0xd9, 0xd0, 0x9e,
-	0x10, 0xcc, 0xaa, 0xb2, 0xc5, 0x18, 0xd8, 0x16, 0x54, 0xdc, 0x84, 0xb4, 0xe2, 0xb1, 0xd2, 0xf9,
-	0xf2, 0x93, 0x83, 0x17, 0x2e, 0x17, 0xf5, 0x9e, 0xd3, 0xc3, 0x82, 0x69, 0x65, 0x9e, 0x92, 0xc7,
-	0x9c, 0x8b, 0xfd, 0xab, 0x43, 0xe6, 0xfb, 0xd1, 0x01, 0x47, 0xcf, 0xc0, 0x60, 0x1c, 0xb4, 0xa3,
-	0x3a, 0xc1, 0x24, 0x0c, 0xe2, 0x31, 0xeb, 0x7c, 0x99, 0x4e, 0x3d, 0x3a, 0xa9, 0x57, 0x74, 0x33,
-	0x36, 0x71, 0xd0, 0x17, 0x2c, 0x18, 0x6a, 0x90, 0x38, 0x71, 0x7d, 0xc6, 0x5f, 0x76, 0x7e, 0xf5,
-	0xc8, 0x9d, 0x97, 0x8d, 0xb3, 0x9a, 0xf8, 0xf4, 0x69, 0xf1, 0x22, 0x43, 0x46, 0x63, 0x8c, 0x53,
-	0xfc, 0xe9, 0xe2, 0x6c, 0x90, 0xb8, 0x1e, 0xb9, 0x21, 0xfd, 0x2f, 0x96, 0x8f, 0x5a, 0x9c, 0xb3,
-	0x1a, 0x84, 0x4d, 0x3c, 0xe4, 0x43, 0x85, 0x2e, 0xbe, 0x78, 0xac, 0x8f, 0xf5, 0x7f, 0xfe, 0x68,
-	0xfd, 0x17, 0x83, 0x4a, 0xd7, 0xb5, 0x1e, 0x7d, 0xfa, 0x2f, 0xc6, 0x9c, 0x0d, 0xfa, 0xbc, 0x05,
-	0x63, 0x42, 0x38, 0x60, 0xc2, 0x07, 0xf4, 0xe6, 0x86, 0x9b, 0x10, 0xcf, 0x8d, 0x93, 0xb1, 0x0a,
-	0xeb, 0xc3, 0x64, 0x6f, 0x73, 0x6b, 0x2e, 0x0a, 0xda, 0xe1, 0x55, 0xd7, 0x6f, 0x4c, 0x9f, 0x17,
-	0x9c, 0xc6, 0x66, 0xba, 0x10, 0xc6, 0x5d, 0x59, 0xa2, 0x2f, 0x5b, 0x70, 0xce, 0x77, 0x5a, 0x24,
-	0x0e, 0x1d, 0xfa, 0x69, 0x39, 0x78, 0xda, 0x73, 0xea, 0x9b, 0xac, 0x47, 0xfd, 0x87, 0xeb, 0x91,
-	0x2d, 0x7a, 0x74, 0xee, 0x5a, 0x57, 0xd2, 0x78, 0x0f, 0xb6, 0xe8, 0xeb, 0x16, 0x9c, 0x0c, 0xa2,
-	0x70, 0xc3, 0xf1, 0x49, 0x43, 0x42, 0xe3, 0xb1, 0x01, 0xb6, 0xf4, 0x3e, 0x74, 0xb4, 0x4f, 0xb4,
-	0x94, 0x25, 0xbb, 0x18, 0xf8, 0x6e, 0x12, 0x44, 0x2b, 0x24, 0x49, 0x5c, 0xbf, 0x19, 0x4f, 0x9f,
-	0xb9, 0xb3, 0x3b, 0x7e, 0xb2, 0x03, 0x0b, 0x77, 0xf6, 0x07, 0xfd, 0x24, 0x0c, 0xc6, 0x3b, 0x7e,
-	0xfd, 0xa6, 0xeb, 0x37, 0x82, 0xdb, 0xf1, 0x58, 0xb5, 0x88, 0xe5, 0xbb, 0xa2, 0x08, 0x8a, 0x05,
-	0xa8, 0x19, 0x60, 0x93, 0x5b, 0xfe, 0x87, 0xd3, 0x53, 0xa9, 0x56, 0xf4, 0x87, 0xd3, 0x93, 0x69,
-	0x0f, 0xb6, 0xe8, 0x67, 0x2d, 0x18, 0x8e, 0xdd, 0xa6, 0xef, 0x24, 0xed, 0x88, 0x5c, 0x25, 0x3b,
-	0xf1, 0x18, 0xb0, 0x8e, 0x5c, 0x39, 0xe2, 0xa8, 0x18, 0x24, 0xa7, 0xcf, 0x88, 0x3e, 0x0e, 0x9b,
-	0xad, 0x31, 0x4e, 0xf3, 0xcd, 0x5b, 0x68, 0x7a, 0x5a, 0x0f, 0x16, 0xbb, 0xd0, 0xf4, 0xa4, 0xee,
-	0xca, 0x12, 0xfd, 0x38, 0x9c, 0xe0, 0x4d, 0x6a, 0x64, 0xe3, 0xb1, 0x21, 0x26, 0x68, 0x4f, 0xdf,
-	0xd9, 0x1d, 0x3f, 0xb1, 0x92, 0x81, 0xe1, 0x0e, 0x6c, 0xf4, 0x1a, 0x8c, 0x87, 0x24, 0x6a, 0xb9,
-	0xc9, 0x92, 0xef, 0xed, 0x48, 0xf1, 0x5d, 0x0f, 0x42, 0xd2, 0x10, 0xdd, 0x89, 0xc7, 0x86, 0xcf,
-	0x5b, 0x4f, 0x56, 0xa7, 0xdf, 0x21, 0xba, 0x39, 0xbe, 0xbc, 0x37, 0x3a, 0xde, 0x8f, 0x9e, 0xfd,
-	0xaf, 0x4a, 0x70, 0x22, 0xab, 0x38, 0xd1, 0xdf, 0xb1, 0x60, 0xf4, 0xd6, 0xed, 0x64, 0x35, 0xd8,
-	0x24, 0x7e, 0x3c, 0xbd, 0x43, 0xc5, 0x1b, 0x53, 0x19, 0x83, 0x17, 0xea, 0xc5, 0xaa, 0xe8, 0x89,
-	0x2b, 0x69, 0x2e, 0x17, 0xfd, 0x24, 0xda, 0x99, 0x7e, 0x58, 0xbc, 0xdd, 0xe8, 0x95, 0x9b, 0xab,
-	0x26, 0x14, 0x67, 0x3b, 0x75, 0xee, 0xb3, 0x16, 0x9c, 0xce, 0x23, 0x81, 0x4e, 0x40, 0x79, 0x93,
-	0xec, 0x70, 0x03, 0x0e, 0xd3, 0x9f, 0xe8, 0x15, 0xa8, 0x6c, 0x39, 0x5e, 0x9b, 0x08, 0xeb, 0x66,
-	0xee, 0x68, 0x2f, 0xa2, 0x7a, 0x86, 0x39, 0xd5, 0xf7, 0x94, 0x9e, 0xb7, 0xec, 0xdf, 0x2b, 0xc3,
-	0xa0, 0xa1, 0xdf, 0xee, 0x81, 0xc5, 0x16, 0xa4, 0x2c, 0xb6, 0xc5, 0xc2, 0x54, 0x73, 0x57, 0x93,
-	0xed, 0x76, 0xc6, 0x64, 0x5b, 0x2a, 0x8e, 0xe5, 0x9e, 0x36, 0x1b, 0x4a, 0xa0, 0x16, 0x84, 0xd4,
-	0x7a, 0xa7, 0xaa, 0xbf, 0xaf, 0x88, 0x4f, 0xb8, 0x24, 0xc9, 0x4d, 0x0f, 0xdf, 0xd9, 0x1d, 0xaf,
-	0xa9, 0xbf, 0x58, 0x33, 0xb2, 0xbf, 0x6d, 0xc1, 0x69, 0xa3, 0x8f, 0x33, 0x81, 0xdf, 0x70, 0xd9,
-	0xa7, 0x3d, 0x0f, 0x7d, 0xc9, 0x4e, 0x28, 0x77, 0x08, 0x6a, 0xa4, 0x56, 0x77, 0x42, 0x82, 0x19,
-	0x84, 0x1a, 0xfa, 0x2d, 0x12, 0xc7, 0x4e, 0x93, 0x64, 0xf7, 0x04, 0x8b, 0xbc, 0x19, 0x4b, 0x38,
-	0x8a, 0x00, 0x79, 0x4e, 0x9c, 0xac, 0x46, 0x8e, 0x1f, 0x33, 0xf2, 0xab, 0x6e, 0x8b, 0x88, 0x01,
-	0xfe, 0x4b, 0xbd, 0xcd, 0x18, 0xfa, 0xc4, 0xf4, 0x43, 0x77, 0x76, 0xc7, 0xd1, 0x42, 0x07, 0x25,
-	0x9c, 0x43, 0xdd, 0xfe, 0xb2, 0x05, 0x0f, 0xe5, 0xdb, 0x62, 0xe8, 0x09, 0xe8, 0xe7, 0xdb, 0x43,
-	0xf1, 0x76, 0xfa, 0x93, 0xb0, 0x56, 0x2c, 0xa0, 0x68, 0x12, 0x6a, 0x4a, 0x4f, 0x88, 0x77, 0x3c,
-	0x29, 0x50, 0x6b, 0x5a, 0xb9, 0x68, 0x1c, 0x3a, 0x68, 0xf4, 0x8f, 0xb0, 0xdc, 0xd4, 0xa0, 0xb1,
-	0xfd, 0x14, 0x83, 0xd8, 0xff, 0xd1, 0x82, 0x51, 0xa3, 0x57, 0xf7, 0xc0, 0x34, 0xf7, 0xd3, 0xa6,
-	0xf9, 0x7c, 0x61, 0xf3, 0xb9, 0x8b, 0x6d, 0xfe, 0x79, 0x0b, 0xce, 0x19, 0x58, 0x8b, 0x4e, 0x52,
-	0xdf, 0xb8, 0xb8, 0x1d, 0x46, 0x24, 0xa6, 0x5b, 0x6f, 0xf4, 0xa8, 0x21, 0xb7, 0xa6, 0x07, 0x05,
-	0x85, 0xf2, 0x55, 0xb2, 0xc3, 0x85, 0xd8, 0x53, 0x50, 0xe5, 0x93, 0x33, 0x88, 0xc4, 0x88, 0xab,
-	0x77, 0x5b, 0x12, 0xed, 0x58, 0x61, 0x20, 0x1b, 0xfa, 0x99, 0x70, 0xa2, 0x8b, 0x95, 0xaa, 0x21,
-	0xa0, 0x1f, 0xf1, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0x2c, 0x47, 0x84, 0x7d, 0xdc,
-	0xc6, 0x25, 0x97, 0x78, 0x8d, 0x98, 0x6e, 0x1b, 0x1c, 0xdf, 0x0f, 0x12, 0xb1, 0x03, 0x30, 0xb6,
-	0x0d, 0x53, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xe7, 0xac, 0x11, 0x8f, 0x8f, 0xa8, 0x60, 0xba,
-	0xc0, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x83, 0xa2, 0x96, 0x3e, 0xb9, 0x17, 0xbb, 0xdb,
-	0x28, 0x25, 0x2b, 0x97, 0x8b, 0x13, 0x5c, 0xa4, 0xfb, 0x0e, 0xf7, 0xf5, 0x8c, 0xb8, 0xc4, 0x85,
-	0x72, 0xdd, 0x7b, 0x97, 0xfb, 0xf1, 0x32, 0x8c, 0xa7, 0x1f, 0xe8, 0x90, 0xb6, 0x74, 0x4b, 0x65,
-	0x30, 0xca, 0xfa, 0x3b, 0x0c, 0x7c, 0x6c, 0xe2, 0x75, 0x11, 0x58, 0xa5, 0xe3, 0x14, 0x58, 0xa6,
-	0x3c, 0x2d, 0xef, 0x23, 0x4f, 0x9f, 0x50, 0xa3, 0xde, 0x97, 0x11, 0x60, 0x69, 0x9d, 0x72, 0x1e,
-	0xfa, 0xe2, 0x84, 0x84, 0x63, 0x95, 0xb4, 0x3c, 0x5a, 0x49, 0x48, 0x88, 0x19, 0x04, 0xbd, 0x17,
-	0x46, 0x13, 0x27, 0x6a, 0x92, 0x24, 0x22, 0x5b, 0x2e, 0xf3, 0x8d, 0xb1, 0xfd, 0x52, 0x6d, 0xfa,
-	0x14, 0x35, 0x4f, 0x56, 0x19, 0x08, 0x4b, 0x10, 0xce, 0xe2, 0xda, 0xff, 0xad, 0x04, 0x0f, 0xa7,
-	0x3f, 0x81, 0xd6, 0x20, 0xef, 0x4b, 0x69, 0x90, 0x77, 0x9a, 0x1a, 0xe4, 0xee, 0xee, 0xf8, 0xdb,
-	0xbb, 0x3c, 0xf6, 0x3d, 0xa3, 0x60, 0xd0, 0x5c, 0xe6, 0x23, 0x4c, 0xa6, 0x3f, 0xc2, 0xdd, 0xdd,
-	0xf1, 0x47, 0xbb, 0xbc, 0x63, 0xe6, 0x2b, 0x3d, 0x01, 0xfd, 0x11, 0x71, 0xe2, 0xc0, 0x17, 0xdf,
-	0x49, 0x7d, 0x4d, 0xcc, 0x5a, 0xb1, 0x80, 0xda, 0x7f, 0x50, 0xcb, 0x0e, 0xf6, 0x1c, 0xf7, 0xf7,
-	0x05, 0x11, 0x72, 0xa1, 0x8f, 0xed, 0x0a, 0xb8, 0x64, 0xb9, 0x7a, 0xb4, 0x55, 0x48, 0xb5, 0x88,
-	0x22, 0x3d, 0x5d, 0xa5, 0x5f, 0x8d, 0x36, 0x61, 0xc6, 0x02, 0x6d, 0x43, 0xb5, 0x2e, 0x8d, 0xf5,
-	0x52, 0x11, 0x6e, 0x2d, 0x61, 0xaa, 0x6b, 0x8e, 0x43, 0x54, 0xdc, 0x2b, 0x0b, 0x5f, 0x71, 0x43,
-	0x04, 0xca, 0x4d, 0x37, 0x11, 0x9f, 0xf5, 0x88, 0xdb, 0xb1, 0x39, 0xd7, 0x78, 0xc5, 0x01, 0xaa,
-	0x83, 0xe6, 0xdc, 0x04, 0x53, 0xfa, 0xe8, 0xd3, 0x16, 0x0c, 0xc6, 0xf5, 0xd6, 0x72, 0x14, 0x6c,
-	0xb9, 0x0d, 0x12, 0x09, 0x63, 0xec, 0x88, 0x92, 0x6d, 0x65, 0x66, 0x51, 0x12, 0xd4, 0x7c, 0xf9,
-	0xf6, 0x58, 0x43, 0xb0, 0xc9, 0x97, 0x6e, 0x52, 0x1e, 0x16, 0xef, 0x3e, 0x4b, 0xea, 0x6c, 0xc5,
-	0xc9, 0x3d, 0x19, 0x9b, 0x29, 0x47, 0x36, 0x4e, 0x67, 0xdb, 0xf5, 0x4d, 0xba, 0xde, 0x74, 0x87,
-	0xde, 0x7e, 0x67, 0x77, 0xfc, 0xe1, 0x99, 0x7c, 0x9e, 0xb8, 0x5b, 0x67, 0xd8, 0x80, 0x85, 0x6d,
-	0xcf, 0xc3, 0xe4, 0xb5, 0x36, 0x61, 0x1e, 0x97, 0x02, 0x06, 0x6c, 0x59, 0x13, 0xcc, 0x0c, 0x98,
-	0x01, 0xc1, 0x26, 0x5f, 0xf4, 0x1a, 0xf4, 0xb7, 0x9c, 0x24, 0x72, 0xb7, 0x85, 0x9b, 0xe5, 0x88,
-	0xdb, 0x85, 0x45, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0xb5, 0xa0, 0xd2,
-	0x22, 0x51, 0x93, 0x8c, 0x55, 0x8b, 0x70, 0x29, 0x2f, 0x52, 0x52, 0x9a, 0x61, 0x8d, 0x1a, 0x57,
-	0xac, 0x0d, 0x73, 0x2e, 0xe8, 0x15, 0xa8, 0xc6, 0xc4, 0x23, 0x75, 0x6a, 0x1e, 0xd5, 0x18, 0xc7,
-	0x77, 0xf5, 0x68, 0x2a, 0x52, 0xbb, 0x64, 0x45, 0x3c, 0xca, 0x17, 0x98, 0xfc, 0x87, 0x15, 0x49,
-	0x3a, 0x80, 0xa1, 0xd7, 0x6e, 0xba, 0xfe, 0x18, 0x14, 0x31, 0x80, 0xcb, 0x8c, 0x56, 0x66, 0x00,
-	0x79, 0x23, 0x16, 0x8c, 0xec, 0xff, 0x6c, 0x01, 0x4a, 0x0b, 0xb5, 0x7b, 0x60, 0x13, 0xbf, 0x96,
-	0xb6, 0x89, 0x17, 0x8a, 0x34, 0x5a, 0xba, 0x98, 0xc5, 0xbf, 0x51, 0x83, 0x8c, 0x3a, 0xb8, 0x46,
-	0xe2, 0x84, 0x34, 0xde, 0x12, 0xe1, 0x6f, 0x89, 0xf0, 0xb7, 0x44, 0xb8, 0x12, 0xe1, 0x6b, 0x19,
-	0x11, 0xfe, 0xa2, 0xb1, 0xea, 0xf5, 0xf9, 0xed, 0xab, 0xea, 0x80, 0xd7, 0xec, 0x81, 0x81, 0x40,
-	0x25, 0xc1, 0x95, 0x95, 0xa5, 0x6b, 0xb9, 0x32, 0xfb, 0xd5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xff,
-	0x1f, 0xa4, 0xf4, 0xbf, 0xb4, 0xe0, 0x1d, 0x69, 0xe9, 0x25, 0x67, 0xce, 0x7c, 0xd3, 0x0f, 0x22,
-	0x32, 0xeb, 0xae, 0xaf, 0x93, 0x88, 0xf8, 0x75, 0x12, 0x2b, 0x27, 0x88, 0xd5, 0xcd, 0x09, 0x82,
-	0x9e, 0x85, 0xa1, 0x5b, 0x71, 0xe0, 0x2f, 0x07, 0xae, 0x2f, 0x44, 0x10, 0xdd, 0x71, 0x9c, 0xb8,
-	0xb3, 0x3b, 0x3e, 0x44, 0x47, 0x54, 0xb6, 0xe3, 0x14, 0x16, 0x9a, 0x81, 0x93, 0xb7, 0x5e, 0x5b,
-	0x76, 0x12, 0xc3, 0x9b, 0x20, 0xf7, 0xfd, 0xec, 0xbc, 0xe3, 0xca, 0x4b, 0x19, 0x20, 0xee, 0xc4,
-	0xb7, 0xff, 0x66, 0x09, 0xce, 0x66, 0x5e, 0x24, 0xf0, 0xbc, 0xa0, 0x9d, 0xd0, 0x3d, 0x11, 0xfa,
-	0xaa, 0x05, 0x27, 0x5a, 0x69, 0x87, 0x45, 0x2c, 0xfc, 0xc2, 0xef, 0x2f, 0x4c, 0x47, 0x64, 0x3c,
-	0x22, 0xd3, 0x63, 0x62, 0x84, 0x4e, 0x64, 0x00, 0x31, 0xee, 0xe8, 0x0b, 0x7a, 0x05, 0x6a, 0x2d,
-	0x67, 0xfb, 0x7a, 0xd8, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xee, 0x45, 0x68, 0x27, 0xae, 0x37, 0xc1,
-	0x23, 0x03, 0x26, 0xe6, 0xfd, 0x64, 0x29, 0x5a, 0x49, 0x22, 0xd7, 0x6f, 0x72, 0x6f, 0xe0, 0xa2,
-	0x24, 0x83, 0x35, 0x45, 0xfb, 0x2b, 0x56, 0x56, 0x49, 0xa9, 0xd1, 0x89, 0x9c, 0x84, 0x34, 0x77,
-	0xd0, 0x47, 0xa0, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95,
-	0x28, 0xfd, 0x17, 0x63, 0xce, 0xd4, 0xfe, 0x6a, 0x2d, 0x6b, 0x2c, 0xb0, 0xb3, 0xdf, 0x0b, 0x00,
-	0xcd, 0x60, 0x95, 0xb4, 0x42, 0x8f, 0x0e, 0x8b, 0xc5, 0x0e, 0x10, 0x94, 0xab, 0x64, 0x4e, 0x41,
-	0xb0, 0x81, 0x85, 0xfe, 0x8a, 0x05, 0xd0, 0x94, 0x73, 0x5e, 0x1a, 0x02, 0xd7, 0x8b, 0x7c, 0x1d,
-	0xbd, 0xa2, 0x74, 0x5f, 0x14, 0x43, 0x6c, 0x30, 0x47, 0x3f, 0x6d, 0x41, 0x35, 0x91, 0xdd, 0xe7,
-	0xaa, 0x71, 0xb5, 0xc8, 0x9e, 0xc8, 0x97, 0xd6, 0x36, 0x91, 0x1a, 0x12, 0xc5, 0x17, 0xfd, 0x8c,
-	0x05, 0x10, 0xef, 0xf8, 0xf5, 0xe5, 0xc0, 0x73, 0xeb, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d,
-	0xa3, 0xa8, 0x4f, 0x8f, 0xd0, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98,
-	0x6e, 0x42, 0x47, 0xae, 0x16, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89,
-	0x7e, 0xde, 0x82, 0xd1, 0x30, 0xed, 0x26, 0x14, 0xea, 0xb0, 0x38, 0x19, 0x90, 0x71, 0x43, 0x72,
-	0x6f, 0x4b, 0xa6, 0x11, 0x67, 0x7b, 0x41, 0x25, 0xa0, 0x9e, 0xc1, 0x4b, 0x21, 0x77, 0x59, 0x0e,
-	0x68, 0x09, 0x38, 0x97, 0x05, 0xe2, 0x4e, 0x7c, 0xb4, 0x0c, 0xa7, 0x69, 0xef, 0x76, 0xb8, 0xf9,
-	0x29, 0xd5, 0x4b, 0xcc, 0x94, 0x61, 0x75, 0xfa, 0x11, 0x31, 0x43, 0xd8, 0xa1, 0x40, 0x16, 0x07,
-	0xe7, 0x3e, 0x89, 0x7e, 0xcf, 0x82, 0x47, 0x5c, 0xa6, 0x06, 0x4c, 0x7f, 0xbb, 0xd6, 0x08, 0xe2,
-	0x20, 0x97, 0x14, 0x2a, 0x2b, 0xba, 0xa9, 0x9f, 0xe9, 0x1f, 0x14, 0x6f, 0xf0, 0xc8, 0xfc, 0x1e,
-	0x5d, 0xc2, 0x7b, 0x76, 0x18, 0xfd, 0x28, 0x0c, 0xcb, 0x75, 0xb1, 0x4c, 0x45, 0x30, 0x53, 0xb4,
-	0xb5, 0xe9, 0x93, 0x77, 0x76, 0xc7, 0x87, 0x57, 0x4d, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xba, 0x9c,
-	0x3a, 0x4e, 0x51, 0x3e, 0x4c, 0x26, 0x6e, 0xea, 0xd2, 0xff, 0x23, 0xa5, 0x67, 0xa1, 0xe2, 0x46,
-	0x79, 0x97, 0xb4, 0xb8, 0x51, 0x4d, 0x31, 0x36, 0x98, 0x53, 0xa3, 0xf4, 0xa4, 0x93, 0xf5, 0x94,
-	0x0a, 0x09, 0xf8, 0x4a, 0x91, 0x5d, 0xea, 0x3c, 0xfc, 0x3a, 0x2b, 0xba, 0x76, 0xb2, 0x03, 0x84,
-	0x3b, 0xbb, 0x84, 0x3e, 0x0a, 0xb5, 0x48, 0x45, 0x4e, 0x94, 0x8b, 0xd8, 0xaa, 0xc9, 0x69, 0x23,
-	0xba, 0xa3, 0x4e, 0x73, 0x74, 0x8c, 0x84, 0xe6, 0x68, 0xff, 0x4e, 0xfa, 0x04, 0xc9, 0x90, 0x1d,
-	0x3d, 0x9c, 0x8e, 0x7d, 0xc1, 0x82, 0xc1, 0x28, 0xf0, 0x3c, 0xd7, 0x6f, 0x52, 0x39, 0x27, 0x94,
-	0xf5, 0x07, 0x8f, 0x45, 0x5f, 0x0a, 0x81, 0xc6, 0x2c, 0x6b, 0xac, 0x79, 0x62, 0xb3, 0x03, 0xf6,
-	0x9f, 0x5a, 0x30, 0xd6, 0x4d, 0x1e, 0x23, 0x02, 0x6f, 0x97, 0xc2, 0x46, 0x0d, 0xc5, 0x92, 0x3f,
-	0x4b, 0x3c, 0xa2, 0xdc, 0xe6, 0xd5, 0xe9, 0xc7, 0xc5, 0x6b, 0xbe, 0x7d, 0xb9, 0x3b, 0x2a, 0xde,
-	0x8b, 0x0e, 0x7a, 0x19, 0x4e, 0x18, 0xef, 0x15, 0xab, 0x81, 0xa9, 0x4d, 0x4f, 0x50, 0x03, 0x68,
-	0x2a, 0x03, 0xbb, 0xbb, 0x3b, 0xfe, 0x50, 0xb6, 0x4d, 0x28, 0x8c, 0x0e, 0x3a, 0xf6, 0xaf, 0x94,
-	0xb2, 0x5f, 0x4b, 0xe9, 0xfa, 0x37, 0xad, 0x0e, 0x6f, 0xc2, 0xfb, 0x8f, 0x43, 0xbf, 0x32, 0xbf,
-	0x83, 0x0a, 0x3f, 0xe9, 0x8e, 0x73, 0x1f, 0xcf, 0xb7, 0xed, 0x7f, 0xd3, 0x07, 0x7b, 0xf4, 0xac,
-	0x07, 0xe3, 0xfd, 0xc0, 0x87, 0xa2, 0x9f, 0xb3, 0xd4, 0x81, 0x19, 0x5f, 0xc3, 0x8d, 0xe3, 0x1a,
-	0x7b, 0xbe, 0x7f, 0x8a, 0x79, 0x8c, 0x85, 0xf2, 0xa2, 0xa7, 0x8f, 0xe6, 0xd0, 0xd7, 0xac, 0xf4,
-	0x91, 0x1f, 0x0f, 0x9a, 0x73, 0x8f, 0xad, 0x4f, 0xc6, 0x39, 0x22, 0xef, 0x98, 0x3e, 0x7d, 0xea,
-	0x76, 0xc2, 0x38, 0x01, 0xb0, 0xee, 0xfa, 0x8e, 0xe7, 0xbe, 0x4e, 0x77, 0x47, 0x15, 0xa6, 0xe0,
-	0x99, 0xc5, 0x74, 0x49, 0xb5, 0x62, 0x03, 0xe3, 0xdc, 0x5f, 0x86, 0x41, 0xe3, 0xcd, 0x73, 0x42,
-	0x43, 0x4e, 0x9b, 0xa1, 0x21, 0x35, 0x23, 0xa2, 0xe3, 0xdc, 0x8b, 0x70, 0x22, 0xdb, 0xc1, 0x83,
-	0x3c, 0x6f, 0xff, 0xaf, 0x81, 0xec, 0x19, 0xdc, 0x2a, 0x89, 0x5a, 0xb4, 0x6b, 0x6f, 0x39, 0xb6,
-	0xde, 0x72, 0x6c, 0xbd, 0xe5, 0xd8, 0x32, 0xcf, 0x26, 0x84, 0xd3, 0x66, 0xe0, 0x1e, 0x39, 0x6d,
-	0x52, 0x6e, 0xa8, 0x6a, 0xe1, 0x6e, 0x28, 0xfb, 0xd3, 0x1d, 0x9e, 0xfb, 0xd5, 0x88, 0x10, 0x14,
-	0x40, 0xc5, 0x0f, 0x1a, 0x44, 0xda, 0xb8, 0x57, 0x8a, 0x31, 0xd8, 0xae, 0x05, 0x0d, 0x23, 0x1c,
-	0x99, 0xfe, 0x8b, 0x31, 0xe7, 0x63, 0xdf, 0xa9, 0x40, 0xca, 0x9c, 0xe4, 0xdf, 0xfd, 0x87, 0x61,
-	0x20, 0x22, 0x61, 0x70, 0x1d, 0x2f, 0x08, 0x5d, 0xa6, 0x33, 0x16, 0x78, 0x33, 0x96, 0x70, 0xaa,
-	0xf3, 0x42, 0x27, 0xd9, 0x10, 0xca, 0x4c, 0xe9, 0xbc, 0x65, 0x27, 0xd9, 0xc0, 0x0c, 0x82, 0x5e,
-	0x84, 0x91, 0x24, 0x75, 0x14, 0x2e, 0x8e, 0x7c, 0x1f, 0x12, 0xb8, 0x23, 0xe9, 0x83, 0x72, 0x9c,
-	0xc1, 0x46, 0xaf, 0x41, 0xdf, 0x06, 0xf1, 0x5a, 0xe2, 0xd3, 0xaf, 0x14, 0xa7, 0x6b, 0xd8, 0xbb,
-	0x5e, 0x26, 0x5e, 0x8b, 0x4b, 0x42, 0xfa, 0x0b, 0x33, 0x56, 0x74, 0xde, 0xd7, 0x36, 0xdb, 0x71,
-	0x12, 0xb4, 0xdc, 0xd7, 0xa5, 0xa7, 0xf3, 0xfd, 0x05, 0x33, 0xbe, 0x2a, 0xe9, 0x73, 0x97, 0x92,
-	0xfa, 0x8b, 0x35, 0x67, 0xd6, 0x8f, 0x86, 0x1b, 0xb1, 0x29, 0xb3, 0x23, 0x1c, 0x96, 0x45, 0xf7,
-	0x63, 0x56, 0xd2, 0xe7, 0xfd, 0x50, 0x7f, 0xb1, 0xe6, 0x8c, 0x76, 0xd4, 0xfa, 0x1b, 0x64, 0x7d,
-	0xb8, 0x5e, 0x70, 0x1f, 0xf8, 0xda, 0xcb, 0x5d, 0x87, 0x8f, 0x43, 0xa5, 0xbe, 0xe1, 0x44, 0xc9,
-	0xd8, 0x10, 0x9b, 0x34, 0x6a, 0x16, 0xcf, 0xd0, 0x46, 0xcc, 0x61, 0xe8, 0x51, 0x28, 0x47, 0x64,
-	0x9d, 0x45, 0xbf, 0x1a, 0x71, 0x51, 0x98, 0xac, 0x63, 0xda, 0x6e, 0xff, 0x52, 0x29, 0x6d, 0xb6,
-	0xa5, 0xdf, 0x9b, 0xcf, 0xf6, 0x7a, 0x3b, 0x8a, 0xa5, 0xfb, 0xcb, 0x98, 0xed, 0xac, 0x19, 0x4b,
-	0x38, 0xfa, 0x84, 0x05, 0x03, 0xb7, 0xe2, 0xc0, 0xf7, 0x49, 0x22, 0x54, 0xe4, 0x8d, 0x82, 0x87,
-	0xe2, 0x0a, 0xa7, 0xae, 0xfb, 0x20, 0x1a, 0xb0, 0xe4, 0x4b, 0xbb, 0x4b, 0xb6, 0xeb, 0x5e, 0xbb,
-	0xd1, 0x11, 0xea, 0x72, 0x91, 0x37, 0x63, 0x09, 0xa7, 0xa8, 0xae, 0xcf, 0x51, 0xfb, 0xd2, 0xa8,
-	0xf3, 0xbe, 0x40, 0x15, 0x70, 0xfb, 0xaf, 0xf7, 0xc3, 0x99, 0xdc, 0xc5, 0x41, 0x0d, 0x2a, 0x66,
-	0xb2, 0x5c, 0x72, 0x3d, 0x22, 0x83, 0xbc, 0x98, 0x41, 0x75, 0x43, 0xb5, 0x62, 0x03, 0x03, 0xfd,
-	0x14, 0x40, 0xe8, 0x44, 0x4e, 0x8b, 0x28, 0xf7, 0xf4, 0x91, 0xed, 0x16, 0xda, 0x8f, 0x65, 0x49,
-	0x53, 0x6f, 0xd1, 0x55, 0x53, 0x8c, 0x0d, 0x96, 0xe8, 0x39, 0x18, 0x8c, 0x88, 0x47, 0x9c, 0x98,
-	0x05, 0x4f, 0x67, 0x33, 0x41, 0xb0, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xa1, 0xe2, 0xe1, 0x32, 0x71,
-	0x41, 0xe9, 0x98, 0x38, 0xf4, 0x86, 0x05, 0x23, 0xeb, 0xae, 0x47, 0x34, 0x77, 0x91, 0xb7, 0xb1,
-	0x74, 0xf4, 0x97, 0xbc, 0x64, 0xd2, 0xd5, 0x12, 0x32, 0xd5, 0x1c, 0xe3, 0x0c, 0x7b, 0xfa, 0x99,
-	0xb7, 0x48, 0xc4, 0x44, 0x6b, 0x7f, 0xfa, 0x33, 0xdf, 0xe0, 0xcd, 0x58, 0xc2, 0xd1, 0x14, 0x8c,
-	0x86, 0x4e, 0x1c, 0xcf, 0x44, 0xa4, 0x41, 0xfc, 0xc4, 0x75, 0x3c, 0x9e, 0x55, 0x51, 0xd5, 0x51,
-	0xd5, 0xcb, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0x0f, 0xc0, 0xc3, 0xdc, 0xff, 0xb3, 0xe8, 0xc6, 0xb1,
-	0xeb, 0x37, 0xf5, 0x34, 0x10, 0x6e, 0xb0, 0x71, 0x41, 0xea, 0xe1, 0xf9, 0x7c, 0x34, 0xdc, 0xed,
-	0x79, 0xf4, 0x14, 0x54, 0xe3, 0x4d, 0x37, 0x9c, 0x89, 0x1a, 0x31, 0x3b, 0xfb, 0xa9, 0x6a, 0xa7,
-	0xeb, 0x8a, 0x68, 0xc7, 0x0a, 0x03, 0xd5, 0x61, 0x88, 0x7f, 0x12, 0x1e, 0xd0, 0x27, 0xe4, 0xe3,
-	0xd3, 0x5d, 0xd5, 0xb4, 0x48, 0x12, 0x9c, 0xc0, 0xce, 0xed, 0x8b, 0xf2, 0x24, 0x8a, 0x1f, 0x9c,
-	0xdc, 0x30, 0xc8, 0xe0, 0x14, 0x51, 0xfb, 0x17, 0x4a, 0xe9, 0x9d, 0xbf, 0xb9, 0x48, 0x51, 0x4c,
-	0x97, 0x62, 0x72, 0xc3, 0x89, 0xa4, 0xc2, 0x3e, 0x62, 0xf2, 0x87, 0xa0, 0x7b, 0xc3, 0x89, 0xcc,
-	0x45, 0xcd, 0x18, 0x60, 0xc9, 0x09, 0xdd, 0x82, 0xbe, 0xc4, 0x73, 0x0a, 0xca, 0x16, 0x33, 0x38,
-	0x6a, 0x47, 0xcc, 0xc2, 0x54, 0x8c, 0x19, 0x0f, 0xf4, 0x08, 0xdd, 0x7d, 0xac, 0xc9, 0x93, 0x22,
-	0xb1, 0x61, 0x58, 0x8b, 0x31, 0x6b, 0xb5, 0xbf, 0x39, 0x98, 0x23, 0x57, 0x95, 0x22, 0x43, 0x17,
-	0x00, 0xe8, 0x46, 0x76, 0x39, 0x22, 0xeb, 0xee, 0xb6, 0x30, 0x24, 0xd4, 0xda, 0xbd, 0xa6, 0x20,
-	0xd8, 0xc0, 0x92, 0xcf, 0xac, 0xb4, 0xd7, 0xe9, 0x33, 0xa5, 0xce, 0x67, 0x38, 0x04, 0x1b, 0x58,
-	0xe8, 0x59, 0xe8, 0x77, 0x5b, 0x4e, 0x53, 0x05, 0xb2, 0x3e, 0x42, 0x17, 0xed, 0x3c, 0x6b, 0xb9,
-	0xbb, 0x3b, 0x3e, 0xa2, 0x3a, 0xc4, 0x9a, 0xb0, 0xc0, 0x45, 0xbf, 0x62, 0xc1, 0x50, 0x3d, 0x68,
-	0xb5, 0x02, 0x9f, 0x6f, 0xff, 0xc4, 0x5e, 0xf6, 0xd6, 0x71, 0xa9, 0xf9, 0x89, 0x19, 0x83, 0x19,
-	0xdf, 0xcc, 0xaa, 0xb4, 0x36, 0x13, 0x84, 0x53, 0xbd, 0x32, 0xd7, 0x76, 0x65, 0x9f, 0xb5, 0xfd,
-	0xeb, 0x16, 0x9c, 0xe4, 0xcf, 0x1a, 0xbb, 0x52, 0x91, 0xc1, 0x15, 0x1c, 0xf3, 0x6b, 0x75, 0x6c,
-	0xd4, 0x95, 0xb3, 0xb2, 0x03, 0x8e, 0x3b, 0x3b, 0x89, 0xe6, 0xe0, 0xe4, 0x7a, 0x10, 0xd5, 0x89,
-	0x39, 0x10, 0x42, 0x30, 0x29, 0x42, 0x97, 0xb2, 0x08, 0xb8, 0xf3, 0x19, 0x74, 0x03, 0x1e, 0x32,
-	0x1a, 0xcd, 0x71, 0xe0, 0xb2, 0xe9, 0x31, 0x41, 0xed, 0xa1, 0x4b, 0xb9, 0x58, 0xb8, 0xcb, 0xd3,
-	0xd4, 0x88, 0x65, 0x10, 0xe5, 0xa4, 0x11, 0xf2, 0x49, 0x8b, 0xe8, 0x14, 0x14, 0x67, 0xb0, 0xd3,
-	0x8e, 0x1f, 0xe8, 0xc1, 0xf1, 0xf3, 0x2a, 0x9c, 0xad, 0x77, 0x8e, 0xec, 0x56, 0xdc, 0x5e, 0x63,
-	0xe9, 0x4b, 0x94, 0xf7, 0x0f, 0x08, 0x02, 0x67, 0x67, 0xba, 0x21, 0xe2, 0xee, 0x34, 0xd0, 0x47,
-	0xa0, 0x1a, 0x11, 0xf6, 0x55, 0x79, 0x1e, 0xd2, 0x91, 0x77, 0xfb, 0xda, 0x82, 0xe5, 0x64, 0xb5,
-	0xec, 0x16, 0x0d, 0x31, 0x56, 0x1c, 0xd1, 0x6d, 0x18, 0x08, 0x9d, 0xa4, 0xbe, 0x41, 0xe2, 0xb1,
-	0xe1, 0x22, 0x7c, 0xd3, 0x8a, 0x39, 0x3b, 0x4a, 0x30, 0xd2, 0xa6, 0x39, 0x13, 0x2c, 0xb9, 0x51,
-	0x6b, 0xa6, 0x1e, 0xb4, 0xc2, 0xc0, 0x27, 0x7e, 0x12, 0x8f, 0x8d, 0x68, 0x6b, 0x66, 0x46, 0xb5,
-	0x62, 0x03, 0x03, 0x2d, 0xc3, 0x69, 0xe6, 0xfb, 0xba, 0xe9, 0x26, 0x1b, 0x41, 0x3b, 0x91, 0x5b,
-	0xb9, 0xb1, 0xd1, 0xf4, 0x89, 0xcf, 0x42, 0x0e, 0x0e, 0xce, 0x7d, 0xf2, 0xdc, 0xfb, 0xe0, 0x64,
-	0x87, 0x28, 0x38, 0x90, 0xdb, 0x69, 0x16, 0x1e, 0xca, 0x5f, 0x74, 0x07, 0x72, 0x3e, 0xfd, 0xe3,
-	0x4c, 0xf4, 0xb1, 0x61, 0x88, 0xf7, 0xe0, 0xc8, 0x74, 0xa0, 0x4c, 0xfc, 0x2d, 0xa1, 0x83, 0x2e,
-	0x1d, 0xed, 0xdb, 0x5d, 0xf4, 0xb7, 0xb8, 0xcc, 0x60, 0xde, 0x9a, 0x8b, 0xfe, 0x16, 0xa6, 0xb4,
-	0xd1, 0x97, 0xac, 0x94, 0x21, 0xc9, 0xdd, 0x9f, 0x1f, 0x3a, 0x96, 0x9d, 0x47, 0xcf, 0xb6, 0xa5,
-	0xfd, 0xbb, 0x25, 0x38, 0xbf, 0x1f, 0x91, 0x1e, 0x86, 0xef, 0x71, 0xe8, 0x8f, 0x59, 0x3c, 0x81,
-	0x10, 0xea, 0x83, 0x74, 0xae, 0xf2, 0x08, 0x83, 0x57, 0xb1, 0x00, 0x21, 0x0f, 0xca, 0x2d, 0x27,
-	0x14, 0x5e, 0xb1, 0xf9, 0xa3, 0xa6, 0x33, 0xd1, 0xff, 0x8e, 0xb7, 0xe8, 0x84, 0xdc, 0xd7, 0x62,
-	0x34, 0x60, 0xca, 0x06, 0x25, 0x50, 0x71, 0xa2, 0xc8, 0x91, 0x87, 0xd7, 0x57, 0x8b, 0xe1, 0x37,
-	0x45, 0x49, 0xf2, 0xb3, 0xbf, 0x54, 0x13, 0xe6, 0xcc, 0xec, 0xcf, 0x0d, 0xa4, 0x52, 0x7a, 0x58,
-	0x44, 0x42, 0x0c, 0xfd, 0xc2, 0x19, 0x66, 0x15, 0x9d, 0x45, 0xc6, 0x73, 0x32, 0xd9, 0x3e, 0x53,
-	0x64, 0xb6, 0x0b, 0x56, 0xe8, 0xb3, 0x16, 0xcb, 0x1f, 0x97, 0x69, 0x4e, 0x62, 0x77, 0x77, 0x3c,
-	0xe9, 0xec, 0x66, 0x56, 0xba, 0x6c, 0xc4, 0x26, 0x77, 0x51, 0x07, 0x82, 0x59, 0xb5, 0x9d, 0x75,
-	0x20, 0x98, 0x95, 0x2a, 0xe1, 0x68, 0x3b, 0x27, 0xf2, 0xa0, 0x80, 0x1c, 0xe4, 0x1e, 0x62, 0x0d,
-	0xbe, 0x66, 0xc1, 0x49, 0x37, 0x7b, 0x84, 0x2c, 0xf6, 0x42, 0x37, 0x8b, 0xf1, 0x5c, 0x75, 0x9e,
-	0x50, 0x2b, 0x73, 0xa0, 0x03, 0x84, 0x3b, 0x3b, 0x83, 0x1a, 0xd0, 0xe7, 0xfa, 0xeb, 0x81, 0x30,
-	0x82, 0xa6, 0x8f, 0xd6, 0xa9, 0x79, 0x7f, 0x3d, 0xd0, 0xab, 0x99, 0xfe, 0xc3, 0x8c, 0x3a, 0x5a,
-	0x80, 0xd3, 0x32, 0xab, 0xe3, 0xb2, 0x1b, 0x27, 0x41, 0xb4, 0xb3, 0xe0, 0xb6, 0xdc, 0x84, 0x19,
-	0x30, 0xe5, 0xe9, 0x31, 0xaa, 0x1f, 0x70, 0x0e, 0x1c, 0xe7, 0x3e, 0x85, 0x5e, 0x87, 0x01, 0x79,
-	0x6c, 0x5b, 0x2d, 0x62, 0x5f, 0xd9, 0x39, 0xff, 0xd5, 0x64, 0x5a, 0x11, 0xe7, 0xb6, 0x92, 0xa1,
-	0xfd, 0xc6, 0x20, 0x74, 0x9e, 0x2e, 0xa7, 0x8f, 0x92, 0xad, 0x7b, 0x7d, 0x94, 0x4c, 0x37, 0x3c,
-	0xb1, 0x3e, 0x05, 0x2e, 0x60, 0x6e, 0x0b, 0xae, 0xfa, 0x84, 0x6f, 0xc7, 0xaf, 0x63, 0xc6, 0x03,
-	0x45, 0xd0, 0xbf, 0x41, 0x1c, 0x2f, 0xd9, 0x28, 0xe6, 0x30, 0xe2, 0x32, 0xa3, 0x95, 0x4d, 0xc5,
-	0xe2, 0xad, 0x58, 0x70, 0x42, 0xdb, 0x30, 0xb0, 0xc1, 0x27, 0x80, 0xd8, 0x83, 0x2c, 0x1e, 0x75,
-	0x70, 0x53, 0xb3, 0x4a, 0x7f, 0x6e, 0xd1, 0x80, 0x25, 0x3b, 0x16, 0xb6, 0x64, 0x04, 0x56, 0xf0,
-	0xa5, 0x5b, 0x5c, 0x16, 0x5a, 0xef, 0x51, 0x15, 0x1f, 0x86, 0xa1, 0x88, 0xd4, 0x03, 0xbf, 0xee,
-	0x7a, 0xa4, 0x31, 0x25, 0x0f, 0x1a, 0x0e, 0x92, 0x7c, 0xc4, 0xf6, 0xf1, 0xd8, 0xa0, 0x81, 0x53,
-	0x14, 0xd1, 0x67, 0x2c, 0x18, 0x51, 0x99, 0xbb, 0xf4, 0x83, 0x10, 0xe1, 0x50, 0x5e, 0x28, 0x28,
-	0x4f, 0x98, 0xd1, 0x9c, 0x46, 0x74, 0x2f, 0x90, 0x6e, 0xc3, 0x19, 0xbe, 0xe8, 0x65, 0x80, 0x60,
-	0x8d, 0xc7, 0x26, 0x4d, 0x25, 0xc2, 0xbb, 0x7c, 0x90, 0x57, 0x1d, 0xe1, 0x49, 0x8c, 0x92, 0x02,
-	0x36, 0xa8, 0xa1, 0xab, 0x00, 0x7c, 0xd9, 0xac, 0xee, 0x84, 0x7c, 0x8f, 0xa2, 0xb3, 0xc7, 0x60,
-	0x45, 0x41, 0xee, 0xee, 0x8e, 0x77, 0x7a, 0xfb, 0x58, 0x00, 0x86, 0xf1, 0x38, 0xfa, 0x49, 0x18,
-	0x88, 0xdb, 0xad, 0x96, 0xa3, 0x7c, 0xcf, 0x05, 0xa6, 0x45, 0x72, 0xba, 0x86, 0x28, 0xe2, 0x0d,
-	0x58, 0x72, 0x44, 0xb7, 0xa8, 0x50, 0x8d, 0x85, 0x1b, 0x92, 0xad, 0x22, 0x6e, 0x13, 0x0c, 0xb2,
-	0x77, 0x7a, 0xb7, 0x34, 0xbc, 0x71, 0x0e, 0xce, 0xdd, 0xdd, 0xf1, 0x87, 0xd2, 0xed, 0x0b, 0x81,
-	0x48, 0x54, 0xcc, 0xa5, 0x89, 0xae, 0xc8, 0xfa, 0x37, 0xf4, 0xb5, 0x65, 0x59, 0x86, 0x27, 0x75,
-	0xfd, 0x1b, 0xd6, 0xdc, 0x7d, 0xcc, 0xcc, 0x87, 0xd1, 0x22, 0x9c, 0xaa, 0x07, 0x7e, 0x12, 0x05,
-	0x9e, 0xc7, 0xeb, 0x3f, 0xf1, 0x3d, 0x1f, 0xf7, 0x4d, 0xbf, 0x5d, 0x74, 0xfb, 0xd4, 0x4c, 0x27,
-	0x0a, 0xce, 0x7b, 0xce, 0xf6, 0xd3, 0xe7, 0x44, 0x62, 0x70, 0x9e, 0x85, 0x21, 0xb2, 0x9d, 0x90,
-	0xc8, 0x77, 0xbc, 0xeb, 0x78, 0x41, 0x7a, 0x65, 0xd9, 0x1a, 0xb8, 0x68, 0xb4, 0xe3, 0x14, 0x16,
-	0xb2, 0x95, 0xa3, 0xc4, 0x48, 0xbe, 0xe5, 0x8e, 0x12, 0xe9, 0x16, 0xb1, 0xff, 0x77, 0x29, 0x65,
-	0x90, 0xdd, 0x97, 0x53, 0x29, 0x56, 0x45, 0x44, 0x96, 0x5b, 0x61, 0x00, 0xb1, 0xd1, 0x28, 0x92,
-	0xb3, 0xaa, 0x22, 0xb2, 0x64, 0x32, 0xc2, 0x69, 0xbe, 0x68, 0x13, 0x2a, 0x1b, 0x41, 0x9c, 0xc8,
-	0xed, 0xc7, 0x11, 0x77, 0x3a, 0x97, 0x83, 0x38, 0x61, 0x56, 0x84, 0x7a, 0x6d, 0xda, 0x12, 0x63,
-	0xce, 0xc3, 0xfe, 0x2f, 0x56, 0xca, 0x07, 0x7f, 0x93, 0x05, 0x30, 0x6f, 0x11, 0x9f, 0x2e, 0x6b,
-	0x33, 0x64, 0xea, 0x47, 0x33, 0xe9, 0xa0, 0xef, 0xe8, 0x56, 0xde, 0xec, 0x36, 0xa5, 0x30, 0xc1,
-	0x48, 0x18, 0xd1, 0x55, 0x1f, 0xb7, 0xd2, 0x79, 0xbd, 0xa5, 0x22, 0x36, 0x18, 0x66, 0x6e, 0xfb,
-	0xbe, 0x29, 0xc2, 0xf6, 0x97, 0x2c, 0x18, 0x98, 0x76, 0xea, 0x9b, 0xc1, 0xfa, 0x3a, 0x7a, 0x0a,
-	0xaa, 0x8d, 0x76, 0x64, 0xa6, 0x18, 0x2b, 0xc7, 0xc1, 0xac, 0x68, 0xc7, 0x0a, 0x83, 0xce, 0xe1,
-	0x75, 0xa7, 0x2e, 0x33, 0xdc, 0xcb, 0x7c, 0x0e, 0x5f, 0x62, 0x2d, 0x58, 0x40, 0xd0, 0x73, 0x30,
-	0xd8, 0x72, 0xb6, 0xe5, 0xc3, 0xd9, 0x03, 0x80, 0x45, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x2f, 0x2c,
-	0x18, 0x9b, 0x76, 0x62, 0xb7, 0x3e, 0xd5, 0x4e, 0x36, 0xa6, 0xdd, 0x64, 0xad, 0x5d, 0xdf, 0x24,
-	0x09, 0x2f, 0x6b, 0x40, 0x7b, 0xd9, 0x8e, 0xe9, 0x52, 0x52, 0xfb, 0x3a, 0xd5, 0xcb, 0xeb, 0xa2,
-	0x1d, 0x2b, 0x0c, 0xf4, 0x3a, 0x0c, 0x86, 0x4e, 0x1c, 0xdf, 0x0e, 0xa2, 0x06, 0x26, 0xeb, 0xc5,
-	0x14, 0x15, 0x59, 0x21, 0xf5, 0x88, 0x24, 0x98, 0xac, 0x8b, 0xc3, 0x72, 0x4d, 0x1f, 0x9b, 0xcc,
-	0xec, 0x2f, 0x58, 0x70, 0x76, 0x9a, 0x38, 0x11, 0x89, 0x58, 0x0d, 0x12, 0xf5, 0x22, 0x33, 0x5e,
-	0xd0, 0x6e, 0xa0, 0xd7, 0xa0, 0x9a, 0xd0, 0x66, 0xda, 0x2d, 0xab, 0xd8, 0x6e, 0xb1, 0xb3, 0xee,
-	0x55, 0x41, 0x1c, 0x2b, 0x36, 0xf6, 0xdf, 0xb0, 0x60, 0x88, 0x1d, 0xd7, 0xcd, 0x92, 0xc4, 0x71,
-	0xbd, 0x8e, 0x52, 0x5d, 0x56, 0x8f, 0xa5, 0xba, 0xce, 0x43, 0xdf, 0x46, 0xd0, 0x22, 0xd9, 0xa3,
-	0xe6, 0xcb, 0x01, 0xdd, 0x56, 0x53, 0x08, 0x7a, 0x86, 0x7e, 0x78, 0xd7, 0x4f, 0x1c, 0xba, 0x04,
-	0xa4, 0x3b, 0x78, 0x94, 0x7f, 0x74, 0xd5, 0x8c, 0x4d, 0x1c, 0xfb, 0x9f, 0xd7, 0x60, 0x40, 0xc4,
-	0x45, 0xf4, 0x5c, 0xda, 0x42, 0xee, 0xef, 0x4b, 0x5d, 0xf7, 0xf7, 0x31, 0xf4, 0xd7, 0x59, 0xcd,
-	0x40, 0x61, 0x46, 0x5e, 0x2d, 0x24, 0x90, 0x86, 0x97, 0x21, 0xd4, 0xdd, 0xe2, 0xff, 0xb1, 0x60,
-	0x85, 0xbe, 0x68, 0xc1, 0x68, 0x3d, 0xf0, 0x7d, 0x52, 0xd7, 0x36, 0x4e, 0x5f, 0x11, 0xf1, 0x12,
-	0x33, 0x69, 0xa2, 0xfa, 0xac, 0x28, 0x03, 0xc0, 0x59, 0xf6, 0xe8, 0x05, 0x18, 0xe6, 0x63, 0x76,
-	0x23, 0xe5, 0xc3, 0xd6, 0x15, 0x9c, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x09, 0x7e, 0x16, 0x20, 0x6a,
-	0x25, 0xf5, 0x6b, 0x57, 0x9d, 0x51, 0x25, 0xc9, 0xc0, 0x40, 0x11, 0xa0, 0x88, 0xac, 0x47, 0x24,
-	0xde, 0x10, 0x71, 0x23, 0xcc, 0xbe, 0x1a, 0x38, 0x5c, 0x1e, 0x3b, 0xee, 0xa0, 0x84, 0x73, 0xa8,
-	0xa3, 0x4d, 0xb1, 0xc1, 0xac, 0x16, 0x21, 0x43, 0xc5, 0x67, 0xee, 0xba, 0xcf, 0x1c, 0x87, 0x4a,
-	0xbc, 0xe1, 0x44, 0x0d, 0x66, 0xd7, 0x95, 0x79, 0xee, 0xd4, 0x0a, 0x6d, 0xc0, 0xbc, 0x1d, 0xcd,
-	0xc2, 0x89, 0x4c, 0xfd, 0xa9, 0x98, 0x59, 0x6e, 0x55, 0x9d, 0x27, 0x93, 0xa9, 0x5c, 0x15, 0xe3,
-	0x8e, 0x27, 0x4c, 0xe7, 0xc3, 0xe0, 0x3e, 0xce, 0x87, 0x1d, 0x15, 0x9d, 0xc8, 0x5d, 0xc8, 0x2f,
-	0x15, 0x32, 0x00, 0x3d, 0x85, 0x22, 0x7e, 0x3e, 0x13, 0x8a, 0xc8, 0xdd, 0xc8, 0x37, 0x8a, 0xe9,
-	0xc0, 0xc1, 0xe3, 0x0e, 0xef, 0x67, 0x1c, 0xe1, 0x5f, 0x58, 0x20, 0xbf, 0xeb, 0x8c, 0x53, 0xdf,
-	0x20, 0x74, 0xca, 0xa0, 0x17, 0x61, 0x44, 0x6d, 0xa1, 0x67, 0x82, 0xb6, 0xcf, 0x43, 0x08, 0xcb,
-	0xfa, 0xc4, 0x02, 0xa7, 0xa0, 0x38, 0x83, 0x8d, 0x26, 0xa1, 0x46, 0xc7, 0x89, 0x3f, 0xca, 0x75,
-	0xad, 0xda, 0xa6, 0x4f, 0x2d, 0xcf, 0x8b, 0xa7, 0x34, 0x0e, 0x0a, 0xe0, 0xa4, 0xe7, 0xc4, 0x09,
-	0xeb, 0x01, 0xdd, 0x51, 0x1f, 0xb2, 0x8a, 0x04, 0x4b, 0xc6, 0x58, 0xc8, 0x12, 0xc2, 0x9d, 0xb4,
-	0xed, 0x7f, 0x5b, 0x81, 0xe1, 0x94, 0x64, 0x3c, 0xa0, 0x92, 0x7e, 0x0a, 0xaa, 0x52, 0x6f, 0x66,
-	0xcb, 0xe5, 0x28, 0xe5, 0xaa, 0x30, 0xa8, 0xd2, 0x5a, 0xd3, 0x5a, 0x35, 0x6b, 0x54, 0x18, 0x0a,
-	0x17, 0x9b, 0x78, 0x4c, 0x28, 0x27, 0x5e, 0x3c, 0xe3, 0xb9, 0xc4, 0x4f, 0x78, 0x37, 0x8b, 0x11,
-	0xca, 0xab, 0x0b, 0x2b, 0x26, 0x51, 0x2d, 0x94, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x3e, 0x65, 0xc1,
-	0xb0, 0x73, 0x3b, 0xd6, 0x85, 0x6d, 0x45, 0xd0, 0xe1, 0x11, 0x95, 0x54, 0xaa, 0x56, 0x2e, 0x77,
-	0xf9, 0xa6, 0x9a, 0x70, 0x9a, 0x29, 0x7a, 0xd3, 0x02, 0x44, 0xb6, 0x49, 0x5d, 0x86, 0x45, 0x8a,
-	0xbe, 0xf4, 0x17, 0xb1, 0xd3, 0xbc, 0xd8, 0x41, 0x97, 0x4b, 0xf5, 0xce, 0x76, 0x9c, 0xd3, 0x07,
-	0x74, 0x05, 0x50, 0xc3, 0x8d, 0x9d, 0x35, 0x8f, 0xcc, 0x04, 0x2d, 0x99, 0x40, 0x28, 0xce, 0x23,
-	0xcf, 0x89, 0x71, 0x46, 0xb3, 0x1d, 0x18, 0x38, 0xe7, 0x29, 0x36, 0xcb, 0xa2, 0x60, 0x7b, 0xe7,
-	0x7a, 0xe4, 0x31, 0x2d, 0x61, 0xce, 0x32, 0xd1, 0x8e, 0x15, 0x86, 0xfd, 0xcd, 0xb2, 0x5a, 0xca,
-	0x3a, 0x06, 0xd8, 0x31, 0x62, 0x11, 0xad, 0xc3, 0xc7, 0x22, 0xea, 0x58, 0x8a, 0xce, 0xb4, 0xd8,
-	0x54, 0x16, 0x5d, 0xe9, 0x3e, 0x65, 0xd1, 0xfd, 0xb4, 0x95, 0x2a, 0x49, 0x35, 0x78, 0xe1, 0xe5,
-	0x62, 0xe3, 0x8f, 0x27, 0x78, 0x9c, 0x47, 0x46, 0xaf, 0xa4, 0xc3, 0x7b, 0xa8, 0x1c, 0x37, 0xd0,
-	0x0e, 0x24, 0x87, 0xff, 0x7d, 0x19, 0x06, 0x0d, 0x1d, 0x9e, 0x6b, 0x90, 0x59, 0x0f, 0x98, 0x41,
-	0x56, 0x3a, 0x80, 0x41, 0xf6, 0x53, 0x50, 0xab, 0x4b, 0xfd, 0x52, 0x4c, 0x51, 0xe6, 0xac, 0xd6,
-	0xd2, 0x2a, 0x46, 0x35, 0x61, 0xcd, 0x13, 0xcd, 0xa5, 0x72, 0xaf, 0x84, 0x6e, 0xea, 0x63, 0xba,
-	0x29, 0x2f, 0x39, 0x4a, 0xe8, 0xa8, 0xce, 0x67, 0x58, 0xe5, 0xb2, 0xd0, 0x15, 0xef, 0x25, 0xb3,
-	0x04, 0x78, 0xe5, 0xb2, 0xe5, 0x79, 0xd9, 0x8c, 0x4d, 0x1c, 0xfb, 0xdb, 0x96, 0xfa, 0xb8, 0xf7,
-	0xa0, 0xc8, 0xc6, 0xad, 0x74, 0x91, 0x8d, 0x8b, 0x85, 0x0c, 0x73, 0x97, 0xea, 0x1a, 0xd7, 0x60,
-	0x60, 0x26, 0x68, 0xb5, 0x1c, 0xbf, 0x81, 0x7e, 0x08, 0x06, 0xea, 0xfc, 0xa7, 0x70, 0x29, 0xb1,
-	0x83, 0x49, 0x01, 0xc5, 0x12, 0x86, 0x1e, 0x81, 0x3e, 0x27, 0x6a, 0x4a, 0x37, 0x12, 0x0b, 0x0b,
-	0x9a, 0x8a, 0x9a, 0x31, 0x66, 0xad, 0xf6, 0x3f, 0xea, 0x03, 0x76, 0x9a, 0xee, 0x44, 0xa4, 0xb1,
-	0x1a, 0xb0, 0xa2, 0x90, 0xc7, 0x7a, 0x9c, 0xa7, 0xb7, 0x69, 0x0f, 0xf2, 0x91, 0x9e, 0x71, 0xac,
-	0x53, 0xbe, 0xc7, 0xc7, 0x3a, 0x5d, 0x4e, 0xea, 0xfa, 0x1e, 0xa0, 0x93, 0x3a, 0xfb, 0x73, 0x16,
-	0x20, 0x15, 0x82, 0xa1, 0x8f, 0xd2, 0x27, 0xa1, 0xa6, 0x82, 0x31, 0x84, 0x49, 0xa7, 0x45, 0x84,
-	0x04, 0x60, 0x8d, 0xd3, 0xc3, 0xde, 0xfc, 0x71, 0x29, 0xbf, 0xcb, 0xe9, 0x88, 0x62, 0x26, 0xf5,
-	0x85, 0x38, 0xb7, 0x7f, 0xab, 0x04, 0x0f, 0x71, 0x63, 0x60, 0xd1, 0xf1, 0x9d, 0x26, 0x69, 0xd1,
-	0x5e, 0xf5, 0x1a, 0x1c, 0x51, 0xa7, 0x9b, 0x42, 0x57, 0x46, 0x08, 0x1f, 0x75, 0xed, 0xf2, 0x35,
-	0xc7, 0x57, 0xd9, 0xbc, 0xef, 0x26, 0x98, 0x11, 0x47, 0x31, 0x54, 0xe5, 0x8d, 0x05, 0x42, 0x16,
-	0x17, 0xc4, 0x48, 0x89, 0x25, 0xa1, 0x37, 0x09, 0x56, 0x8c, 0xa8, 0x31, 0xe3, 0x05, 0xf5, 0x4d,
-	0x4c, 0xc2, 0x80, 0xc9, 0x5d, 0x23, 0x40, 0x73, 0x41, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x96, 0x05,
-	0x59, 0x8d, 0x64, 0x54, 0xdf, 0xb3, 0xf6, 0xac, 0xbe, 0x77, 0x80, 0xfa, 0x75, 0x3f, 0x01, 0x83,
-	0x4e, 0x42, 0x8d, 0x08, 0xbe, 0xe1, 0x2f, 0x1f, 0xee, 0x40, 0x65, 0x31, 0x68, 0xb8, 0xeb, 0x2e,
-	0xdb, 0xe8, 0x9b, 0xe4, 0xec, 0xff, 0xd1, 0x07, 0x27, 0x3b, 0xf2, 0x69, 0xd0, 0xf3, 0x30, 0x54,
-	0x17, 0xd3, 0x23, 0x94, 0xae, 0xb4, 0x9a, 0x19, 0xd0, 0xa7, 0x61, 0x38, 0x85, 0xd9, 0xc3, 0x04,
-	0x9d, 0x87, 0x53, 0x11, 0x79, 0xad, 0x4d, 0xda, 0x64, 0x6a, 0x3d, 0x21, 0xd1, 0x0a, 0xa9, 0x07,
-	0x7e, 0x83, 0xd7, 0x88, 0x2c, 0x4f, 0x3f, 0x7c, 0x67, 0x77, 0xfc, 0x14, 0xee, 0x04, 0xe3, 0xbc,
-	0x67, 0x50, 0x08, 0xc3, 0x9e, 0x69, 0x03, 0x8a, 0xad, 0xc7, 0xa1, 0xcc, 0x47, 0x65, 0x23, 0xa4,
-	0x9a, 0x71, 0x9a, 0x41, 0xda, 0x90, 0xac, 0xdc, 0x27, 0x43, 0xf2, 0x93, 0xda, 0x90, 0xe4, 0x27,
-	0xff, 0x1f, 0x2c, 0x38, 0x9f, 0xea, 0xb8, 0x2d, 0xc9, 0x97, 0xa0, 0x2a, 0xa3, 0xa2, 0x7a, 0x8a,
-	0x26, 0x32, 0xe9, 0x74, 0x91, 0x68, 0x4f, 0xc0, 0x0f, 0x5e, 0x8c, 0x22, 0x63, 0x30, 0xaf, 0x05,
-	0xc9, 0x94, 0xe7, 0x05, 0xb7, 0xa9, 0x92, 0xbe, 0x1e, 0x13, 0xe1, 0xdb, 0xb1, 0xef, 0x96, 0x20,
-	0x67, 0x9b, 0x44, 0xd7, 0xa3, 0xb6, 0x0c, 0x52, 0xeb, 0xf1, 0x60, 0xd6, 0x01, 0xda, 0xe6, 0x91,
-	0x63, 0x5c, 0x07, 0x7e, 0xa0, 0xe8, 0x6d, 0x9e, 0x0e, 0x26, 0x53, 0x69, 0x20, 0x2a, 0xa0, 0xec,
-	0x02, 0x80, 0x36, 0xe8, 0x44, 0x90, 0xbf, 0x3a, 0x98, 0xd6, 0x76, 0x1f, 0x36, 0xb0, 0xe8, 0xae,
-	0xdf, 0xf5, 0xe3, 0xc4, 0xf1, 0xbc, 0xcb, 0xae, 0x9f, 0x08, 0xf7, 0xa5, 0x52, 0xf6, 0xf3, 0x1a,
-	0x84, 0x4d, 0xbc, 0x73, 0xef, 0x36, 0xbe, 0xdf, 0x41, 0xbe, 0xfb, 0x06, 0x9c, 0x9d, 0x73, 0x13,
-	0x95, 0x9a, 0xa2, 0xe6, 0x1b, 0xb5, 0xd7, 0x54, 0xaa, 0x95, 0xd5, 0x35, 0xd5, 0xca, 0x48, 0x0d,
-	0x29, 0xa5, 0x33, 0x59, 0xb2, 0xa9, 0x21, 0xf6, 0xf3, 0x70, 0x7a, 0xce, 0x4d, 0x2e, 0xb9, 0x1e,
-	0x39, 0x20, 0x13, 0xfb, 0x37, 0xfb, 0x61, 0xc8, 0x4c, 0xb2, 0x3c, 0x48, 0xb6, 0xd8, 0x17, 0xa8,
-	0x49, 0x26, 0xde, 0xce, 0x55, 0xc7, 0x7a, 0x37, 0x8f, 0x9c, 0xf1, 0x99, 0x3f, 0x62, 0x86, 0x55,
-	0xa6, 0x79, 0x62, 0xb3, 0x03, 0xe8, 0x36, 0x54, 0xd6, 0x59, 0xea, 0x42, 0xb9, 0x88, 0xd8, 0x87,
-	0xbc, 0x11, 0xd5, 0xcb, 0x91, 0x27, 0x3f, 0x70, 0x7e, 0x54, 0x93, 0x46, 0xe9, 0x7c, 0x38, 0x23,
-	0x5c, 0x56, 0x64, 0xc2, 0x29, 0x8c, 0x6e, 0x2a, 0xa1, 0x72, 0x08, 0x95, 0x90, 0x12, 0xd0, 0xfd,
-	0xf7, 0x49, 0x40, 0xb3, 0x34, 0x94, 0x64, 0x83, 0xd9, 0x79, 0x22, 0x3f, 0x60, 0x80, 0x0d, 0x82,
-	0x91, 0x86, 0x92, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x98, 0x12, 0xf1, 0xd5, 0x22, 0x3c, 0xbf, 0xe6,
-	0x8c, 0x3e, 0x6e, 0xe9, 0xfe, 0xb9, 0x12, 0x8c, 0xcc, 0xf9, 0xed, 0xe5, 0xb9, 0xe5, 0xf6, 0x9a,
-	0xe7, 0xd6, 0xaf, 0x92, 0x1d, 0x2a, 0xc2, 0x37, 0xc9, 0xce, 0xfc, 0xac, 0x58, 0x41, 0x6a, 0xce,
-	0x5c, 0xa5, 0x8d, 0x98, 0xc3, 0xa8, 0x30, 0x5a, 0x77, 0xfd, 0x26, 0x89, 0xc2, 0xc8, 0x15, 0x4e,
-	0x59, 0x43, 0x18, 0x5d, 0xd2, 0x20, 0x6c, 0xe2, 0x51, 0xda, 0xc1, 0x6d, 0x9f, 0x44, 0x59, 0x83,
-	0x77, 0x89, 0x36, 0x62, 0x0e, 0xa3, 0x48, 0x49, 0xd4, 0x8e, 0x13, 0x31, 0x19, 0x15, 0xd2, 0x2a,
-	0x6d, 0xc4, 0x1c, 0x46, 0x57, 0x7a, 0xdc, 0x5e, 0x63, 0xa1, 0x25, 0x99, 0x64, 0x84, 0x15, 0xde,
-	0x8c, 0x25, 0x9c, 0xa2, 0x6e, 0x92, 0x9d, 0x59, 0xba, 0x3b, 0xce, 0xe4, 0x24, 0x5d, 0xe5, 0xcd,
-	0x58, 0xc2, 0x59, 0x15, 0xcb, 0xf4, 0x70, 0x7c, 0xcf, 0x55, 0xb1, 0x4c, 0x77, 0xbf, 0xcb, 0x3e,
-	0xfb, 0x97, 0x2d, 0x18, 0x32, 0x03, 0xc2, 0x50, 0x33, 0x63, 0x0b, 0x2f, 0x75, 0x14, 0x41, 0x7e,
-	0x6f, 0xde, 0x05, 0x74, 0x4d, 0x37, 0x09, 0xc2, 0xf8, 0x69, 0xe2, 0x37, 0x5d, 0x9f, 0xb0, 0x73,
-	0x7e, 0x1e, 0x48, 0x96, 0x8a, 0x36, 0x9b, 0x09, 0x1a, 0xe4, 0x10, 0xc6, 0xb4, 0x7d, 0x13, 0x4e,
-	0x76, 0x24, 0xa2, 0xf5, 0x60, 0x82, 0xec, 0x9b, 0x06, 0x6c, 0x63, 0x18, 0xa4, 0x84, 0x65, 0x25,
-	0xa5, 0x19, 0x38, 0xc9, 0x17, 0x12, 0xe5, 0xb4, 0x52, 0xdf, 0x20, 0x2d, 0x95, 0x5c, 0xc8, 0x4e,
-	0x00, 0x6e, 0x64, 0x81, 0xb8, 0x13, 0xdf, 0xfe, 0xbc, 0x05, 0xc3, 0xa9, 0xdc, 0xc0, 0x82, 0x8c,
-	0x25, 0xb6, 0xd2, 0x02, 0x16, 0x9f, 0xc8, 0x82, 0xb4, 0xcb, 0x4c, 0x99, 0xea, 0x95, 0xa6, 0x41,
-	0xd8, 0xc4, 0xb3, 0xbf, 0x54, 0x82, 0xaa, 0x8c, 0xf1, 0xe8, 0xa1, 0x2b, 0x9f, 0xb5, 0x60, 0x58,
-	0x9d, 0xba, 0x30, 0xa7, 0x5a, 0xa9, 0x88, 0x44, 0x0c, 0xda, 0x03, 0xb5, 0x2d, 0xf7, 0xd7, 0x03,
-	0x6d, 0xb9, 0x63, 0x93, 0x19, 0x4e, 0xf3, 0x46, 0x37, 0x00, 0xe2, 0x9d, 0x38, 0x21, 0x2d, 0xc3,
-	0xbd, 0x67, 0x1b, 0x2b, 0x6e, 0xa2, 0x1e, 0x44, 0x84, 0xae, 0xaf, 0x6b, 0x41, 0x83, 0xac, 0x28,
-	0x4c, 0x6d, 0x42, 0xe9, 0x36, 0x6c, 0x50, 0xb2, 0xff, 0x41, 0x09, 0x4e, 0x64, 0xbb, 0x84, 0x3e,
-	0x08, 0x43, 0x92, 0xbb, 0x71, 0x97, 0x9e, 0x0c, 0x6c, 0x19, 0xc2, 0x06, 0xec, 0xee, 0xee, 0xf8,
-	0x78, 0xe7, 0x65, 0x86, 0x13, 0x26, 0x0a, 0x4e, 0x11, 0xe3, 0x47, 0x5f, 0xe2, 0x8c, 0x76, 0x7a,
-	0x67, 0x2a, 0x0c, 0xc5, 0xf9, 0x95, 0x71, 0xf4, 0x65, 0x42, 0x71, 0x06, 0x1b, 0x2d, 0xc3, 0x69,
-	0xa3, 0xe5, 0x1a, 0x71, 0x9b, 0x1b, 0x6b, 0x41, 0x24, 0x77, 0x60, 0x8f, 0xe8, 0xd0, 0xb3, 0x4e,
-	0x1c, 0x9c, 0xfb, 0x24, 0xd5, 0xf6, 0x75, 0x27, 0x74, 0xea, 0x6e, 0xb2, 0x23, 0xfc, 0x95, 0x4a,
-	0x36, 0xcd, 0x88, 0x76, 0xac, 0x30, 0xec, 0x45, 0xe8, 0xeb, 0x71, 0x06, 0xf5, 0x64, 0xf9, 0xbf,
-	0x04, 0x55, 0x4a, 0x4e, 0x9a, 0x77, 0x45, 0x90, 0x0c, 0xa0, 0x2a, 0xef, 0xb8, 0x41, 0x36, 0x94,
-	0x5d, 0x47, 0x9e, 0x2e, 0xaa, 0xd7, 0x9a, 0x8f, 0xe3, 0x36, 0xdb, 0x4c, 0x53, 0x20, 0x7a, 0x1c,
-	0xca, 0x64, 0x3b, 0xcc, 0x1e, 0x23, 0x5e, 0xdc, 0x0e, 0xdd, 0x88, 0xc4, 0x14, 0x89, 0x6c, 0x87,
-	0xe8, 0x1c, 0x94, 0xdc, 0x86, 0x50, 0x52, 0x20, 0x70, 0x4a, 0xf3, 0xb3, 0xb8, 0xe4, 0x36, 0xec,
-	0x6d, 0xa8, 0xa9, 0x4b, 0x75, 0xd0, 0xa6, 0x94, 0xdd, 0x56, 0x11, 0x41, 0x59, 0x92, 0x6e, 0x17,
-	0xa9, 0xdd, 0x06, 0xd0, 0x49, 0x92, 0x45, 0xc9, 0x97, 0xf3, 0xd0, 0x57, 0x0f, 0x44, 0x02, 0x77,
-	0x55, 0x93, 0x61, 0x42, 0x9b, 0x41, 0xec, 0x9b, 0x30, 0x72, 0xd5, 0x0f, 0x6e, 0xb3, 0x92, 0xfe,
-	0xac, 0x82, 0x1d, 0x25, 0xbc, 0x4e, 0x7f, 0x64, 0x4d, 0x04, 0x06, 0xc5, 0x1c, 0xa6, 0x6a, 0x6b,
-	0x95, 0xba, 0xd5, 0xd6, 0xb2, 0x3f, 0x6e, 0xc1, 0x90, 0xca, 0x96, 0x9a, 0xdb, 0xda, 0xa4, 0x74,
-	0x9b, 0x51, 0xd0, 0x0e, 0xb3, 0x74, 0xd9, 0xb5, 0x57, 0x98, 0xc3, 0xcc, 0x34, 0xc4, 0xd2, 0x3e,
-	0x69, 0x88, 0xe7, 0xa1, 0x6f, 0xd3, 0xf5, 0x1b, 0xd9, 0x7b, 0x5c, 0xae, 0xba, 0x7e, 0x03, 0x33,
-	0x88, 0xfd, 0x4d, 0x0b, 0x4e, 0xa8, 0x2e, 0x48, 0x85, 0xf0, 0x3c, 0x0c, 0xad, 0xb5, 0x5d, 0xaf,
-	0x21, 0x4b, 0xf3, 0x65, 0x3c, 0x2a, 0xd3, 0x06, 0x0c, 0xa7, 0x30, 0xe9, 0xbe, 0x6e, 0xcd, 0xf5,
-	0x9d, 0x68, 0x67, 0x59, 0x6b, 0x20, 0x25, 0x94, 0xa6, 0x15, 0x04, 0x1b, 0x58, 0x94, 0x5b, 0x4c,
-	0x12, 0x1d, 0x9e, 0xc9, 0x3f, 0x84, 0xe2, 0xb6, 0x62, 0xc0, 0x70, 0x0a, 0xd3, 0x7e, 0xa3, 0x0c,
-	0x23, 0xe9, 0x6c, 0xb3, 0x1e, 0x36, 0x66, 0x8f, 0x43, 0x85, 0x25, 0xa0, 0x65, 0x27, 0x05, 0xaf,
-	0x83, 0xc7, 0x61, 0x28, 0x86, 0x7e, 0x5e, 0xfa, 0xa2, 0x98, 0xdb, 0x93, 0x54, 0x27, 0x95, 0x07,
-	0x87, 0xc5, 0xca, 0x89, 0x6a, 0x1b, 0x82, 0x15, 0xfa, 0x94, 0x05, 0x03, 0x41, 0x68, 0x56, 0x73,
-	0xfa, 0x40, 0x91, 0x99, 0x78, 0x22, 0x11, 0x48, 0xd8, 0xd2, 0x6a, 0xd2, 0xc8, 0x0f, 0x29, 0x59,
-	0x9f, 0x7b, 0x0f, 0x0c, 0x99, 0x98, 0xfb, 0x99, 0xd3, 0x55, 0xd3, 0x9c, 0xfe, 0xac, 0x39, 0x9d,
-	0x44, 0xae, 0x61, 0x0f, 0x0b, 0xf5, 0x3a, 0x54, 0xea, 0x2a, 0xb8, 0xe1, 0x50, 0xa5, 0x60, 0x55,
-	0x2d, 0x0a, 0x76, 0xcc, 0xc4, 0xa9, 0xd9, 0xdf, 0xb6, 0x8c, 0xf9, 0x81, 0x49, 0x3c, 0xdf, 0x40,
-	0x11, 0x94, 0x9b, 0x5b, 0x9b, 0xc2, 0x88, 0xbd, 0x52, 0xd0, 0xf0, 0xce, 0x6d, 0x6d, 0xea, 0xf9,
-	0x6a, 0xb6, 0x62, 0xca, 0xac, 0x07, 0x37, 0x63, 0x2a, 0x25, 0xb5, 0xbc, 0x7f, 0x4a, 0xaa, 0xfd,
-	0x66, 0x09, 0x4e, 0x76, 0x4c, 0x2a, 0xf4, 0x3a, 0x54, 0x22, 0xfa, 0x96, 0xe2, 0xf5, 0x16, 0x0a,
-	0x4b, 0x22, 0x8d, 0xe7, 0x1b, 0x5a, 0x63, 0xa7, 0xdb, 0x31, 0x67, 0x89, 0xae, 0x00, 0xd2, 0x21,
-	0x38, 0xca, 0xc7, 0xc9, 0x5f, 0x59, 0x9d, 0xd3, 0x4f, 0x75, 0x60, 0xe0, 0x9c, 0xa7, 0xd0, 0x0b,
-	0x59, 0x57, 0x69, 0x39, 0x7d, 0x32, 0xba, 0x97, 0xd7, 0xd3, 0xfe, 0x67, 0x25, 0x18, 0x4e, 0x15,
-	0xd7, 0x42, 0x1e, 0x54, 0x89, 0xc7, 0x8e, 0x0d, 0xa4, 0x9a, 0x3a, 0x6a, 0xa9, 0x6c, 0xa5, 0x5a,
-	0x2f, 0x0a, 0xba, 0x58, 0x71, 0x78, 0x30, 0x8e, 0xef, 0x9f, 0x87, 0x21, 0xd9, 0xa1, 0x0f, 0x38,
-	0x2d, 0x4f, 0x0c, 0xa0, 0x9a, 0xa3, 0x17, 0x0d, 0x18, 0x4e, 0x61, 0xda, 0xbf, 0x5d, 0x86, 0x31,
-	0x7e, 0xce, 0xd2, 0x50, 0x33, 0x6f, 0x51, 0xee, 0xd4, 0xfe, 0xaa, 0x2e, 0x81, 0xc7, 0x07, 0x72,
-	0xed, 0xa8, 0x37, 0x53, 0xe4, 0x33, 0xea, 0x29, 0xea, 0xec, 0xab, 0x99, 0xa8, 0x33, 0x6e, 0xb0,
-	0x37, 0x8f, 0xa9, 0x47, 0xdf, 0x5b, 0x61, 0x68, 0x7f, 0xb7, 0x04, 0xa3, 0x99, 0x6b, 0x3f, 0xd0,
-	0x1b, 0xe9, 0x4a, 0xd1, 0x56, 0x11, 0xde, 0xf8, 0x3d, 0x6f, 0x82, 0x38, 0x58, 0xbd, 0xe8, 0xfb,
-	0xb4, 0x54, 0xec, 0x3f, 0x2c, 0xc1, 0x48, 0xfa, 0xbe, 0x92, 0x07, 0x70, 0xa4, 0xde, 0x09, 0x35,
-	0x56, 0x92, 0x9f, 0x5d, 0xe3, 0xca, 0x9d, 0xf9, 0xbc, 0xfa, 0xb9, 0x6c, 0xc4, 0x1a, 0xfe, 0x40,
-	0x94, 0xe1, 0xb6, 0xff, 0x9e, 0x05, 0x67, 0xf8, 0x5b, 0x66, 0xe7, 0xe1, 0xcf, 0xe5, 0x8d, 0xee,
-	0x2b, 0xc5, 0x76, 0x30, 0x53, 0xba, 0x71, 0xbf, 0xf1, 0x65, 0xd7, 0x47, 0x8a, 0xde, 0xa6, 0xa7,
-	0xc2, 0x03, 0xd8, 0xd9, 0x03, 0x4d, 0x06, 0xfb, 0x0f, 0xcb, 0xa0, 0x6f, 0xcc, 0x44, 0xae, 0xc8,
-	0xdf, 0x2c, 0xa4, 0x84, 0xe5, 0xca, 0x8e, 0x5f, 0xd7, 0x77, 0x73, 0x56, 0x33, 0xe9, 0x9b, 0x3f,
-	0x6b, 0xc1, 0xa0, 0xeb, 0xbb, 0x89, 0xeb, 0xb0, 0x0d, 0x78, 0x31, 0xb7, 0xf9, 0x29, 0x76, 0xf3,
-	0x9c, 0x72, 0x10, 0x99, 0x27, 0x40, 0x8a, 0x19, 0x36, 0x39, 0xa3, 0x0f, 0x8b, 0xc0, 0xf0, 0x72,
-	0x61, 0x99, 0xc7, 0xd5, 0x4c, 0x34, 0x78, 0x48, 0x0d, 0xaf, 0x24, 0x2a, 0x28, 0x61, 0x1f, 0x53,
-	0x52, 0xaa, 0x1a, 0xb2, 0xbe, 0xbb, 0x9c, 0x36, 0x63, 0xce, 0xc8, 0x8e, 0x01, 0x75, 0x8e, 0xc5,
-	0x01, 0x83, 0x6e, 0x27, 0xa1, 0xe6, 0xb4, 0x93, 0xa0, 0x45, 0x87, 0x49, 0x1c, 0x52, 0xe9, 0xb0,
-	0x62, 0x09, 0xc0, 0x1a, 0xc7, 0x7e, 0xa3, 0x02, 0x99, 0x84, 0x4a, 0xb4, 0x6d, 0xde, 0xf6, 0x6a,
-	0x15, 0x7b, 0xdb, 0xab, 0xea, 0x4c, 0xde, 0x8d, 0xaf, 0xa8, 0x09, 0x95, 0x70, 0xc3, 0x89, 0xa5,
-	0x59, 0xfd, 0x92, 0xda, 0xc7, 0xd1, 0xc6, 0xbb, 0xbb, 0xe3, 0x3f, 0xde, 0x9b, 0xbf, 0x96, 0xce,
-	0xd5, 0x49, 0x5e, 0x9a, 0x45, 0xb3, 0x66, 0x34, 0x30, 0xa7, 0x7f, 0x90, 0xfb, 0x0c, 0x3f, 0x21,
-	0xee, 0x1e, 0xc0, 0x24, 0x6e, 0x7b, 0x89, 0x98, 0x0d, 0x2f, 0x15, 0xb8, 0xca, 0x38, 0x61, 0x5d,
-	0x0a, 0x80, 0xff, 0xc7, 0x06, 0x53, 0xf4, 0x41, 0xa8, 0xc5, 0x89, 0x13, 0x25, 0x87, 0x4c, 0xde,
-	0x55, 0x83, 0xbe, 0x22, 0x89, 0x60, 0x4d, 0x0f, 0xbd, 0xcc, 0x2a, 0xfa, 0xba, 0xf1, 0xc6, 0x21,
-	0xf3, 0x39, 0x64, 0xf5, 0x5f, 0x41, 0x01, 0x1b, 0xd4, 0xd0, 0x05, 0x00, 0x36, 0xb7, 0x79, 0x28,
-	0x61, 0x95, 0xf9, 0xa7, 0x94, 0x28, 0xc4, 0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x47, 0x20, 0x5d, 0xcb,
-	0x02, 0x8d, 0xcb, 0xd2, 0x19, 0xdc, 0x7f, 0xcd, 0xf2, 0x32, 0x52, 0x55, 0x2e, 0x7e, 0xdd, 0x02,
-	0xb3, 0xe0, 0x06, 0x7a, 0x8d, 0x57, 0xf6, 0xb0, 0x8a, 0x38, 0x73, 0x34, 0xe8, 0x4e, 0x2c, 0x3a,
-	0x61, 0xe6, 0xf0, 0x5b, 0x96, 0xf7, 0x38, 0xf7, 0x6e, 0xa8, 0x4a, 0xe8, 0x81, 0x8c, 0xba, 0x8f,
-	0xc1, 0xa9, 0xec, 0x5d, 0xf8, 0xe2, 0xbc, 0x6a, 0x7f, 0xa7, 0x91, 0xf4, 0x04, 0x95, 0xba, 0x79,
-	0x82, 0x7a, 0xb8, 0xf3, 0xf7, 0x37, 0x2c, 0x38, 0xbf, 0xdf, 0x95, 0xfd, 0xe8, 0x11, 0xe8, 0xbb,
-	0xed, 0x44, 0xb2, 0xd4, 0x3a, 0x13, 0x94, 0x37, 0x9d, 0xc8, 0xc7, 0xac, 0x15, 0xed, 0x40, 0x3f,
-	0x8f, 0x37, 0x13, 0xd6, 0xfa, 0x11, 0xd7, 0x46, 0xce, 0x70, 0xe8, 0xed, 0x02, 0x8f, 0x75, 0xc3,
-	0x82, 0xa1, 0xfd, 0x1d, 0x0b, 0xd0, 0xd2, 0x16, 0x89, 0x22, 0xb7, 0x61, 0x44, 0xc8, 0xb1, 0x3b,
-	0x7c, 0x8c, 0xbb, 0x7a, 0xcc, 0xf4, 0xdd, 0xcc, 0x1d, 0x3e, 0xc6, 0xbf, 0xfc, 0x3b, 0x7c, 0x4a,
-	0x07, 0xbb, 0xc3, 0x07, 0x2d, 0xc1, 0x99, 0x16, 0xdf, 0x6e, 0xf0, 0x7b, 0x31, 0xf8, 0xde, 0x43,
-	0x25, 0xcb, 0x9d, 0xbd, 0xb3, 0x3b, 0x7e, 0x66, 0x31, 0x0f, 0x01, 0xe7, 0x3f, 0x67, 0xbf, 0x1b,
-	0x10, 0x0f, 0x8c, 0x9b, 0xc9, 0x8b, 0x72, 0xea, 0xea, 0x7e, 0xb1, 0xbf, 0x52, 0x81, 0xd1, 0x4c,
-	0x21, 0x5e, 0xba, 0xd5, 0xeb, 0x0c, 0xab, 0x3a, 0xb2, 0xfe, 0xee, 0xec, 0x5e, 0x4f, 0x81, 0x5a,
-	0x3e, 0x54, 0x5c, 0x3f, 0x6c, 0x27, 0xc5, 0xe4, 0xc7, 0xf2, 0x4e, 0xcc, 0x53, 0x82, 0x86, 0xa3,
-	0x99, 0xfe, 0xc5, 0x9c, 0x4d, 0x91, 0x61, 0x5f, 0x29, 0x63, 0xbc, 0xef, 0x3e, 0xb9, 0x03, 0x3e,
-	0xa1, 0x83, 0xb0, 0x2a, 0x45, 0x38, 0x16, 0x33, 0x93, 0xe5, 0xb8, 0x0f, 0xe9, 0x7f, 0xad, 0x04,
-	0x83, 0xc6, 0x47, 0x43, 0xbf, 0x94, 0x2e, 0x47, 0x65, 0x15, 0xf7, 0x4a, 0x8c, 0xfe, 0x84, 0x2e,
-	0x38, 0xc5, 0x5f, 0xe9, 0x89, 0xce, 0x4a, 0x54, 0x77, 0x77, 0xc7, 0x4f, 0x64, 0x6a, 0x4d, 0xa5,
-	0xaa, 0x53, 0x9d, 0xfb, 0x28, 0x8c, 0x66, 0xc8, 0xe4, 0xbc, 0xf2, 0xaa, 0xf9, 0xca, 0x47, 0x76,
-	0x4b, 0x99, 0x43, 0xf6, 0x0d, 0x3a, 0x64, 0x22, 0x45, 0x30, 0xf0, 0x48, 0x0f, 0x3e, 0xd8, 0x4c,
-	0x26, 0x70, 0xa9, 0xc7, 0x4c, 0xe0, 0x27, 0xa1, 0x1a, 0x06, 0x9e, 0x5b, 0x77, 0x55, 0xcd, 0x47,
-	0x96, 0x7b, 0xbc, 0x2c, 0xda, 0xb0, 0x82, 0xa2, 0xdb, 0x50, 0xbb, 0x75, 0x3b, 0xe1, 0xe7, 0x46,
-	0xc2, 0xbf, 0x5d, 0xd4, 0x71, 0x91, 0x32, 0x5a, 0xd4, 0xc1, 0x14, 0xd6, 0xbc, 0x90, 0x0d, 0xfd,
-	0x4c, 0x09, 0xca, 0xe4, 0x02, 0xe6, 0x7b, 0x67, 0xda, 0x31, 0xc6, 0x02, 0x62, 0x7f, 0xbd, 0x06,
-	0xa7, 0xf3, 0xaa, 0xa1, 0xa3, 0x8f, 0x40, 0x3f, 0xef, 0x63, 0x31, 0x17, 0x6e, 0xe4, 0xf1, 0x98,
-	0x63, 0x04, 0x45, 0xb7, 0xd8, 0x6f, 0x2c, 0x78, 0x0a, 0xee, 0x9e, 0xb3, 0x26, 0x66, 0xc8, 0xf1,
-	0x70, 0x5f, 0x70, 0x34, 0xf7, 0x05, 0x87, 0x73, 0xf7, 0x9c, 0x35, 0xb4, 0x0d, 0x95, 0xa6, 0x9b,
-	0x10, 0x47, 0x38, 0x11, 0x6e, 0x1e, 0x0b, 0x73, 0xe2, 0x70, 0x2b, 0x8d, 0xfd, 0xc4, 0x9c, 0x21,
-	0xfa, 0x9a, 0x05, 0xa3, 0x6b, 0xe9, 0xb4, 0x7f, 0x21, 0x3c, 0x9d, 0x63, 0xa8, 0x78, 0x9f, 0x66,
-	0xc4, 0x2f, 0xb1, 0xca, 0x34, 0xe2, 0x6c, 0x77, 0xd0, 0x27, 0x2d, 0x18, 0x58, 0x77, 0x3d, 0xa3,
-	0xe8, 0xf0, 0x31, 0x7c, 0x9c, 0x4b, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x31, 0x96, 0x9c, 0xbb,
-	0x69, 0xaa, 0xfe, 0xa3, 0x6a, 0xaa, 0x81, 0xfb, 0xa4, 0xa9, 0x3e, 0x63, 0x41, 0x4d, 0x8d, 0xb4,
-	0x48, 0xe5, 0xfe, 0xe0, 0x31, 0x7e, 0x72, 0xee, 0x39, 0x51, 0x7f, 0xb1, 0x66, 0x8e, 0xbe, 0x68,
-	0xc1, 0xa0, 0xf3, 0x7a, 0x3b, 0x22, 0x0d, 0xb2, 0x15, 0x84, 0xb1, 0xb8, 0x01, 0xf3, 0x95, 0xe2,
-	0x3b, 0x33, 0x45, 0x99, 0xcc, 0x92, 0xad, 0xa5, 0x30, 0x16, 0x89, 0x4f, 0xba, 0x01, 0x9b, 0x5d,
-	0xb0, 0x77, 0x4b, 0x30, 0xbe, 0x0f, 0x05, 0xf4, 0x3c, 0x0c, 0x05, 0x51, 0xd3, 0xf1, 0xdd, 0xd7,
-	0xcd, 0x3a, 0x1e, 0xca, 0xca, 0x5a, 0x32, 0x60, 0x38, 0x85, 0x69, 0x26, 0x9b, 0x97, 0xf6, 0x49,
-	0x36, 0x3f, 0x0f, 0x7d, 0x11, 0x09, 0x83, 0xec, 0x66, 0x81, 0x25, 0x1d, 0x30, 0x08, 0x7a, 0x14,
-	0xca, 0x4e, 0xe8, 0x8a, 0x10, 0x36, 0xb5, 0x07, 0x9a, 0x5a, 0x9e, 0xc7, 0xb4, 0x3d, 0x55, 0xfb,
-	0xa2, 0x72, 0x4f, 0x6a, 0x5f, 0x50, 0x35, 0x20, 0xce, 0x2e, 0xfa, 0xb5, 0x1a, 0x48, 0x9f, 0x29,
-	0xd8, 0x6f, 0x96, 0xe1, 0xd1, 0x3d, 0xe7, 0x8b, 0x8e, 0xe0, 0xb3, 0xf6, 0x88, 0xe0, 0x93, 0xc3,
-	0x53, 0xda, 0x6f, 0x78, 0xca, 0x5d, 0x86, 0xe7, 0x93, 0x74, 0x19, 0xc8, 0xfa, 0x27, 0xc5, 0xdc,
-	0x61, 0xd8, 0xad, 0x9c, 0x8a, 0x58, 0x01, 0x12, 0x8a, 0x35, 0x5f, 0xba, 0x07, 0x48, 0x25, 0x5a,
-	0x57, 0x8a, 0x50, 0x03, 0x5d, 0xeb, 0xa1, 0xf0, 0xb9, 0xdf, 0x2d, 0x7b, 0xdb, 0xfe, 0xf9, 0x12,
-	0x3c, 0xde, 0x83, 0xf4, 0x36, 0x67, 0xb1, 0xd5, 0xe3, 0x2c, 0xfe, 0xde, 0xfe, 0x4c, 0xf6, 0x5f,
-	0xb3, 0xe0, 0x5c, 0x77, 0xe5, 0x81, 0x9e, 0x81, 0xc1, 0xb5, 0xc8, 0xf1, 0xeb, 0x1b, 0xec, 0x5e,
-	0x56, 0x39, 0x28, 0x6c, 0xac, 0x75, 0x33, 0x36, 0x71, 0xe8, 0xf6, 0x96, 0xc7, 0x24, 0x18, 0x18,
-	0x32, 0x3d, 0x95, 0x6e, 0x6f, 0x57, 0xb3, 0x40, 0xdc, 0x89, 0x6f, 0xff, 0x79, 0x29, 0xbf, 0x5b,
-	0xdc, 0xc8, 0x38, 0xc8, 0x77, 0x12, 0x5f, 0xa1, 0xd4, 0x83, 0x2c, 0x29, 0xdf, 0x6b, 0x59, 0xd2,
-	0xd7, 0x4d, 0x96, 0xa0, 0x59, 0x38, 0x61, 0x5c, 0x9c, 0xc3, 0x53, 0x8e, 0x79, 0xa8, 0xae, 0xaa,
-	0x00, 0xb2, 0x9c, 0x81, 0xe3, 0x8e, 0x27, 0xd0, 0x53, 0x50, 0x75, 0xfd, 0x98, 0xd4, 0xdb, 0x11,
-	0x0f, 0x11, 0x37, 0xd2, 0xbc, 0xe6, 0x45, 0x3b, 0x56, 0x18, 0xf6, 0x2f, 0x97, 0xe0, 0x6c, 0x57,
-	0x3b, 0xeb, 0x1e, 0xc9, 0x2e, 0xf3, 0x73, 0xf4, 0xdd, 0x9b, 0xcf, 0x61, 0x0e, 0x52, 0x65, 0xdf,
-	0x41, 0xfa, 0xa3, 0xee, 0x13, 0x93, 0xda, 0xdc, 0xdf, 0xb7, 0xa3, 0xf4, 0x02, 0x0c, 0x3b, 0x61,
-	0xc8, 0xf1, 0x58, 0xa4, 0x67, 0xa6, 0x02, 0xd0, 0x94, 0x09, 0xc4, 0x69, 0xdc, 0x9e, 0xb4, 0xe7,
-	0x9f, 0x58, 0x50, 0xc3, 0x64, 0x9d, 0x4b, 0x07, 0x74, 0x4b, 0x0c, 0x91, 0x55, 0x44, 0xad, 0x50,
-	0x3a, 0xb0, 0xb1, 0xcb, 0x6a, 0x68, 0xe6, 0x0d, 0x76, 0xe7, 0xc5, 0x46, 0xa5, 0x03, 0x5d, 0x6c,
-	0xa4, 0xae, 0xb6, 0x29, 0x77, 0xbf, 0xda, 0xc6, 0xfe, 0xc6, 0x00, 0x7d, 0xbd, 0x30, 0x98, 0x89,
-	0x48, 0x23, 0xa6, 0xdf, 0xb7, 0x1d, 0x79, 0x62, 0x92, 0xa8, 0xef, 0x7b, 0x1d, 0x2f, 0x60, 0xda,
-	0x9e, 0x3a, 0x8a, 0x29, 0x1d, 0xa8, 0xfe, 0x49, 0x79, 0xdf, 0xfa, 0x27, 0x2f, 0xc0, 0x70, 0x1c,
-	0x6f, 0x2c, 0x47, 0xee, 0x96, 0x93, 0x90, 0xab, 0x64, 0x47, 0x58, 0x59, 0xba, 0x72, 0xc0, 0xca,
-	0x65, 0x0d, 0xc4, 0x69, 0x5c, 0x34, 0x07, 0x27, 0x75, 0x15, 0x12, 0x12, 0x25, 0x2c, 0x2f, 0x80,
-	0xcf, 0x04, 0x95, 0x26, 0xac, 0xeb, 0x96, 0x08, 0x04, 0xdc, 0xf9, 0x0c, 0x95, 0x6f, 0xa9, 0x46,
-	0xda, 0x91, 0xfe, 0xb4, 0x7c, 0x4b, 0xd1, 0xa1, 0x7d, 0xe9, 0x78, 0x02, 0x2d, 0xc2, 0x29, 0x3e,
-	0x31, 0xa6, 0xc2, 0xd0, 0x78, 0xa3, 0x81, 0x74, 0x8d, 0xc6, 0xb9, 0x4e, 0x14, 0x9c, 0xf7, 0x1c,
-	0x7a, 0x0e, 0x06, 0x55, 0xf3, 0xfc, 0xac, 0x38, 0x45, 0x50, 0x5e, 0x0c, 0x45, 0x66, 0xbe, 0x81,
-	0x4d, 0x3c, 0xf4, 0x01, 0x78, 0x58, 0xff, 0xe5, 0xc9, 0x63, 0xfc, 0x68, 0x6d, 0x56, 0x14, 0x78,
-	0x52, 0x17, 0xa9, 0xcc, 0xe5, 0xa2, 0x35, 0x70, 0xb7, 0xe7, 0xd1, 0x1a, 0x9c, 0x53, 0xa0, 0x8b,
-	0x7e, 0xc2, 0x32, 0x41, 0x62, 0x32, 0xed, 0xc4, 0xe4, 0x7a, 0xe4, 0x89, 0xfb, 0x07, 0xd4, 0x5d,
-	0x9b, 0x73, 0x6e, 0x72, 0x39, 0x0f, 0x13, 0x2f, 0xe0, 0x3d, 0xa8, 0xa0, 0x49, 0xa8, 0x11, 0xdf,
-	0x59, 0xf3, 0xc8, 0xd2, 0xcc, 0xbc, 0xb8, 0x91, 0x40, 0x47, 0xf6, 0x4a, 0x00, 0xd6, 0x38, 0x2a,
-	0x36, 0x75, 0xa8, 0xeb, 0xbd, 0xaf, 0xcb, 0x70, 0xba, 0x59, 0x0f, 0xa9, 0xed, 0xe1, 0xd6, 0xc9,
-	0x54, 0x9d, 0x05, 0xd4, 0xd1, 0x0f, 0xc3, 0x8b, 0x67, 0xaa, 0xc0, 0xeb, 0xb9, 0x99, 0xe5, 0x0e,
-	0x1c, 0x9c, 0xfb, 0x24, 0x0b, 0xbc, 0x8c, 0x82, 0xed, 0x9d, 0xb1, 0x53, 0x99, 0xc0, 0x4b, 0xda,
-	0x88, 0x39, 0x0c, 0x5d, 0x01, 0xc4, 0xa2, 0xf8, 0x2f, 0x27, 0x49, 0xa8, 0x8c, 0x9d, 0xb1, 0xd3,
-	0xe9, 0x72, 0x2f, 0x97, 0x3a, 0x30, 0x70, 0xce, 0x53, 0xf6, 0x7f, 0xb0, 0x60, 0x58, 0xad, 0xd7,
-	0x7b, 0x90, 0xc7, 0xe2, 0xa5, 0xf3, 0x58, 0xe6, 0x8e, 0x2e, 0xf1, 0x58, 0xcf, 0xbb, 0x04, 0x43,
-	0x7f, 0x7a, 0x10, 0x40, 0x4b, 0x45, 0xa5, 0x90, 0xac, 0xae, 0x0a, 0xe9, 0x81, 0x95, 0x48, 0x79,
-	0xb5, 0x59, 0x2a, 0xf7, 0xb7, 0x36, 0xcb, 0x0a, 0x9c, 0x91, 0xe6, 0x02, 0x3f, 0x2b, 0xba, 0x1c,
-	0xc4, 0x4a, 0xc0, 0x55, 0xa7, 0x1f, 0x15, 0x84, 0xce, 0xcc, 0xe7, 0x21, 0xe1, 0xfc, 0x67, 0x53,
-	0x56, 0xca, 0xc0, 0x7e, 0x56, 0x8a, 0x5e, 0xd3, 0x0b, 0xeb, 0xf2, 0xc6, 0x94, 0xcc, 0x9a, 0x5e,
-	0xb8, 0xb4, 0x82, 0x35, 0x4e, 0xbe, 0x60, 0xaf, 0x15, 0x24, 0xd8, 0xe1, 0xc0, 0x82, 0x5d, 0x8a,
-	0x98, 0xc1, 0xae, 0x22, 0x46, 0xfa, 0xa4, 0x87, 0xba, 0xfa, 0xa4, 0x5f, 0x84, 0x11, 0xd7, 0xdf,
-	0x20, 0x91, 0x9b, 0x90, 0x06, 0x5b, 0x0b, 0x4c, 0xfc, 0x18, 0x57, 0xbd, 0xcc, 0xa7, 0xa0, 0x38,
-	0x83, 0x9d, 0x96, 0x8b, 0x23, 0x3d, 0xc8, 0xc5, 0x2e, 0xda, 0x68, 0xb4, 0x18, 0x6d, 0x74, 0xe2,
-	0xe8, 0xda, 0xe8, 0xe4, 0xb1, 0x6a, 0x23, 0x54, 0x88, 0x36, 0xea, 0x49, 0xd0, 0x1b, 0xdb, 0xbf,
-	0xd3, 0xfb, 0x6c, 0xff, 0xba, 0xa9, 0xa2, 0x33, 0x87, 0x56, 0x45, 0xf9, 0x5a, 0xe6, 0xa1, 0x43,
-	0x69, 0x99, 0xcf, 0x94, 0xe0, 0x8c, 0x96, 0xc3, 0x74, 0xf6, 0xbb, 0xeb, 0x54, 0x12, 0xb1, 0x4b,
-	0xb7, 0xf8, 0xb9, 0x8d, 0x91, 0x56, 0xa5, 0x33, 0xb4, 0x14, 0x04, 0x1b, 0x58, 0x2c, 0x3b, 0x89,
-	0x44, 0xac, 0x44, 0x70, 0x56, 0x48, 0xcf, 0x88, 0x76, 0xac, 0x30, 0xe8, 0xfc, 0xa2, 0xbf, 0x45,
-	0xc6, 0x67, 0xb6, 0x10, 0xde, 0x8c, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xc9, 0x99, 0x30, 0x01, 0x41,
-	0x05, 0xf5, 0x90, 0xb8, 0x0d, 0x58, 0xca, 0x04, 0x05, 0x95, 0xdd, 0x61, 0x69, 0x68, 0x95, 0xce,
-	0xee, 0xb0, 0x10, 0x28, 0x85, 0x61, 0xff, 0x4f, 0x0b, 0xce, 0xe6, 0x0e, 0xc5, 0x3d, 0x50, 0xbe,
-	0xdb, 0x69, 0xe5, 0xbb, 0x52, 0xd4, 0x76, 0xc3, 0x78, 0x8b, 0x2e, 0x8a, 0xf8, 0xdf, 0x59, 0x30,
-	0xa2, 0xf1, 0xef, 0xc1, 0xab, 0xba, 0xe9, 0x57, 0x2d, 0x6e, 0x67, 0x55, 0xeb, 0x78, 0xb7, 0xdf,
-	0x2e, 0x81, 0x2a, 0x4e, 0x39, 0x55, 0x97, 0xa5, 0x7f, 0xf7, 0x39, 0x49, 0xdc, 0x81, 0x7e, 0x76,
-	0x10, 0x1a, 0x17, 0x13, 0xe4, 0x91, 0xe6, 0xcf, 0x0e, 0x55, 0xf5, 0x21, 0x33, 0xfb, 0x1b, 0x63,
-	0xc1, 0x90, 0x15, 0xb0, 0xe6, 0x75, 0xff, 0x1a, 0x22, 0x8f, 0x48, 0x17, 0xb0, 0x16, 0xed, 0x58,
-	0x61, 0x50, 0xf5, 0xe0, 0xd6, 0x03, 0x7f, 0xc6, 0x73, 0x62, 0x79, 0xd3, 0xa4, 0x52, 0x0f, 0xf3,
-	0x12, 0x80, 0x35, 0x0e, 0x3b, 0x23, 0x75, 0xe3, 0xd0, 0x73, 0x76, 0x8c, 0xfd, 0xb3, 0x51, 0xd9,
-	0x40, 0x81, 0xb0, 0x89, 0x67, 0xb7, 0x60, 0x2c, 0xfd, 0x12, 0xb3, 0x64, 0x9d, 0x05, 0x28, 0xf6,
-	0x34, 0x9c, 0x93, 0x50, 0x73, 0xd8, 0x53, 0x0b, 0x6d, 0x27, 0x7b, 0x51, 0xfd, 0x94, 0x04, 0x60,
-	0x8d, 0x63, 0xff, 0xaa, 0x05, 0xa7, 0x72, 0x06, 0xad, 0xc0, 0x84, 0xb9, 0x44, 0x4b, 0x9b, 0x3c,
-	0xc5, 0xfe, 0xc3, 0x30, 0xd0, 0x20, 0xeb, 0x8e, 0x0c, 0x81, 0x33, 0x64, 0xfb, 0x2c, 0x6f, 0xc6,
-	0x12, 0x6e, 0xff, 0x77, 0x0b, 0x46, 0xd3, 0x7d, 0x8d, 0x59, 0x2a, 0x09, 0x1f, 0x26, 0x37, 0xae,
-	0x07, 0x5b, 0x24, 0xda, 0xa1, 0x6f, 0x6e, 0x65, 0x52, 0x49, 0x3a, 0x30, 0x70, 0xce, 0x53, 0xac,
-	0x34, 0x6d, 0x43, 0x8d, 0xb6, 0x9c, 0x91, 0x37, 0x8a, 0x9c, 0x91, 0xfa, 0x63, 0x9a, 0xc7, 0xe5,
-	0x8a, 0x25, 0x36, 0xf9, 0xdb, 0xdf, 0xe9, 0x03, 0x95, 0x51, 0xcb, 0xe2, 0x8f, 0x0a, 0x8a, 0xde,
-	0x3a, 0x68, 0x06, 0x91, 0x9a, 0x0c, 0x7d, 0x7b, 0x05, 0x04, 0x70, 0x2f, 0x89, 0xe9, 0xba, 0x54,
-	0x6f, 0xb8, 0xaa, 0x41, 0xd8, 0xc4, 0xa3, 0x3d, 0xf1, 0xdc, 0x2d, 0xc2, 0x1f, 0xea, 0x4f, 0xf7,
-	0x64, 0x41, 0x02, 0xb0, 0xc6, 0xa1, 0x3d, 0x69, 0xb8, 0xeb, 0xeb, 0x62, 0xcb, 0xaf, 0x7a, 0x42,
-	0x47, 0x07, 0x33, 0x08, 0xaf, 0x36, 0x1e, 0x6c, 0x0a, 0x2b, 0xd8, 0xa8, 0x36, 0x1e, 0x6c, 0x62,
-	0x06, 0xa1, 0x76, 0x9b, 0x1f, 0x44, 0x2d, 0xc7, 0x73, 0x5f, 0x27, 0x0d, 0xc5, 0x45, 0x58, 0xbf,
-	0xca, 0x6e, 0xbb, 0xd6, 0x89, 0x82, 0xf3, 0x9e, 0xa3, 0x33, 0x30, 0x8c, 0x48, 0xc3, 0xad, 0x27,
-	0x26, 0x35, 0x48, 0xcf, 0xc0, 0xe5, 0x0e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x82, 0x51, 0x99, 0x11,
-	0x2d, 0xeb, 0xdd, 0x0c, 0xa6, 0xeb, 0x6b, 0xe0, 0x34, 0x18, 0x67, 0xf1, 0xa9, 0x54, 0x6b, 0x89,
-	0x92, 0x58, 0xcc, 0x58, 0x36, 0xa4, 0x9a, 0x2c, 0x95, 0x85, 0x15, 0x86, 0xfd, 0x89, 0x32, 0xd5,
-	0xc2, 0x5d, 0x4a, 0xc1, 0xdd, 0xb3, 0x68, 0xc1, 0xf4, 0x8c, 0xec, 0xeb, 0x61, 0x46, 0x3e, 0x0b,
-	0x43, 0xb7, 0xe2, 0xc0, 0x57, 0x91, 0x78, 0x95, 0xae, 0x91, 0x78, 0x06, 0x56, 0x7e, 0x24, 0x5e,
-	0x7f, 0x51, 0x91, 0x78, 0x03, 0x87, 0x8c, 0xc4, 0xfb, 0x9d, 0x0a, 0xa8, 0x6b, 0x4f, 0xae, 0x91,
-	0xe4, 0x76, 0x10, 0x6d, 0xba, 0x7e, 0x93, 0x65, 0x92, 0x7f, 0xcd, 0x82, 0x21, 0xbe, 0x5e, 0x16,
-	0xcc, 0x4c, 0xaa, 0xf5, 0x82, 0xee, 0xd3, 0x48, 0x31, 0x9b, 0x58, 0x35, 0x18, 0x65, 0x2e, 0x3a,
-	0x35, 0x41, 0x38, 0xd5, 0x23, 0xf4, 0x51, 0x00, 0xe9, 0x1f, 0x5d, 0x97, 0x22, 0x73, 0xbe, 0x98,
-	0xfe, 0x61, 0xb2, 0xae, 0x6d, 0xe0, 0x55, 0xc5, 0x04, 0x1b, 0x0c, 0xd1, 0x67, 0x74, 0x96, 0x19,
-	0x0f, 0xd9, 0xff, 0xf0, 0xb1, 0x8c, 0x4d, 0x2f, 0x39, 0x66, 0x18, 0x06, 0x5c, 0xbf, 0x49, 0xe7,
-	0x89, 0x88, 0x58, 0x7a, 0x47, 0x5e, 0x15, 0x86, 0x85, 0xc0, 0x69, 0x4c, 0x3b, 0x9e, 0xe3, 0xd7,
-	0x49, 0x34, 0xcf, 0xd1, 0xcd, 0xeb, 0xbd, 0x59, 0x03, 0x96, 0x84, 0x3a, 0x2e, 0x8c, 0xa9, 0xf4,
-	0x72, 0x61, 0xcc, 0xb9, 0xf7, 0xc1, 0xc9, 0x8e, 0x8f, 0x79, 0xa0, 0x94, 0xb2, 0xc3, 0x67, 0xa3,
-	0xd9, 0xbf, 0x5b, 0xd3, 0x4a, 0xeb, 0x5a, 0xd0, 0xe0, 0xd7, 0x96, 0x44, 0xfa, 0x8b, 0x0a, 0x1b,
-	0xb7, 0xc0, 0x29, 0x62, 0x5c, 0x11, 0xae, 0x1a, 0xb1, 0xc9, 0x92, 0xce, 0xd1, 0xd0, 0x89, 0x88,
-	0x7f, 0xdc, 0x73, 0x74, 0x59, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x91, 0xca, 0x29, 0xb9, 0x74, 0xf4,
-	0x9c, 0x12, 0x56, 0x9f, 0x2a, 0xef, 0xa6, 0x81, 0x2f, 0x5a, 0x30, 0xe2, 0xa7, 0x66, 0x6e, 0x31,
-	0x61, 0xa4, 0xf9, 0xab, 0x82, 0xdf, 0x9a, 0x95, 0x6e, 0xc3, 0x19, 0xfe, 0x79, 0x2a, 0xad, 0x72,
-	0x40, 0x95, 0xa6, 0xef, 0x3f, 0xea, 0xef, 0x76, 0xff, 0x11, 0xf2, 0xd5, 0x05, 0x70, 0x03, 0x85,
-	0x5f, 0x00, 0x07, 0x39, 0x97, 0xbf, 0xdd, 0x84, 0x5a, 0x3d, 0x22, 0x4e, 0x72, 0xc8, 0xbb, 0xc0,
-	0xd8, 0x01, 0xfd, 0x8c, 0x24, 0x80, 0x35, 0x2d, 0xf4, 0x31, 0x25, 0xcf, 0x6a, 0x45, 0x9a, 0x9f,
-	0x74, 0x29, 0xf6, 0x24, 0xc5, 0xbe, 0x94, 0xc9, 0x94, 0x85, 0x22, 0x12, 0x1a, 0x53, 0xbd, 0xf8,
-	0xde, 0xca, 0x8e, 0xfd, 0x3f, 0x7d, 0x70, 0x42, 0x76, 0x5f, 0xa6, 0x04, 0x50, 0x7b, 0x85, 0xcf,
-	0x03, 0xbd, 0xd9, 0x50, 0xf6, 0xca, 0x65, 0x09, 0xc0, 0x1a, 0x87, 0xda, 0xc7, 0xed, 0x98, 0x2c,
-	0x85, 0xc4, 0x5f, 0x70, 0xd7, 0x62, 0x71, 0xee, 0xac, 0xde, 0xfb, 0xba, 0x06, 0x61, 0x13, 0x8f,
-	0x6e, 0x8e, 0xf8, 0x3e, 0x25, 0xce, 0xa6, 0x13, 0x89, 0xfd, 0x0f, 0x96, 0x70, 0xf4, 0x0b, 0xb9,
-	0xb5, 0x82, 0x8b, 0x49, 0xa4, 0xeb, 0xc8, 0x84, 0x38, 0xe0, 0x75, 0x9e, 0x7f, 0xdb, 0x82, 0x33,
-	0xbc, 0x55, 0x8e, 0xe4, 0xf5, 0xb0, 0xe1, 0x24, 0x24, 0x2e, 0xe6, 0xd6, 0x80, 0x9c, 0xfe, 0x69,
-	0xa7, 0x7b, 0x1e, 0x5b, 0x9c, 0xdf, 0x1b, 0xf4, 0x86, 0x05, 0xa3, 0x9b, 0xa9, 0xea, 0x2d, 0x52,
-	0x95, 0x1f, 0xb5, 0x3c, 0x42, 0x8a, 0xa8, 0x16, 0x7d, 0xe9, 0xf6, 0x18, 0x67, 0xb9, 0xdb, 0x7f,
-	0x6e, 0x81, 0xa9, 0xd6, 0xee, 0x7d, 0xd1, 0x97, 0x83, 0x9b, 0xe6, 0xd2, 0xda, 0xaf, 0x74, 0xb5,
-	0xf6, 0x1f, 0x85, 0x72, 0xdb, 0x6d, 0x88, 0xfd, 0x9e, 0x3e, 0x0d, 0x9f, 0x9f, 0xc5, 0xb4, 0xdd,
-	0xfe, 0xa7, 0x15, 0xed, 0x47, 0x12, 0x79, 0x6a, 0xdf, 0x17, 0xaf, 0xbd, 0xae, 0xca, 0xc6, 0xf1,
-	0x37, 0xbf, 0xd6, 0x51, 0x36, 0xee, 0xc7, 0x0e, 0x9e, 0x86, 0xc8, 0x07, 0xa8, 0x5b, 0xd5, 0xb8,
-	0x81, 0x7d, 0x72, 0x10, 0x6f, 0x41, 0x95, 0x6e, 0x89, 0x99, 0x43, 0xb8, 0x9a, 0xea, 0x54, 0xf5,
-	0xb2, 0x68, 0xbf, 0xbb, 0x3b, 0xfe, 0x9e, 0x83, 0x77, 0x4b, 0x3e, 0x8d, 0x15, 0x7d, 0x14, 0x43,
-	0x8d, 0xfe, 0x66, 0xe9, 0x92, 0x62, 0xb3, 0x7d, 0x5d, 0xc9, 0x4c, 0x09, 0x28, 0x24, 0x17, 0x53,
-	0xf3, 0x41, 0x3e, 0xd4, 0xd8, 0xcd, 0xc7, 0x8c, 0x29, 0xdf, 0x93, 0x2f, 0xab, 0xa4, 0x45, 0x09,
-	0xb8, 0xbb, 0x3b, 0xfe, 0xc2, 0xc1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0x7f, 0xa9, 0x4f, 0xcf,
-	0x5d, 0x51, 0x2d, 0xf0, 0xfb, 0x62, 0xee, 0x3e, 0x9f, 0x99, 0xbb, 0xe7, 0x3b, 0xe6, 0xee, 0x88,
-	0xbe, 0xa1, 0x37, 0x35, 0x1b, 0xef, 0xb5, 0x61, 0xb6, 0xbf, 0xff, 0x87, 0x59, 0xa4, 0xaf, 0xb5,
-	0xdd, 0x88, 0xc4, 0xcb, 0x51, 0xdb, 0x77, 0xfd, 0x26, 0x9b, 0x8e, 0x55, 0xd3, 0x22, 0x4d, 0x81,
-	0x71, 0x16, 0x1f, 0x3d, 0x05, 0x55, 0xfa, 0xcd, 0x6f, 0x3a, 0x5b, 0x7c, 0x56, 0x19, 0x05, 0xd4,
-	0x56, 0x44, 0x3b, 0x56, 0x18, 0xf6, 0x37, 0x58, 0x6c, 0x81, 0x91, 0xa7, 0x4d, 0xe7, 0x84, 0xc7,
-	0xae, 0x9a, 0xe6, 0xd5, 0xd7, 0xd4, 0x9c, 0xe0, 0xf7, 0x4b, 0x73, 0x18, 0xba, 0x0d, 0x03, 0x6b,
-	0xfc, 0xae, 0xc5, 0x62, 0x2a, 0xd2, 0x8b, 0x8b, 0x1b, 0xd9, 0x8d, 0x3a, 0xf2, 0x16, 0xc7, 0xbb,
-	0xfa, 0x27, 0x96, 0xdc, 0xec, 0x3f, 0xa8, 0xc0, 0x68, 0xe6, 0x32, 0xe2, 0x54, 0xdd, 0xdb, 0xd2,
-	0xbe, 0x75, 0x6f, 0x3f, 0x04, 0xd0, 0x20, 0xa1, 0x17, 0xec, 0x30, 0xf3, 0xb8, 0xef, 0xc0, 0xe6,
-	0xb1, 0xda, 0x51, 0xcd, 0x2a, 0x2a, 0xd8, 0xa0, 0x28, 0x4a, 0xce, 0xf1, 0x32, 0xba, 0x99, 0x92,
-	0x73, 0xc6, 0xbd, 0x15, 0xfd, 0xf7, 0xf6, 0xde, 0x0a, 0x17, 0x46, 0x79, 0x17, 0x55, 0x36, 0xf4,
-	0x21, 0x92, 0x9e, 0x59, 0x3e, 0xc9, 0x6c, 0x9a, 0x0c, 0xce, 0xd2, 0xbd, 0x9f, 0x77, 0x8d, 0xa3,
-	0x77, 0x42, 0x4d, 0x7e, 0x67, 0xbe, 0x47, 0x11, 0x15, 0x25, 0xe4, 0x34, 0x60, 0x77, 0x80, 0x8b,
-	0x9f, 0x1d, 0x85, 0x1d, 0xe0, 0x7e, 0x15, 0x76, 0xb0, 0xbf, 0x50, 0xa2, 0x76, 0x3c, 0xef, 0x97,
-	0xaa, 0x51, 0xf4, 0x04, 0xf4, 0x3b, 0xed, 0x64, 0x23, 0xe8, 0xb8, 0x39, 0x72, 0x8a, 0xb5, 0x62,
-	0x01, 0x45, 0x0b, 0xd0, 0xd7, 0xd0, 0x75, 0x67, 0x0e, 0xf2, 0x3d, 0xb5, 0x8b, 0xda, 0x49, 0x08,
-	0x66, 0x54, 0xd0, 0x23, 0xd0, 0x97, 0x38, 0x4d, 0x99, 0x02, 0xc7, 0xd2, 0x9e, 0x57, 0x9d, 0x66,
-	0x8c, 0x59, 0xab, 0xa9, 0xbe, 0xfb, 0xf6, 0x51, 0xdf, 0x2f, 0xc0, 0x70, 0xec, 0x36, 0x7d, 0x27,
-	0x69, 0x47, 0xc4, 0x38, 0x76, 0xd5, 0x91, 0x34, 0x26, 0x10, 0xa7, 0x71, 0xed, 0xdf, 0x1c, 0x82,
-	0xd3, 0x2b, 0x33, 0x8b, 0xb2, 0x0e, 0xfb, 0xb1, 0x65, 0xb1, 0xe5, 0xf1, 0xb8, 0x77, 0x59, 0x6c,
-	0x5d, 0xb8, 0x7b, 0x46, 0x16, 0x9b, 0x67, 0x64, 0xb1, 0xa5, 0x53, 0x8a, 0xca, 0x45, 0xa4, 0x14,
-	0xe5, 0xf5, 0xa0, 0x97, 0x94, 0xa2, 0x63, 0x4b, 0x6b, 0xdb, 0xb3, 0x43, 0x07, 0x4a, 0x6b, 0x53,
-	0x39, 0x7f, 0x85, 0x24, 0x7b, 0x74, 0xf9, 0x54, 0xb9, 0x39, 0x7f, 0x2a, 0xdf, 0x8a, 0x27, 0x32,
-	0x09, 0x51, 0xff, 0x4a, 0xf1, 0x1d, 0xe8, 0x21, 0xdf, 0x4a, 0xe4, 0x52, 0x99, 0x39, 0x7e, 0x03,
-	0x45, 0xe4, 0xf8, 0xe5, 0x75, 0x67, 0xdf, 0x1c, 0xbf, 0x17, 0x60, 0xb8, 0xee, 0x05, 0x3e, 0x59,
-	0x8e, 0x82, 0x24, 0xa8, 0x07, 0xf2, 0xee, 0x3a, 0x25, 0x12, 0x66, 0x4c, 0x20, 0x4e, 0xe3, 0x76,
-	0x4b, 0x10, 0xac, 0x1d, 0x35, 0x41, 0x10, 0xee, 0x53, 0x82, 0xe0, 0xcf, 0xe8, 0x54, 0xf6, 0x41,
-	0xf6, 0x45, 0x3e, 0x54, 0xfc, 0x17, 0xe9, 0x25, 0x9f, 0x1d, 0xbd, 0xc9, 0xaf, 0x6e, 0xa4, 0x86,
-	0xf1, 0x4c, 0xd0, 0xa2, 0x86, 0xdf, 0x10, 0x1b, 0x92, 0x57, 0x8f, 0x61, 0xc2, 0xde, 0x5c, 0xd1,
-	0x6c, 0xd4, 0x75, 0x8e, 0xba, 0x09, 0xa7, 0x3b, 0x72, 0x94, 0x54, 0xfb, 0xaf, 0x94, 0xe0, 0x07,
-	0xf6, 0xed, 0x02, 0xba, 0x0d, 0x90, 0x38, 0x4d, 0x31, 0x51, 0xc5, 0x01, 0xd6, 0x11, 0xc3, 0x5d,
-	0x57, 0x25, 0x3d, 0x5e, 0x23, 0x46, 0xfd, 0x65, 0x47, 0x43, 0xf2, 0x37, 0x8b, 0x72, 0x0d, 0xbc,
-	0x8e, 0x52, 0x9a, 0x38, 0xf0, 0x08, 0x66, 0x10, 0xaa, 0xfe, 0x23, 0xd2, 0xd4, 0x77, 0x8d, 0xab,
-	0xcf, 0x87, 0x59, 0x2b, 0x16, 0x50, 0xf4, 0x1c, 0x0c, 0x3a, 0x9e, 0xc7, 0xf3, 0x95, 0x48, 0x2c,
-	0x6e, 0x50, 0xd2, 0x5e, 0x4b, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x67, 0x25, 0x18, 0xdf, 0x47, 0xa6,
-	0x74, 0x64, 0x60, 0x56, 0x7a, 0xce, 0xc0, 0x14, 0x39, 0x23, 0xfd, 0x5d, 0x72, 0x46, 0x9e, 0x83,
-	0xc1, 0x84, 0x38, 0x2d, 0x11, 0x20, 0x27, 0x3c, 0x01, 0xfa, 0x44, 0x5e, 0x83, 0xb0, 0x89, 0x47,
-	0xa5, 0xd8, 0x88, 0x53, 0xaf, 0x93, 0x38, 0x96, 0x49, 0x21, 0xc2, 0xbb, 0x5d, 0x58, 0xc6, 0x09,
-	0x3b, 0x34, 0x98, 0x4a, 0xb1, 0xc0, 0x19, 0x96, 0xd9, 0x01, 0xaf, 0xf5, 0x38, 0xe0, 0x5f, 0x2f,
-	0xc1, 0xa3, 0x7b, 0x6a, 0xb7, 0x9e, 0xf3, 0x75, 0xda, 0x31, 0x89, 0xb2, 0x13, 0xe7, 0x7a, 0x4c,
-	0x22, 0xcc, 0x20, 0x7c, 0x94, 0xc2, 0xd0, 0xb8, 0xcb, 0xbd, 0xe8, 0x64, 0x32, 0x3e, 0x4a, 0x29,
-	0x16, 0x38, 0xc3, 0xf2, 0xb0, 0xd3, 0xf2, 0xef, 0x97, 0xe0, 0xf1, 0x1e, 0x6c, 0x80, 0x02, 0x93,
-	0xee, 0xd2, 0xa9, 0x8f, 0xe5, 0xfb, 0x94, 0xa1, 0x7a, 0xc8, 0xe1, 0xfa, 0x46, 0x09, 0xce, 0x75,
-	0x57, 0xc5, 0xe8, 0xbd, 0x30, 0x1a, 0xa9, 0xa8, 0x38, 0x33, 0x6b, 0xf2, 0x14, 0xf7, 0x24, 0xa4,
-	0x40, 0x38, 0x8b, 0x8b, 0x26, 0x00, 0x42, 0x27, 0xd9, 0x88, 0x2f, 0x6e, 0xbb, 0x71, 0x22, 0xaa,
-	0x02, 0x8d, 0xf0, 0xb3, 0x44, 0xd9, 0x8a, 0x0d, 0x0c, 0xca, 0x8e, 0xfd, 0x9b, 0x0d, 0xae, 0x05,
-	0x09, 0x7f, 0x88, 0x6f, 0x23, 0x4e, 0xc9, 0xdb, 0x57, 0x0c, 0x10, 0xce, 0xe2, 0x52, 0x76, 0xec,
-	0x9c, 0x87, 0x77, 0x94, 0xef, 0x2f, 0x18, 0xbb, 0x05, 0xd5, 0x8a, 0x0d, 0x8c, 0x6c, 0x3e, 0x68,
-	0x65, 0xff, 0x7c, 0x50, 0xfb, 0x9f, 0x94, 0xe0, 0x6c, 0x57, 0x53, 0xae, 0xb7, 0x05, 0xf8, 0xe0,
-	0xe5, 0x70, 0x1e, 0x6e, 0xee, 0x1c, 0x30, 0xd7, 0xf0, 0x4f, 0xba, 0xcc, 0x34, 0x91, 0x6b, 0x78,
-	0xf8, 0x64, 0xfd, 0x07, 0x6f, 0x3c, 0x3b, 0xd2, 0x0b, 0xfb, 0x0e, 0x90, 0x5e, 0x98, 0xf9, 0x18,
-	0x95, 0x1e, 0x17, 0xf2, 0x5f, 0x94, 0xbb, 0x0e, 0x2f, 0xdd, 0xfa, 0xf5, 0xe4, 0xa7, 0x9d, 0x85,
-	0x13, 0xae, 0xcf, 0x6e, 0xe2, 0x5a, 0x69, 0xaf, 0x89, 0x42, 0x31, 0xa5, 0xf4, 0x4d, 0xfd, 0xf3,
-	0x19, 0x38, 0xee, 0x78, 0xe2, 0x01, 0x4c, 0xf7, 0x3c, 0xdc, 0x90, 0x1e, 0x2c, 0xe1, 0x18, 0x2d,
-	0xc1, 0x19, 0x39, 0x14, 0x1b, 0x4e, 0x44, 0x1a, 0x42, 0x8d, 0xc4, 0x22, 0xc1, 0xe5, 0x2c, 0x4f,
-	0x92, 0xc9, 0x41, 0xc0, 0xf9, 0xcf, 0xb1, 0xcb, 0x8f, 0x82, 0xd0, 0xad, 0x8b, 0x4d, 0x8e, 0xbe,
-	0xfc, 0x88, 0x36, 0x62, 0x0e, 0xb3, 0x3f, 0x04, 0x35, 0xf5, 0xfe, 0x3c, 0xcc, 0x5e, 0x4d, 0xba,
-	0x8e, 0x30, 0x7b, 0x35, 0xe3, 0x0c, 0x2c, 0xfa, 0xb5, 0xa8, 0x49, 0x9c, 0x59, 0x3d, 0x57, 0xc9,
-	0x0e, 0xb3, 0x8f, 0xed, 0x77, 0xc1, 0x90, 0xf2, 0xb3, 0xf4, 0x7a, 0x25, 0x94, 0xfd, 0x73, 0x03,
-	0x30, 0x9c, 0x2a, 0xd6, 0x98, 0x72, 0xb0, 0x5a, 0xfb, 0x3a, 0x58, 0x59, 0xda, 0x44, 0xdb, 0x97,
-	0xf7, 0xc5, 0x19, 0x69, 0x13, 0x6d, 0x9f, 0x60, 0x0e, 0xa3, 0xe6, 0x6d, 0x23, 0xda, 0xc1, 0x6d,
-	0x5f, 0x84, 0x37, 0x2b, 0xf3, 0x76, 0x96, 0xb5, 0x62, 0x01, 0x45, 0x1f, 0xb7, 0x60, 0x28, 0x66,
-	0xde, 0x7b, 0xee, 0x9e, 0x16, 0x93, 0xee, 0xca, 0xd1, 0x6b, 0x51, 0xaa, 0xc2, 0xa4, 0x2c, 0x62,
-	0xc9, 0x6c, 0xc1, 0x29, 0x8e, 0xe8, 0x53, 0x16, 0xd4, 0xd4, 0xb5, 0x36, 0xe2, 0xf2, 0xc7, 0x95,
-	0x62, 0x6b, 0x61, 0x72, 0xbf, 0xa6, 0x3a, 0x08, 0x51, 0x45, 0x09, 0xb1, 0x66, 0x8c, 0x62, 0xe5,
-	0x3b, 0x1e, 0x38, 0x1e, 0xdf, 0x31, 0xe4, 0xf8, 0x8d, 0xdf, 0x09, 0xb5, 0x96, 0xe3, 0xbb, 0xeb,
-	0x24, 0x4e, 0xb8, 0x3b, 0x57, 0x96, 0xe8, 0x95, 0x8d, 0x58, 0xc3, 0xa9, 0x42, 0x8e, 0xd9, 0x8b,
-	0x25, 0x86, 0xff, 0x95, 0x29, 0xe4, 0x15, 0xdd, 0x8c, 0x4d, 0x1c, 0xd3, 0x59, 0x0c, 0xf7, 0xd5,
-	0x59, 0x3c, 0xb8, 0x8f, 0xb3, 0xf8, 0xbd, 0x30, 0x5a, 0xdf, 0x70, 0xfc, 0x26, 0x51, 0xd0, 0xb1,
-	0x21, 0x6d, 0xdb, 0xcc, 0xa4, 0x41, 0x38, 0x8b, 0x8b, 0x5e, 0x84, 0x91, 0x74, 0x93, 0xc8, 0x3f,
-	0x55, 0x09, 0x60, 0x69, 0x0a, 0x38, 0x83, 0x6d, 0xff, 0x43, 0x0b, 0xce, 0xe4, 0x4e, 0x9a, 0x07,
-	0x37, 0x0e, 0xd6, 0xfe, 0x72, 0x05, 0x4e, 0xe5, 0x14, 0x7d, 0x45, 0x3b, 0xe6, 0x72, 0xb2, 0x8a,
-	0x08, 0x61, 0x48, 0x9f, 0xc8, 0xcb, 0xaf, 0x98, 0xb3, 0x86, 0x0e, 0x76, 0x52, 0xa4, 0x4f, 0x6b,
-	0xca, 0xf7, 0xf6, 0xb4, 0xc6, 0x58, 0x15, 0x7d, 0xf7, 0x75, 0x55, 0x54, 0xf6, 0x59, 0x15, 0xbf,
-	0x66, 0xc1, 0x58, 0xab, 0xcb, 0x4d, 0x03, 0xc2, 0xef, 0x79, 0xe3, 0x78, 0xee, 0x31, 0x98, 0x7e,
-	0xe4, 0xce, 0xee, 0x78, 0xd7, 0x0b, 0x1e, 0x70, 0xd7, 0x5e, 0xd9, 0xdf, 0x29, 0x03, 0xab, 0x38,
-	0xcc, 0x0a, 0xfb, 0xed, 0xa0, 0x8f, 0x99, 0xb5, 0xa3, 0xad, 0xa2, 0xea, 0x1c, 0x73, 0xe2, 0xaa,
-	0xf6, 0x34, 0x1f, 0xc1, 0xbc, 0x52, 0xd4, 0x59, 0x99, 0x59, 0xea, 0x41, 0x66, 0x7a, 0xb2, 0x48,
-	0x77, 0xb9, 0xf8, 0x22, 0xdd, 0xb5, 0x6c, 0x81, 0xee, 0xbd, 0x3f, 0x71, 0xdf, 0x03, 0xf9, 0x89,
-	0x7f, 0xd1, 0xe2, 0x82, 0x27, 0xf3, 0x15, 0xb4, 0x61, 0x62, 0xed, 0x61, 0x98, 0x3c, 0x05, 0xd5,
-	0x98, 0x78, 0xeb, 0x97, 0x89, 0xe3, 0x09, 0x03, 0x46, 0x1f, 0x9f, 0x8b, 0x76, 0xac, 0x30, 0xd8,
-	0xfd, 0xbf, 0x9e, 0x17, 0xdc, 0xbe, 0xd8, 0x0a, 0x93, 0x1d, 0x61, 0xca, 0xe8, 0xfb, 0x7f, 0x15,
-	0x04, 0x1b, 0x58, 0xf6, 0xdf, 0x2a, 0xf1, 0x19, 0x28, 0x62, 0x30, 0x9e, 0xcf, 0xdc, 0xd8, 0xd8,
-	0x7b, 0xf8, 0xc2, 0x47, 0x00, 0xea, 0x41, 0x2b, 0xa4, 0x66, 0xe7, 0x6a, 0x20, 0x8e, 0xa4, 0x2e,
-	0x1f, 0xf9, 0xc2, 0x76, 0x41, 0x4f, 0xbf, 0x86, 0x6e, 0xc3, 0x06, 0xbf, 0x94, 0x2c, 0x2d, 0xef,
-	0x2b, 0x4b, 0x53, 0x62, 0xa5, 0x6f, 0x6f, 0xb1, 0x62, 0xff, 0x99, 0x05, 0x29, 0x83, 0x0c, 0x85,
-	0x50, 0xa1, 0xdd, 0xdd, 0x11, 0x2b, 0x74, 0xa9, 0x38, 0xeb, 0x8f, 0x8a, 0x46, 0x31, 0xed, 0xd9,
-	0x4f, 0xcc, 0x19, 0x21, 0x4f, 0x84, 0x6a, 0xf0, 0x51, 0xbd, 0x56, 0x1c, 0xc3, 0xcb, 0x41, 0xb0,
-	0xc9, 0xcf, 0x55, 0x75, 0xd8, 0x87, 0xfd, 0x3c, 0x9c, 0xec, 0xe8, 0x14, 0xbb, 0x9c, 0x2d, 0xa0,
-	0xda, 0x27, 0x33, 0x5d, 0x59, 0x3e, 0x2f, 0xe6, 0x30, 0xfb, 0x1b, 0x16, 0x9c, 0xc8, 0x92, 0x47,
-	0x6f, 0x5a, 0x70, 0x32, 0xce, 0xd2, 0x3b, 0xae, 0xb1, 0x53, 0xe1, 0x96, 0x1d, 0x20, 0xdc, 0xd9,
-	0x09, 0xfb, 0xff, 0x8a, 0xc9, 0x7f, 0xd3, 0xf5, 0x1b, 0xc1, 0x6d, 0x65, 0x98, 0x58, 0x5d, 0x0d,
-	0x13, 0xba, 0x1e, 0xeb, 0x1b, 0xa4, 0xd1, 0xf6, 0x3a, 0x12, 0x89, 0x57, 0x44, 0x3b, 0x56, 0x18,
-	0x2c, 0x6f, 0xb2, 0x2d, 0xaa, 0xf8, 0x67, 0x26, 0xe5, 0xac, 0x68, 0xc7, 0x0a, 0x03, 0x3d, 0x0b,
-	0x43, 0xc6, 0x4b, 0xca, 0x79, 0xc9, 0xf6, 0x03, 0x86, 0xca, 0x8c, 0x71, 0x0a, 0x0b, 0x4d, 0x00,
-	0x28, 0x23, 0x47, 0xaa, 0x48, 0xe6, 0xa7, 0x52, 0x92, 0x28, 0xc6, 0x06, 0x06, 0xcb, 0x52, 0xf6,
-	0xda, 0x31, 0x3b, 0x62, 0xe8, 0xd7, 0x95, 0x65, 0x67, 0x44, 0x1b, 0x56, 0x50, 0x2a, 0x4d, 0x5a,
-	0x8e, 0xdf, 0x76, 0x3c, 0x3a, 0x42, 0x62, 0xe7, 0xa9, 0x96, 0xe1, 0xa2, 0x82, 0x60, 0x03, 0x8b,
-	0xbe, 0x71, 0xe2, 0xb6, 0xc8, 0xcb, 0x81, 0x2f, 0xc3, 0xe4, 0xf4, 0xa9, 0x93, 0x68, 0xc7, 0x0a,
-	0xc3, 0xfe, 0xaf, 0x16, 0x8c, 0xea, 0x9a, 0x07, 0xfc, 0x1a, 0x76, 0x73, 0xa3, 0x6c, 0xed, 0xbb,
-	0x51, 0x4e, 0x27, 0x83, 0x97, 0x7a, 0x4a, 0x06, 0x37, 0xf3, 0xb4, 0xcb, 0x7b, 0xe6, 0x69, 0xff,
-	0x90, 0xbe, 0xe2, 0x97, 0x27, 0x74, 0x0f, 0xe6, 0x5d, 0xef, 0x8b, 0x6c, 0xe8, 0xaf, 0x3b, 0xaa,
-	0xe0, 0xcf, 0x10, 0xdf, 0xba, 0xcc, 0x4c, 0x31, 0x24, 0x01, 0xb1, 0x97, 0xa0, 0xa6, 0x0e, 0x5f,
-	0xe4, 0x3e, 0xd9, 0xca, 0xdf, 0x27, 0xf7, 0x94, 0x2f, 0x3a, 0xbd, 0xf6, 0xad, 0xef, 0x3e, 0xf6,
-	0xb6, 0xdf, 0xff, 0xee, 0x63, 0x6f, 0xfb, 0xe3, 0xef, 0x3e, 0xf6, 0xb6, 0x8f, 0xdf, 0x79, 0xcc,
-	0xfa, 0xd6, 0x9d, 0xc7, 0xac, 0xdf, 0xbf, 0xf3, 0x98, 0xf5, 0xc7, 0x77, 0x1e, 0xb3, 0xbe, 0x73,
-	0xe7, 0x31, 0xeb, 0x8b, 0xff, 0xe9, 0xb1, 0xb7, 0xbd, 0x9c, 0x1b, 0x27, 0x49, 0x7f, 0x3c, 0x5d,
-	0x6f, 0x4c, 0x6e, 0x5d, 0x60, 0xa1, 0x7a, 0x74, 0x79, 0x4d, 0x1a, 0x73, 0x6a, 0x52, 0x2e, 0xaf,
-	0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x99, 0x15, 0x93, 0xb9, 0x1e, 0xe6, 0x00, 0x00,
+	// 12639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x1c, 0xd9,
+	0x75, 0x18, 0xac, 0x9e, 0xc1, 0x00, 0x98, 0x03, 0xf0, 0x75, 0x49, 0xee, 0x62, 0xa9, 0xdd, 0x05,
+	0xdd, 0x6b, 0xaf, 0xa4, 0x4f, 0x2b, 0xd0, 0x4b, 0x6b, 0xe5, 0xfd, 0xbc, 0x96, 0x64, 0x3c, 0xf8,
+	0x00, 0x09, 0x10, 0xd0, 0x01, 0x48, 0x5a, 0x92, 0xf5, 0x68, 0xf4, 0x5c, 0x0c, 0x9a, 0xe8, 0xe9,
+	0x9e, 0xed, 0xee, 0x01, 0x89, 0xb5, 0x2c, 0x4b, 0xb6, 0x64, 0xeb, 0xb3, 0x1e, 0xab, 0x4f, 0xfe,
+	0xbe, 0x78, 0x1d, 0x3f, 0x22, 0x3f, 0x92, 0xca, 0x4b, 0x15, 0xe7, 0x55, 0x76, 0x9c, 0x54, 0xb9,
+	0x62, 0xa7, 0x1c, 0x27, 0x4e, 0xca, 0x8e, 0xe3, 0xb2, 0x9c, 0x8a, 0x8d, 0xc8, 0x4c, 0xa5, 0x92,
+	0x4a, 0x25, 0x4e, 0x39, 0xf1, 0x8f, 0x84, 0x71, 0xaa, 0x52, 0xf7, 0x7d, 0xbb, 0x67, 0x06, 0x18,
+	0x00, 0x0d, 0x92, 0x76, 0xf4, 0x0b, 0x98, 0x7b, 0x4e, 0xdf, 0x73, 0xfb, 0xf6, 0xbd, 0xf7, 0x9c,
+	0x7b, 0x9e, 0xb0, 0xd0, 0x0c, 0xb2, 0x8d, 0xce, 0xda, 0x94, 0x1f, 0xb7, 0x2e, 0x78, 0x49, 0x33,
+	0x6e, 0x27, 0xf1, 0x1d, 0xfe, 0xcf, 0x3b, 0xfc, 0xc6, 0x85, 0xad, 0x8b, 0x17, 0xda, 0x9b, 0xcd,
+	0x0b, 0x5e, 0x3b, 0x48, 0x2f, 0x78, 0xed, 0x76, 0x18, 0xf8, 0x5e, 0x16, 0xc4, 0xd1, 0x85, 0xad,
+	0x17, 0xbd, 0xb0, 0xbd, 0xe1, 0xbd, 0x78, 0xa1, 0x49, 0x23, 0x9a, 0x78, 0x19, 0x6d, 0x4c, 0xb5,
+	0x93, 0x38, 0x8b, 0xc9, 0xb7, 0x9b, 0xde, 0xa6, 0x54, 0x6f, 0xfc, 0x9f, 0x8f, 0xf8, 0x8d, 0xa9,
+	0xad, 0x8b, 0x53, 0xed, 0xcd, 0xe6, 0x14, 0xeb, 0x6d, 0xca, 0xea, 0x6d, 0x4a, 0xf5, 0x76, 0xee,
+	0x1d, 0xd6, 0x58, 0x9a, 0x71, 0x33, 0xbe, 0xc0, 0x3b, 0x5d, 0xeb, 0xac, 0xf3, 0x5f, 0xfc, 0x07,
+	0xff, 0x4f, 0x10, 0x3b, 0xe7, 0x6e, 0xbe, 0x9c, 0x4e, 0x05, 0x31, 0x1b, 0xde, 0x05, 0x3f, 0x4e,
+	0xe8, 0x85, 0xad, 0xae, 0x01, 0x9d, 0xbb, 0x6a, 0x70, 0xe8, 0xbd, 0x8c, 0x46, 0x69, 0x10, 0x47,
+	0xe9, 0x3b, 0xd8, 0x10, 0x68, 0xb2, 0x45, 0x13, 0xfb, 0xf5, 0x2c, 0x84, 0x5e, 0x3d, 0xbd, 0xd3,
+	0xf4, 0xd4, 0xf2, 0xfc, 0x8d, 0x20, 0xa2, 0xc9, 0xb6, 0x79, 0xbc, 0x45, 0x33, 0xaf, 0xd7, 0x53,
+	0x17, 0xfa, 0x3d, 0x95, 0x74, 0xa2, 0x2c, 0x68, 0xd1, 0xae, 0x07, 0xde, 0xb5, 0xd7, 0x03, 0xa9,
+	0xbf, 0x41, 0x5b, 0x5e, 0xd7, 0x73, 0xdf, 0xd2, 0xef, 0xb9, 0x4e, 0x16, 0x84, 0x17, 0x82, 0x28,
+	0x4b, 0xb3, 0xa4, 0xf8, 0x90, 0xfb, 0xe3, 0x0e, 0x1c, 0x9b, 0xbe, 0xbd, 0x32, 0xdd, 0xc9, 0x36,
+	0x66, 0xe3, 0x68, 0x3d, 0x68, 0x92, 0x97, 0x60, 0xcc, 0x0f, 0x3b, 0x69, 0x46, 0x93, 0x1b, 0x5e,
+	0x8b, 0x4e, 0x38, 0xe7, 0x9d, 0xb7, 0xd6, 0x67, 0x4e, 0xff, 0xda, 0xce, 0xe4, 0x9b, 0xee, 0xef,
+	0x4c, 0x8e, 0xcd, 0x1a, 0x10, 0xda, 0x78, 0xe4, 0x6d, 0x30, 0x92, 0xc4, 0x21, 0x9d, 0xc6, 0x1b,
+	0x13, 0x15, 0xfe, 0xc8, 0x09, 0xf9, 0xc8, 0x08, 0x8a, 0x66, 0x54, 0x70, 0x86, 0xda, 0x4e, 0xe2,
+	0xf5, 0x20, 0xa4, 0x13, 0xd5, 0x3c, 0xea, 0xb2, 0x68, 0x46, 0x05, 0x77, 0x7f, 0xa7, 0x02, 0x30,
+	0xdd, 0x6e, 0x2f, 0x27, 0xf1, 0x1d, 0xea, 0x67, 0xe4, 0xa3, 0x30, 0xca, 0xa6, 0xb9, 0xe1, 0x65,
+	0x1e, 0x1f, 0xd8, 0xd8, 0xc5, 0x6f, 0x9e, 0x12, 0x6f, 0x3d, 0x65, 0xbf, 0xb5, 0x59, 0x64, 0x0c,
+	0x7b, 0x6a, 0xeb, 0xc5, 0xa9, 0xa5, 0x35, 0xf6, 0xfc, 0x22, 0xcd, 0xbc, 0x19, 0x22, 0x89, 0x81,
+	0x69, 0x43, 0xdd, 0x2b, 0x89, 0x60, 0x28, 0x6d, 0x53, 0x9f, 0xbf, 0xc3, 0xd8, 0xc5, 0x85, 0xa9,
+	0xc3, 0xac, 0xe6, 0x29, 0x33, 0xf2, 0x95, 0x36, 0xf5, 0x67, 0xc6, 0x25, 0xe5, 0x21, 0xf6, 0x0b,
+	0x39, 0x1d, 0xb2, 0x05, 0xc3, 0x69, 0xe6, 0x65, 0x9d, 0x94, 0x4f, 0xc5, 0xd8, 0xc5, 0x1b, 0xa5,
+	0x51, 0xe4, 0xbd, 0xce, 0x1c, 0x97, 0x34, 0x87, 0xc5, 0x6f, 0x94, 0xd4, 0xdc, 0xdf, 0x77, 0xe0,
+	0xb8, 0x41, 0x5e, 0x08, 0xd2, 0x8c, 0x7c, 0x57, 0xd7, 0xe4, 0x4e, 0x0d, 0x36, 0xb9, 0xec, 0x69,
+	0x3e, 0xb5, 0x27, 0x25, 0xb1, 0x51, 0xd5, 0x62, 0x4d, 0x6c, 0x0b, 0x6a, 0x41, 0x46, 0x5b, 0xe9,
+	0x44, 0xe5, 0x7c, 0xf5, 0xad, 0x63, 0x17, 0xaf, 0x96, 0xf5, 0x9e, 0x33, 0xc7, 0x24, 0xd1, 0xda,
+	0x3c, 0xeb, 0x1e, 0x05, 0x15, 0xf7, 0xef, 0x9c, 0xb4, 0xdf, 0x8f, 0x4d, 0x38, 0x79, 0x11, 0xc6,
+	0xd2, 0xb8, 0x93, 0xf8, 0x14, 0x69, 0x3b, 0x4e, 0x27, 0x9c, 0xf3, 0x55, 0xb6, 0xf4, 0xd8, 0xa2,
+	0x5e, 0x31, 0xcd, 0x68, 0xe3, 0x90, 0x2f, 0x38, 0x30, 0xde, 0xa0, 0x69, 0x16, 0x44, 0x9c, 0xbe,
+	0x1a, 0xfc, 0xea, 0xa1, 0x07, 0xaf, 0x1a, 0xe7, 0x4c, 0xe7, 0x33, 0x67, 0xe4, 0x8b, 0x8c, 0x5b,
+	0x8d, 0x29, 0xe6, 0xe8, 0xb3, 0xcd, 0xd9, 0xa0, 0xa9, 0x9f, 0x04, 0x6d, 0xf6, 0x5b, 0x6e, 0x1f,
+	0xbd, 0x39, 0xe7, 0x0c, 0x08, 0x6d, 0x3c, 0x12, 0x41, 0x8d, 0x6d, 0xbe, 0x74, 0x62, 0x88, 0x8f,
+	0x7f, 0xfe, 0x70, 0xe3, 0x97, 0x93, 0xca, 0xf6, 0xb5, 0x99, 0x7d, 0xf6, 0x2b, 0x45, 0x41, 0x86,
+	0x7c, 0xde, 0x81, 0x09, 0x79, 0x38, 0x20, 0x15, 0x13, 0x7a, 0x7b, 0x23, 0xc8, 0x68, 0x18, 0xa4,
+	0xd9, 0x44, 0x8d, 0x8f, 0xe1, 0xc2, 0x60, 0x6b, 0xeb, 0x4a, 0x12, 0x77, 0xda, 0xd7, 0x83, 0xa8,
+	0x31, 0x73, 0x5e, 0x52, 0x9a, 0x98, 0xed, 0xd3, 0x31, 0xf6, 0x25, 0x49, 0x7e, 0xd8, 0x81, 0x73,
+	0x91, 0xd7, 0xa2, 0x69, 0xdb, 0x63, 0x9f, 0x56, 0x80, 0x67, 0x42, 0xcf, 0xdf, 0xe4, 0x23, 0x1a,
+	0x3e, 0xd8, 0x88, 0x5c, 0x39, 0xa2, 0x73, 0x37, 0xfa, 0x76, 0x8d, 0xbb, 0x90, 0x25, 0x3f, 0xe3,
+	0xc0, 0xa9, 0x38, 0x69, 0x6f, 0x78, 0x11, 0x6d, 0x28, 0x68, 0x3a, 0x31, 0xc2, 0xb7, 0xde, 0x87,
+	0x0f, 0xf7, 0x89, 0x96, 0x8a, 0xdd, 0x2e, 0xc6, 0x51, 0x90, 0xc5, 0xc9, 0x0a, 0xcd, 0xb2, 0x20,
+	0x6a, 0xa6, 0x33, 0x67, 0xef, 0xef, 0x4c, 0x9e, 0xea, 0xc2, 0xc2, 0xee, 0xf1, 0x90, 0xef, 0x86,
+	0xb1, 0x74, 0x3b, 0xf2, 0x6f, 0x07, 0x51, 0x23, 0xbe, 0x9b, 0x4e, 0x8c, 0x96, 0xb1, 0x7d, 0x57,
+	0x74, 0x87, 0x72, 0x03, 0x1a, 0x02, 0x68, 0x53, 0xeb, 0xfd, 0xe1, 0xcc, 0x52, 0xaa, 0x97, 0xfd,
+	0xe1, 0xcc, 0x62, 0xda, 0x85, 0x2c, 0xf9, 0x41, 0x07, 0x8e, 0xa5, 0x41, 0x33, 0xf2, 0xb2, 0x4e,
+	0x42, 0xaf, 0xd3, 0xed, 0x74, 0x02, 0xf8, 0x40, 0xae, 0x1d, 0x72, 0x56, 0xac, 0x2e, 0x67, 0xce,
+	0xca, 0x31, 0x1e, 0xb3, 0x5b, 0x53, 0xcc, 0xd3, 0xed, 0xb5, 0xd1, 0xcc, 0xb2, 0x1e, 0x2b, 0x77,
+	0xa3, 0x99, 0x45, 0xdd, 0x97, 0x24, 0xf9, 0x0e, 0x38, 0x29, 0x9a, 0xf4, 0xcc, 0xa6, 0x13, 0xe3,
+	0xfc, 0xa0, 0x3d, 0x73, 0x7f, 0x67, 0xf2, 0xe4, 0x4a, 0x01, 0x86, 0x5d, 0xd8, 0xe4, 0x55, 0x98,
+	0x6c, 0xd3, 0xa4, 0x15, 0x64, 0x4b, 0x51, 0xb8, 0xad, 0x8e, 0x6f, 0x3f, 0x6e, 0xd3, 0x86, 0x1c,
+	0x4e, 0x3a, 0x71, 0xec, 0xbc, 0xf3, 0xd6, 0xd1, 0x99, 0xb7, 0xc8, 0x61, 0x4e, 0x2e, 0xef, 0x8e,
+	0x8e, 0x7b, 0xf5, 0x47, 0x7e, 0xd5, 0x81, 0x73, 0xd6, 0x29, 0xbb, 0x42, 0x93, 0xad, 0xc0, 0xa7,
+	0xd3, 0xbe, 0x1f, 0x77, 0xa2, 0x2c, 0x9d, 0x38, 0xce, 0xa7, 0x71, 0xed, 0x28, 0xce, 0xfc, 0x3c,
+	0x29, 0xb3, 0x2e, 0xfb, 0xa2, 0xa4, 0xb8, 0xcb, 0x48, 0xc9, 0xa7, 0x1d, 0x80, 0xb8, 0xcd, 0xe4,
+	0x3b, 0xce, 0xac, 0x4e, 0xf0, 0x93, 0xe4, 0x66, 0x29, 0x87, 0xfd, 0x92, 0xee, 0x76, 0x39, 0x0e,
+	0x03, 0x7f, 0x7b, 0xe6, 0x38, 0x17, 0xa1, 0x74, 0x2b, 0x5a, 0x84, 0xc9, 0xcf, 0x3b, 0xf0, 0x54,
+	0xcb, 0x8b, 0xbc, 0x26, 0x6d, 0xe8, 0x2f, 0xbb, 0x4a, 0x5b, 0xed, 0xd0, 0xcb, 0x68, 0x3a, 0x71,
+	0x92, 0xcf, 0xe7, 0xad, 0xc3, 0x0d, 0x6b, 0xb1, 0x4f, 0xf7, 0x33, 0xdf, 0x20, 0xe7, 0xf0, 0xa9,
+	0x7e, 0x18, 0x29, 0xf6, 0x1f, 0x9b, 0xfb, 0x4f, 0x2a, 0x70, 0xb2, 0x28, 0x43, 0x91, 0xbf, 0xe4,
+	0xc0, 0x89, 0x3b, 0x77, 0xb3, 0xd5, 0x78, 0x93, 0x46, 0xe9, 0xcc, 0x36, 0xe3, 0x74, 0x5c, 0x7a,
+	0x18, 0xbb, 0xe8, 0x97, 0x2b, 0xad, 0x4d, 0x5d, 0xcb, 0x53, 0xb9, 0x14, 0x65, 0xc9, 0xf6, 0xcc,
+	0x93, 0xf2, 0x8d, 0x4e, 0x5c, 0xbb, 0xbd, 0x6a, 0x43, 0xb1, 0x38, 0xa8, 0x73, 0x9f, 0x75, 0xe0,
+	0x4c, 0xaf, 0x2e, 0xc8, 0x49, 0xa8, 0x6e, 0xd2, 0x6d, 0x21, 0xcb, 0x23, 0xfb, 0x97, 0x7c, 0x08,
+	0x6a, 0x5b, 0x5e, 0xd8, 0xa1, 0x52, 0xd0, 0xbd, 0x72, 0xb8, 0x17, 0xd1, 0x23, 0x43, 0xd1, 0xeb,
+	0xb7, 0x55, 0x5e, 0x76, 0xdc, 0xdf, 0xa8, 0xc2, 0x98, 0xb5, 0xec, 0x1f, 0x82, 0xf0, 0x1e, 0xe7,
+	0x84, 0xf7, 0xc5, 0xd2, 0x76, 0x6c, 0x5f, 0xe9, 0xfd, 0x6e, 0x41, 0x7a, 0x5f, 0x2a, 0x8f, 0xe4,
+	0xae, 0xe2, 0x3b, 0xc9, 0xa0, 0xae, 0xf7, 0xdb, 0xc4, 0x50, 0x19, 0x9f, 0x50, 0x6f, 0xe5, 0x99,
+	0x63, 0xf7, 0x77, 0x26, 0xeb, 0xfa, 0x27, 0x1a, 0x42, 0xee, 0x57, 0x1d, 0x38, 0x63, 0x8d, 0x71,
+	0x36, 0x8e, 0x1a, 0x01, 0xff, 0xb4, 0xe7, 0x61, 0x28, 0xdb, 0x6e, 0xab, 0xcb, 0xa2, 0x9e, 0xa9,
+	0xd5, 0xed, 0x36, 0x45, 0x0e, 0x61, 0x77, 0xbe, 0x16, 0x4d, 0x53, 0xaf, 0x49, 0x8b, 0xd7, 0xc3,
+	0x45, 0xd1, 0x8c, 0x0a, 0x4e, 0x12, 0x20, 0xa1, 0x97, 0x66, 0xab, 0x89, 0x17, 0xa5, 0xbc, 0xfb,
+	0xd5, 0xa0, 0x45, 0xe5, 0x04, 0xff, 0x5f, 0x83, 0xad, 0x18, 0xf6, 0xc4, 0xcc, 0x13, 0xf7, 0x77,
+	0x26, 0xc9, 0x42, 0x57, 0x4f, 0xd8, 0xa3, 0x77, 0xf7, 0x07, 0x2b, 0x70, 0x36, 0x77, 0x44, 0xb7,
+	0x69, 0xd4, 0xa0, 0x91, 0xbf, 0xcd, 0x5e, 0x2d, 0x32, 0xf7, 0x60, 0xfd, 0x6a, 0xfc, 0x02, 0xcc,
+	0x21, 0xe4, 0x02, 0xd4, 0xb5, 0xac, 0x20, 0x5f, 0xee, 0x94, 0x44, 0xab, 0x1b, 0x01, 0xc3, 0xe0,
+	0x90, 0x26, 0x0c, 0x6f, 0x50, 0x2f, 0xcc, 0x36, 0xa4, 0xfc, 0xbe, 0xa4, 0x3e, 0xf2, 0x55, 0xde,
+	0xfa, 0x60, 0x67, 0xf2, 0xdd, 0xbd, 0x54, 0x32, 0xcd, 0x20, 0x8b, 0xdb, 0xe9, 0x3b, 0x68, 0xd4,
+	0x0c, 0x22, 0xca, 0x2f, 0xf6, 0xa2, 0x97, 0x29, 0xf1, 0x98, 0x58, 0x21, 0xb3, 0x71, 0x83, 0xa2,
+	0xec, 0x9e, 0x5c, 0x84, 0x21, 0x26, 0x4c, 0xf1, 0x05, 0x52, 0x9f, 0x79, 0x56, 0x2f, 0xe0, 0xed,
+	0xc8, 0x7f, 0xb0, 0x33, 0x79, 0x9c, 0xfd, 0xb5, 0x9e, 0xe2, 0xb8, 0xee, 0x0f, 0x3b, 0xf0, 0x44,
+	0x6f, 0x66, 0x45, 0x9e, 0x87, 0x61, 0xa1, 0x33, 0x91, 0x93, 0x61, 0x16, 0x27, 0x6f, 0x45, 0x09,
+	0xdd, 0xff, 0x84, 0xa8, 0x39, 0xae, 0xf6, 0x9b, 0x63, 0xf7, 0xb7, 0x1d, 0xf8, 0xc6, 0x41, 0x58,
+	0xe8, 0xd1, 0x8d, 0x71, 0x05, 0xce, 0x36, 0xe8, 0xba, 0xd7, 0x09, 0xb3, 0x3c, 0x45, 0x39, 0xe8,
+	0x67, 0xe4, 0xc3, 0x67, 0xe7, 0x7a, 0x21, 0x61, 0xef, 0x67, 0xdd, 0x7f, 0xe3, 0xc0, 0x09, 0xeb,
+	0xb5, 0x1e, 0xc2, 0x35, 0x3c, 0xca, 0x5f, 0xc3, 0xe7, 0x4b, 0x3b, 0xb0, 0xfa, 0xdc, 0xc3, 0x3f,
+	0xef, 0xc0, 0x39, 0x0b, 0x6b, 0xd1, 0xcb, 0xfc, 0x8d, 0x4b, 0xf7, 0xda, 0x09, 0x4d, 0x53, 0xb6,
+	0xa4, 0x9e, 0xb1, 0x18, 0xd3, 0xcc, 0x98, 0xec, 0xa1, 0x7a, 0x9d, 0x6e, 0x0b, 0x2e, 0xf5, 0x02,
+	0x8c, 0x8a, 0xd3, 0x27, 0x4e, 0xe4, 0x47, 0xd2, 0xef, 0xb6, 0x24, 0xdb, 0x51, 0x63, 0x10, 0x17,
+	0x86, 0x39, 0xf7, 0x61, 0xa7, 0x31, 0x13, 0x39, 0x81, 0x7d, 0xf7, 0x5b, 0xbc, 0x05, 0x25, 0xc4,
+	0xfd, 0xbb, 0x0e, 0x67, 0xf0, 0x6a, 0x3c, 0xcb, 0x5e, 0x27, 0xa5, 0x6c, 0xd1, 0x24, 0xd4, 0x4b,
+	0xe3, 0xa8, 0xb8, 0x68, 0x90, 0xb7, 0xa2, 0x84, 0xb2, 0xe1, 0xb4, 0xd9, 0x03, 0x8d, 0x99, 0xed,
+	0xe2, 0x70, 0x96, 0x65, 0x3b, 0x6a, 0x0c, 0x72, 0x1d, 0x6a, 0x9d, 0x28, 0x0b, 0xc2, 0x03, 0x1c,
+	0x5d, 0x75, 0x36, 0x8f, 0x37, 0xd9, 0xc3, 0x28, 0xfa, 0x70, 0xd3, 0xdc, 0x34, 0x2e, 0x27, 0x94,
+	0xaf, 0xe3, 0xc6, 0xe5, 0x80, 0x86, 0x8d, 0x94, 0xbc, 0x08, 0x63, 0x5e, 0x14, 0xc5, 0x99, 0x14,
+	0xfc, 0x2c, 0xd5, 0xc6, 0xb4, 0x69, 0x46, 0x1b, 0x87, 0x4d, 0x56, 0xe8, 0xad, 0xd1, 0x50, 0xac,
+	0x04, 0x39, 0x59, 0x0b, 0xbc, 0x05, 0x25, 0xc4, 0xbd, 0x5f, 0xe1, 0x4a, 0x14, 0xcd, 0x93, 0xe8,
+	0xc3, 0xd0, 0xc0, 0x25, 0x39, 0x26, 0xbe, 0x5c, 0x1e, 0x47, 0xa5, 0xfd, 0xb5, 0x70, 0xaf, 0x15,
+	0xf8, 0x38, 0x96, 0x4a, 0x75, 0x77, 0x4d, 0xdc, 0x27, 0xaa, 0x30, 0x99, 0x7f, 0xa0, 0x4b, 0x0c,
+	0x20, 0x2f, 0xc1, 0x98, 0x45, 0xa8, 0xa8, 0x93, 0xb5, 0xf0, 0xd1, 0xc6, 0xeb, 0xc3, 0x49, 0x2b,
+	0x47, 0xc9, 0x49, 0x6d, 0x46, 0x5f, 0xdd, 0x83, 0xd1, 0x3f, 0xaf, 0x67, 0x7d, 0xa8, 0x70, 0x56,
+	0xe7, 0x85, 0x9d, 0xf3, 0x30, 0x94, 0x66, 0xb4, 0x3d, 0x51, 0xcb, 0xb3, 0x87, 0x95, 0x8c, 0xb6,
+	0x91, 0x43, 0xc8, 0xbb, 0xe1, 0x44, 0xe6, 0x25, 0x4d, 0x9a, 0x25, 0x74, 0x2b, 0xe0, 0xfa, 0x7b,
+	0xae, 0xd3, 0xa9, 0xcf, 0x9c, 0x66, 0x72, 0xf3, 0x2a, 0x07, 0xa1, 0x02, 0x61, 0x11, 0xd7, 0xfd,
+	0x8f, 0x15, 0x78, 0x32, 0xff, 0x09, 0x8c, 0x68, 0xf3, 0xde, 0x9c, 0x68, 0xf3, 0x76, 0x5b, 0xb4,
+	0x79, 0xb0, 0x33, 0xf9, 0xe6, 0x3e, 0x8f, 0xfd, 0xa9, 0x91, 0x7c, 0xc8, 0x95, 0xc2, 0x47, 0xb8,
+	0x90, 0xff, 0x08, 0x0f, 0x76, 0x26, 0x9f, 0xe9, 0xf3, 0x8e, 0x85, 0xaf, 0x64, 0x0e, 0xd1, 0xda,
+	0x6e, 0x87, 0xa8, 0xfb, 0x5b, 0xf5, 0xe2, 0x64, 0x5f, 0x11, 0x36, 0x89, 0x38, 0x21, 0x01, 0x0c,
+	0x71, 0xcd, 0x85, 0x38, 0x59, 0xae, 0x1f, 0x6e, 0x17, 0x32, 0xee, 0xa7, 0xbb, 0x9e, 0x19, 0x65,
+	0x5f, 0x8d, 0x35, 0x21, 0x27, 0x41, 0xee, 0xc1, 0xa8, 0xaf, 0x14, 0x0a, 0x95, 0x32, 0x54, 0xef,
+	0x52, 0x9d, 0x60, 0x28, 0x8e, 0x33, 0xbe, 0xa0, 0xb5, 0x10, 0x9a, 0x1a, 0xa1, 0x50, 0x6d, 0x06,
+	0x99, 0xfc, 0xac, 0x87, 0x54, 0x19, 0x5d, 0x09, 0xac, 0x57, 0x1c, 0x61, 0xbc, 0xf3, 0x4a, 0x90,
+	0x21, 0xeb, 0x9f, 0x7c, 0xda, 0x81, 0xb1, 0xd4, 0x6f, 0x2d, 0x27, 0xf1, 0x56, 0xd0, 0xa0, 0x89,
+	0xbc, 0x25, 0x1c, 0xf2, 0x64, 0x5b, 0x99, 0x5d, 0x54, 0x1d, 0x1a, 0xba, 0x42, 0x85, 0x67, 0x20,
+	0x68, 0xd3, 0x65, 0xb7, 0xe7, 0x27, 0xe5, 0xbb, 0xcf, 0x51, 0x9f, 0xef, 0x38, 0xa5, 0x37, 0xe2,
+	0x2b, 0xe5, 0xd0, 0xb7, 0xa6, 0xb9, 0x8e, 0xbf, 0xc9, 0xf6, 0x9b, 0x19, 0xd0, 0x9b, 0xef, 0xef,
+	0x4c, 0x3e, 0x39, 0xdb, 0x9b, 0x26, 0xf6, 0x1b, 0x0c, 0x9f, 0xb0, 0x76, 0x27, 0x0c, 0x91, 0xbe,
+	0xda, 0xa1, 0x5c, 0x2b, 0x5c, 0xc2, 0x84, 0x2d, 0x9b, 0x0e, 0x0b, 0x13, 0x66, 0x41, 0xd0, 0xa6,
+	0x4b, 0x5e, 0x85, 0xe1, 0x96, 0x97, 0x25, 0xc1, 0x3d, 0xa9, 0x0a, 0x5e, 0x3c, 0xac, 0xa6, 0x84,
+	0xf5, 0x65, 0x88, 0x73, 0x46, 0x2f, 0x1a, 0x51, 0x12, 0x22, 0x2d, 0xa8, 0xb5, 0x68, 0xd2, 0xa4,
+	0x13, 0xa3, 0x65, 0x98, 0xbd, 0x16, 0x59, 0x57, 0x86, 0x20, 0x17, 0x66, 0x78, 0x1b, 0x0a, 0x2a,
+	0xe4, 0x43, 0x30, 0x9a, 0xd2, 0x90, 0xfa, 0x4c, 0xac, 0xab, 0x73, 0x8a, 0xdf, 0x32, 0xa0, 0x88,
+	0xcb, 0xe4, 0x92, 0x15, 0xf9, 0xa8, 0xd8, 0x60, 0xea, 0x17, 0xea, 0x2e, 0xd9, 0x04, 0xb6, 0xc3,
+	0x4e, 0x33, 0x88, 0x26, 0xa0, 0x8c, 0x09, 0x5c, 0xe6, 0x7d, 0x15, 0x26, 0x50, 0x34, 0xa2, 0x24,
+	0xe4, 0xfe, 0x3b, 0x07, 0x48, 0xfe, 0x50, 0x7b, 0x08, 0xb2, 0xfc, 0xab, 0x79, 0x59, 0x7e, 0xa1,
+	0x4c, 0xa1, 0xa5, 0x8f, 0x38, 0xff, 0xf7, 0xeb, 0x50, 0x60, 0x07, 0x37, 0x68, 0x9a, 0xd1, 0xc6,
+	0xd7, 0x8f, 0xf0, 0xaf, 0x1f, 0xe1, 0x5f, 0x3f, 0xc2, 0xf5, 0x11, 0xbe, 0x56, 0x38, 0xc2, 0xdf,
+	0x63, 0xed, 0x7a, 0xe3, 0x63, 0xf2, 0x11, 0xed, 0x84, 0x62, 0x8f, 0xc0, 0x42, 0x60, 0x27, 0xc1,
+	0xb5, 0x95, 0xa5, 0x1b, 0x3d, 0xcf, 0xec, 0x8f, 0xe4, 0xcf, 0xec, 0xc3, 0x92, 0xf8, 0x3f, 0xe1,
+	0x94, 0xfe, 0x55, 0x07, 0xde, 0x92, 0x3f, 0xbd, 0xd4, 0xca, 0x99, 0x6f, 0x46, 0x71, 0x42, 0xe7,
+	0x82, 0xf5, 0x75, 0x9a, 0xd0, 0xc8, 0xa7, 0xe9, 0x00, 0x7a, 0xbf, 0x77, 0xc2, 0xf8, 0x9d, 0x34,
+	0x8e, 0x96, 0xe3, 0x20, 0x92, 0x47, 0x10, 0xbb, 0x71, 0x9c, 0xbc, 0xbf, 0x33, 0x39, 0xce, 0x66,
+	0x54, 0xb5, 0x63, 0x0e, 0x8b, 0xcc, 0xc2, 0xa9, 0x3b, 0xaf, 0x2e, 0x7b, 0x99, 0xa5, 0x05, 0x51,
+	0xfa, 0x0a, 0x6e, 0x93, 0xbd, 0xf6, 0xbe, 0x02, 0x10, 0xbb, 0xf1, 0xdd, 0x1f, 0xab, 0xc0, 0x53,
+	0x85, 0x17, 0x89, 0xc3, 0x30, 0xee, 0x64, 0xec, 0x4e, 0x44, 0x7e, 0xd2, 0x81, 0x93, 0xad, 0xbc,
+	0xa2, 0x25, 0x95, 0x06, 0x8b, 0xef, 0x2c, 0x8d, 0x47, 0x14, 0x34, 0x39, 0x33, 0x13, 0x72, 0x86,
+	0x4e, 0x16, 0x00, 0x29, 0x76, 0x8d, 0x85, 0x7c, 0x08, 0xea, 0x2d, 0xef, 0xde, 0xcd, 0x76, 0xc3,
+	0xcb, 0xd4, 0x75, 0xb4, 0xbf, 0x16, 0xa1, 0x93, 0x05, 0xe1, 0x94, 0xf0, 0x5e, 0x9a, 0x9a, 0x8f,
+	0xb2, 0xa5, 0x64, 0x25, 0x4b, 0x82, 0xa8, 0x29, 0xd4, 0xd4, 0x8b, 0xaa, 0x1b, 0x34, 0x3d, 0xba,
+	0x3f, 0xe1, 0x14, 0x99, 0x94, 0x9e, 0x9d, 0xc4, 0xcb, 0x68, 0x73, 0x9b, 0x7c, 0x0c, 0x6a, 0xec,
+	0xde, 0xa8, 0x66, 0xe5, 0x76, 0x99, 0x9c, 0xd3, 0xfa, 0x12, 0x86, 0x89, 0xb2, 0x5f, 0x29, 0x0a,
+	0xa2, 0xee, 0x4f, 0xd6, 0x8b, 0xc2, 0x02, 0xf7, 0x4f, 0xb9, 0x08, 0xd0, 0x8c, 0x95, 0x29, 0x8a,
+	0xaf, 0xbb, 0x51, 0xa3, 0x2a, 0xb9, 0xa2, 0x21, 0x68, 0x61, 0x91, 0xff, 0xc7, 0x01, 0x68, 0xaa,
+	0x35, 0xaf, 0x04, 0x81, 0x9b, 0x65, 0xbe, 0x8e, 0xd9, 0x51, 0x66, 0x2c, 0x9a, 0x20, 0x5a, 0xc4,
+	0xc9, 0xf7, 0x39, 0x30, 0x9a, 0xa9, 0xe1, 0x0b, 0xd6, 0xb8, 0x5a, 0xe6, 0x48, 0xb4, 0x89, 0x4f,
+	0xcb, 0x44, 0x7a, 0x4a, 0x34, 0x5d, 0xf2, 0x03, 0x0e, 0x40, 0xba, 0x1d, 0xf9, 0xc2, 0x4a, 0x29,
+	0x39, 0xe6, 0xad, 0x52, 0xd5, 0x39, 0xba, 0x77, 0x61, 0x03, 0x35, 0xbf, 0xd1, 0xa2, 0x4c, 0x3e,
+	0x0e, 0xa3, 0xa9, 0x5c, 0x6e, 0x92, 0x47, 0xae, 0x96, 0xab, 0x54, 0x12, 0x7d, 0xcb, 0xe3, 0x55,
+	0xfe, 0x42, 0x4d, 0x93, 0xfc, 0x88, 0x03, 0x27, 0xda, 0x79, 0x35, 0xa1, 0x64, 0x87, 0xe5, 0x9d,
+	0x01, 0x05, 0x35, 0xa4, 0xd0, 0xb6, 0x14, 0x1a, 0xb1, 0x38, 0x0a, 0x76, 0x02, 0x9a, 0x15, 0xbc,
+	0xd4, 0x16, 0x2a, 0xcb, 0x11, 0x73, 0x02, 0x5e, 0x29, 0x02, 0xb1, 0x1b, 0x9f, 0x2c, 0xc3, 0x19,
+	0x36, 0xba, 0x6d, 0x21, 0x7e, 0x2a, 0xf6, 0x92, 0x72, 0x66, 0x38, 0x3a, 0xf3, 0xb4, 0x5c, 0x21,
+	0xdc, 0x5a, 0x55, 0xc4, 0xc1, 0x9e, 0x4f, 0x92, 0xdf, 0x70, 0xe0, 0xe9, 0x80, 0xb3, 0x01, 0xdb,
+	0xd0, 0x60, 0x38, 0x82, 0x74, 0x36, 0xa1, 0xa5, 0x9e, 0x15, 0xfd, 0xd8, 0xcf, 0xcc, 0x37, 0xca,
+	0x37, 0x78, 0x7a, 0x7e, 0x97, 0x21, 0xe1, 0xae, 0x03, 0x26, 0xdf, 0x0a, 0xc7, 0xd4, 0xbe, 0x58,
+	0x66, 0x47, 0x30, 0x67, 0xb4, 0xf5, 0x99, 0x53, 0xf7, 0x77, 0x26, 0x8f, 0xad, 0xda, 0x00, 0xcc,
+	0xe3, 0xb9, 0xff, 0xb4, 0x9a, 0xb3, 0xf3, 0x69, 0x1d, 0x26, 0x3f, 0x6e, 0x7c, 0xa5, 0xff, 0x51,
+	0xa7, 0x67, 0xa9, 0xc7, 0x8d, 0xd6, 0x2e, 0x99, 0xe3, 0x46, 0x37, 0xa5, 0x68, 0x11, 0x67, 0x42,
+	0xe9, 0x29, 0xaf, 0xa8, 0x29, 0x95, 0x27, 0xe0, 0x87, 0xca, 0x1c, 0x52, 0xb7, 0x55, 0xf6, 0x29,
+	0x39, 0xb4, 0x53, 0x5d, 0x20, 0xec, 0x1e, 0x12, 0xf9, 0x1e, 0xa8, 0x27, 0xda, 0xbb, 0xab, 0x5a,
+	0xc6, 0x55, 0x4d, 0x2d, 0x1b, 0x39, 0x1c, 0x6d, 0xb8, 0x32, 0x7e, 0x5c, 0x86, 0xa2, 0xfb, 0xeb,
+	0x79, 0x83, 0x9e, 0x75, 0x76, 0x0c, 0x60, 0xb6, 0xfd, 0x82, 0x03, 0x63, 0x49, 0x1c, 0x86, 0x41,
+	0xd4, 0x64, 0xe7, 0x9c, 0x64, 0xd6, 0x1f, 0x3c, 0x12, 0x7e, 0x29, 0x0f, 0x34, 0x2e, 0x59, 0xa3,
+	0xa1, 0x89, 0xf6, 0x00, 0xdc, 0xdf, 0x77, 0x60, 0xa2, 0xdf, 0x79, 0x4c, 0x28, 0xbc, 0x59, 0x1d,
+	0x36, 0x7a, 0x2a, 0x96, 0xa2, 0x39, 0x1a, 0x52, 0xad, 0x36, 0x1f, 0x9d, 0x79, 0x4e, 0xbe, 0xe6,
+	0x9b, 0x97, 0xfb, 0xa3, 0xe2, 0x6e, 0xfd, 0x90, 0x0f, 0xc0, 0x49, 0xeb, 0xbd, 0x52, 0x3d, 0x31,
+	0xf5, 0x99, 0x29, 0x26, 0x00, 0x4d, 0x17, 0x60, 0x0f, 0x76, 0x26, 0x9f, 0x28, 0xb6, 0x49, 0x86,
+	0xd1, 0xd5, 0x8f, 0xfb, 0xb3, 0x95, 0xe2, 0xd7, 0xd2, 0xbc, 0xfe, 0x0d, 0xa7, 0x4b, 0x9b, 0xf0,
+	0x9d, 0x47, 0xc1, 0x5f, 0xb9, 0xde, 0x41, 0xbb, 0x22, 0xf5, 0xc7, 0x79, 0x84, 0x8e, 0x17, 0xee,
+	0x3f, 0x1b, 0x82, 0x5d, 0x46, 0x76, 0x14, 0x46, 0xfb, 0xcf, 0x39, 0xda, 0x60, 0x26, 0xf6, 0x70,
+	0xe3, 0xa8, 0xe6, 0x5e, 0xdc, 0x9f, 0x52, 0xe1, 0xfc, 0xa3, 0xb5, 0xe8, 0x79, 0xd3, 0x1c, 0xf9,
+	0xb2, 0x93, 0x37, 0xf9, 0x09, 0xc7, 0xde, 0xe0, 0xc8, 0xc6, 0x64, 0xd9, 0x11, 0xc5, 0xc0, 0x8c,
+	0xf5, 0xa9, 0x9f, 0x85, 0x71, 0x0a, 0x60, 0x3d, 0x88, 0xbc, 0x30, 0x78, 0x8d, 0xdd, 0x8e, 0x6a,
+	0x9c, 0xc1, 0x73, 0x89, 0xe9, 0xb2, 0x6e, 0x45, 0x0b, 0xe3, 0xdc, 0xff, 0x0d, 0x63, 0xd6, 0x9b,
+	0xf7, 0xf0, 0x59, 0x3a, 0x63, 0xfb, 0x2c, 0xd5, 0x2d, 0x57, 0xa3, 0x73, 0xef, 0x81, 0x93, 0xc5,
+	0x01, 0xee, 0xe7, 0x79, 0xf7, 0xbf, 0x8f, 0x14, 0x6d, 0x70, 0xab, 0x34, 0x69, 0xb1, 0xa1, 0x7d,
+	0x5d, 0xb1, 0xf5, 0x75, 0xc5, 0xd6, 0xd7, 0x15, 0x5b, 0xb6, 0x6d, 0x42, 0x2a, 0x6d, 0x46, 0x1e,
+	0x92, 0xd2, 0x26, 0xa7, 0x86, 0x1a, 0x2d, 0x5d, 0x0d, 0xe5, 0x7e, 0xba, 0x4b, 0x73, 0xbf, 0x9a,
+	0x50, 0x4a, 0x62, 0xa8, 0x45, 0x71, 0x83, 0x2a, 0x19, 0xf7, 0x5a, 0x39, 0x02, 0xdb, 0x8d, 0xb8,
+	0x61, 0x85, 0x4c, 0xb0, 0x5f, 0x29, 0x0a, 0x3a, 0xee, 0xfd, 0x1a, 0xe4, 0xc4, 0x49, 0xf1, 0xdd,
+	0xdf, 0x06, 0x23, 0x09, 0x6d, 0xc7, 0x37, 0x71, 0x41, 0xf2, 0x32, 0x13, 0x55, 0x25, 0x9a, 0x51,
+	0xc1, 0x19, 0xcf, 0x6b, 0x7b, 0xd9, 0x86, 0x64, 0x66, 0x9a, 0xe7, 0x2d, 0x7b, 0xd9, 0x06, 0x72,
+	0x08, 0x79, 0x0f, 0x1c, 0xcf, 0x72, 0xa6, 0x70, 0x69, 0xf2, 0x7d, 0x42, 0xe2, 0x1e, 0xcf, 0x1b,
+	0xca, 0xb1, 0x80, 0x4d, 0x5e, 0x85, 0xa1, 0x0d, 0x1a, 0xb6, 0xe4, 0xa7, 0x5f, 0x29, 0x8f, 0xd7,
+	0xf0, 0x77, 0xbd, 0x4a, 0xc3, 0x96, 0x38, 0x09, 0xd9, 0x7f, 0xc8, 0x49, 0xb1, 0x75, 0x5f, 0xdf,
+	0xec, 0xa4, 0x59, 0xdc, 0x0a, 0x5e, 0x53, 0x9a, 0xce, 0xef, 0x2c, 0x99, 0xf0, 0x75, 0xd5, 0xbf,
+	0x50, 0x29, 0xe9, 0x9f, 0x68, 0x28, 0xf3, 0x71, 0x34, 0x82, 0x84, 0x2f, 0x99, 0x6d, 0xa9, 0xb0,
+	0x2c, 0x7b, 0x1c, 0x73, 0xaa, 0x7f, 0x31, 0x0e, 0xfd, 0x13, 0x0d, 0x65, 0xb2, 0xad, 0xf7, 0xdf,
+	0x58, 0x19, 0xce, 0xdd, 0x5d, 0x63, 0x10, 0x7b, 0xaf, 0xe7, 0x3e, 0x7c, 0x0e, 0x6a, 0xfe, 0x86,
+	0x97, 0x64, 0x13, 0xe3, 0x7c, 0xd1, 0xe8, 0x55, 0x3c, 0xcb, 0x1a, 0x51, 0xc0, 0xc8, 0x33, 0x50,
+	0x4d, 0xe8, 0x3a, 0xf7, 0xd0, 0xb7, 0xfc, 0xb9, 0x90, 0xae, 0x23, 0x6b, 0x77, 0x7f, 0xaa, 0x92,
+	0x17, 0xdb, 0xf2, 0xef, 0x2d, 0x56, 0xbb, 0xdf, 0x49, 0x52, 0xa5, 0xfe, 0xb2, 0x56, 0x3b, 0x6f,
+	0x46, 0x05, 0x27, 0x9f, 0x74, 0x60, 0xe4, 0x4e, 0x1a, 0x47, 0x11, 0xcd, 0x24, 0x8b, 0xbc, 0x55,
+	0xf2, 0x54, 0x5c, 0x13, 0xbd, 0x9b, 0x31, 0xc8, 0x06, 0x54, 0x74, 0xd9, 0x70, 0xe9, 0x3d, 0x3f,
+	0xec, 0x34, 0xba, 0x5c, 0x5d, 0x2e, 0x89, 0x66, 0x54, 0x70, 0x86, 0x1a, 0x44, 0x02, 0x75, 0x28,
+	0x8f, 0x3a, 0x1f, 0x49, 0x54, 0x09, 0x77, 0xff, 0xff, 0xe1, 0x9c, 0x2b, 0xaa, 0xd9, 0x1c, 0x4c,
+	0xa0, 0xe2, 0x22, 0xcb, 0xe5, 0x20, 0xa4, 0xca, 0xc9, 0x8b, 0x0b, 0x54, 0xb7, 0x74, 0x2b, 0x5a,
+	0x18, 0xe4, 0x7b, 0x01, 0xda, 0x5e, 0xe2, 0xb5, 0xa8, 0x56, 0x4f, 0x1f, 0x5a, 0x6e, 0x61, 0xe3,
+	0x58, 0x56, 0x7d, 0x9a, 0x2b, 0xba, 0x6e, 0x4a, 0xd1, 0x22, 0x49, 0x5e, 0x82, 0xb1, 0x84, 0x86,
+	0xd4, 0x4b, 0x79, 0x80, 0x47, 0x31, 0x5a, 0x0d, 0x0d, 0x08, 0x6d, 0x3c, 0xf2, 0xbc, 0xf6, 0xe3,
+	0x2b, 0xf8, 0x05, 0xe5, 0x7d, 0xf9, 0xc8, 0xeb, 0x0e, 0x1c, 0x5f, 0x0f, 0x42, 0x6a, 0xa8, 0xcb,
+	0xd8, 0xb2, 0xa5, 0xc3, 0xbf, 0xe4, 0x65, 0xbb, 0x5f, 0x73, 0x42, 0xe6, 0x9a, 0x53, 0x2c, 0x90,
+	0x67, 0x9f, 0x79, 0x8b, 0x26, 0xfc, 0x68, 0x1d, 0xce, 0x7f, 0xe6, 0x5b, 0xa2, 0x19, 0x15, 0x9c,
+	0x4c, 0xc3, 0x89, 0xb6, 0x97, 0xa6, 0xb3, 0x09, 0x6d, 0xd0, 0x28, 0x0b, 0xbc, 0x50, 0x44, 0x7e,
+	0x8d, 0x1a, 0x77, 0xff, 0xe5, 0x3c, 0x18, 0x8b, 0xf8, 0xe4, 0xfd, 0xf0, 0xa4, 0xd0, 0xff, 0x2c,
+	0x06, 0x69, 0x1a, 0x44, 0x4d, 0xb3, 0x0c, 0xa4, 0x1a, 0x6c, 0x52, 0x76, 0xf5, 0xe4, 0x7c, 0x6f,
+	0x34, 0xec, 0xf7, 0x3c, 0x79, 0x01, 0x46, 0xd3, 0xcd, 0xa0, 0x3d, 0x9b, 0x34, 0x52, 0x6e, 0xfb,
+	0x19, 0x35, 0x4a, 0xd7, 0x15, 0xd9, 0x8e, 0x1a, 0x83, 0xf8, 0x30, 0x2e, 0x3e, 0x89, 0x70, 0xe8,
+	0x93, 0xe7, 0xe3, 0x3b, 0xfa, 0xb2, 0x69, 0x19, 0xc8, 0x3c, 0x85, 0xde, 0xdd, 0x4b, 0xca, 0x12,
+	0x25, 0x0c, 0x27, 0xb7, 0xac, 0x6e, 0x30, 0xd7, 0xa9, 0xfb, 0xa3, 0x95, 0xfc, 0xcd, 0xdf, 0xde,
+	0xa4, 0x24, 0x65, 0x5b, 0x31, 0xbb, 0xe5, 0x25, 0x8a, 0x61, 0x1f, 0x32, 0x40, 0x4d, 0xf6, 0x7b,
+	0xcb, 0x4b, 0xec, 0x4d, 0xcd, 0x09, 0xa0, 0xa2, 0x44, 0xee, 0xc0, 0x50, 0x16, 0x7a, 0x25, 0x45,
+	0xb4, 0x5a, 0x14, 0x8d, 0x22, 0x66, 0x61, 0x3a, 0x45, 0x4e, 0x83, 0x3c, 0xcd, 0x6e, 0x1f, 0x6b,
+	0xca, 0x52, 0x24, 0x2f, 0x0c, 0x6b, 0x29, 0xf2, 0x56, 0xf7, 0x17, 0xc7, 0x7a, 0x9c, 0xab, 0x9a,
+	0x91, 0x91, 0x8b, 0x00, 0xec, 0x22, 0xbb, 0x9c, 0xd0, 0xf5, 0xe0, 0x9e, 0x14, 0x24, 0xf4, 0xde,
+	0xbd, 0xa1, 0x21, 0x68, 0x61, 0xa9, 0x67, 0x56, 0x3a, 0xeb, 0xec, 0x99, 0x4a, 0xf7, 0x33, 0x02,
+	0x82, 0x16, 0x16, 0x79, 0x27, 0x0c, 0x07, 0x2d, 0xaf, 0xa9, 0x1d, 0x70, 0x9f, 0x66, 0x9b, 0x76,
+	0x9e, 0xb7, 0x3c, 0xd8, 0x99, 0x3c, 0xae, 0x07, 0xc4, 0x9b, 0x50, 0xe2, 0x92, 0x9f, 0x75, 0x60,
+	0xdc, 0x8f, 0x5b, 0xad, 0x38, 0x12, 0xd7, 0x3f, 0x79, 0x97, 0xbd, 0x73, 0x54, 0x6c, 0x7e, 0x6a,
+	0xd6, 0x22, 0x26, 0x2e, 0xb3, 0x3a, 0xf4, 0xd6, 0x06, 0x61, 0x6e, 0x54, 0xf6, 0xde, 0xae, 0xed,
+	0xb1, 0xb7, 0x7f, 0xc1, 0x81, 0x53, 0xe2, 0x59, 0xeb, 0x56, 0x2a, 0xa3, 0x4c, 0xe3, 0x23, 0x7e,
+	0xad, 0xae, 0x8b, 0xba, 0x56, 0x56, 0x76, 0xc1, 0xb1, 0x7b, 0x90, 0xe4, 0x0a, 0x9c, 0x5a, 0x8f,
+	0x13, 0x9f, 0xda, 0x13, 0x21, 0x0f, 0x26, 0xdd, 0xd1, 0xe5, 0x22, 0x02, 0x76, 0x3f, 0x43, 0x6e,
+	0xc1, 0x13, 0x56, 0xa3, 0x3d, 0x0f, 0xe2, 0x6c, 0x52, 0xd1, 0x08, 0x4f, 0x5c, 0xee, 0x89, 0x85,
+	0x7d, 0x9e, 0x66, 0x42, 0x2c, 0x87, 0x68, 0x25, 0x8d, 0x3c, 0x9f, 0xcc, 0x11, 0x9d, 0x83, 0x62,
+	0x01, 0x3b, 0xaf, 0xf8, 0x81, 0x01, 0x14, 0x3f, 0x1f, 0x81, 0xa7, 0xfc, 0xee, 0x99, 0xdd, 0x4a,
+	0x3b, 0x6b, 0x3c, 0xc4, 0x92, 0xd1, 0xd6, 0x31, 0x67, 0xb3, 0xfd, 0x10, 0xb1, 0x7f, 0x1f, 0xe4,
+	0x63, 0x30, 0x9a, 0x50, 0xfe, 0x55, 0x45, 0xac, 0xe4, 0xa1, 0x6f, 0xfb, 0x46, 0x82, 0x15, 0xdd,
+	0x9a, 0xb3, 0x5b, 0x36, 0xa4, 0xa8, 0x29, 0x92, 0xbb, 0x30, 0xd2, 0xf6, 0x32, 0x7f, 0x83, 0xa6,
+	0x13, 0xc7, 0xca, 0xd0, 0x4d, 0x6b, 0xe2, 0xdc, 0x94, 0x60, 0xa5, 0x76, 0x10, 0x44, 0x50, 0x51,
+	0x63, 0xd2, 0x8c, 0x1f, 0xb7, 0xda, 0x71, 0x44, 0x55, 0x90, 0xa5, 0x94, 0x66, 0x66, 0x75, 0x2b,
+	0x5a, 0x18, 0x64, 0x19, 0xce, 0x70, 0xdd, 0xd7, 0xed, 0x20, 0xdb, 0x88, 0x3b, 0x99, 0xba, 0xca,
+	0xf1, 0x28, 0x47, 0xcb, 0xe2, 0xb3, 0xd0, 0x03, 0x07, 0x7b, 0x3e, 0x79, 0xee, 0xbd, 0x70, 0xaa,
+	0xeb, 0x28, 0xd8, 0x97, 0xda, 0x69, 0x0e, 0x9e, 0xe8, 0xbd, 0xe9, 0xf6, 0xa5, 0x7c, 0xfa, 0xdb,
+	0x05, 0xef, 0x63, 0x4b, 0x10, 0x1f, 0x40, 0x91, 0xe9, 0x41, 0x95, 0x46, 0x5b, 0x92, 0x07, 0x5d,
+	0x3e, 0xdc, 0xb7, 0xbb, 0x14, 0x6d, 0x89, 0x33, 0x83, 0x6b, 0x6b, 0x2e, 0x45, 0x5b, 0xc8, 0xfa,
+	0x26, 0x5f, 0x72, 0x72, 0x82, 0xa4, 0x50, 0x7f, 0x7e, 0xf8, 0x48, 0x6e, 0x1e, 0x03, 0xcb, 0x96,
+	0xee, 0x3f, 0xaf, 0xc0, 0xf9, 0xbd, 0x3a, 0x19, 0x60, 0xfa, 0x9e, 0x83, 0xe1, 0x94, 0xfb, 0x13,
+	0xc8, 0x43, 0x7d, 0x8c, 0xad, 0x55, 0xe1, 0x61, 0xf0, 0x11, 0x94, 0x20, 0x12, 0x42, 0xb5, 0xe5,
+	0xb5, 0xa5, 0x56, 0x6c, 0xfe, 0xb0, 0x71, 0x76, 0xec, 0xb7, 0x17, 0x2e, 0x7a, 0x6d, 0xa1, 0x6b,
+	0xb1, 0x1a, 0x90, 0x91, 0x21, 0x19, 0xd4, 0xbc, 0x24, 0xf1, 0x94, 0xf1, 0xfa, 0x7a, 0x39, 0xf4,
+	0xa6, 0x59, 0x97, 0xc2, 0xf6, 0x97, 0x6b, 0x42, 0x41, 0xcc, 0xfd, 0x54, 0x3d, 0x17, 0x8a, 0xc4,
+	0x3d, 0x12, 0x52, 0x18, 0x96, 0xca, 0x30, 0xa7, 0xec, 0xf0, 0x46, 0x11, 0x37, 0xce, 0xef, 0x99,
+	0x32, 0xfb, 0x86, 0x24, 0x45, 0x3e, 0xeb, 0xf0, 0x1c, 0x17, 0x2a, 0xbe, 0x4b, 0xde, 0xee, 0x8e,
+	0x26, 0xe5, 0x86, 0x9d, 0x39, 0x43, 0x35, 0xa2, 0x4d, 0x5d, 0xe6, 0xaa, 0xe1, 0x52, 0x6d, 0x77,
+	0xae, 0x1a, 0x2e, 0xa5, 0x2a, 0x38, 0xb9, 0xd7, 0xc3, 0xf3, 0xa0, 0x84, 0x3c, 0x09, 0x03, 0xf8,
+	0x1a, 0x7c, 0xd9, 0x81, 0x53, 0x41, 0xd1, 0x84, 0x2c, 0xef, 0x42, 0xb7, 0xcb, 0xd1, 0x5c, 0x75,
+	0x5b, 0xa8, 0xb5, 0x38, 0xd0, 0x05, 0xc2, 0xee, 0xc1, 0x90, 0x06, 0x0c, 0x05, 0xd1, 0x7a, 0x2c,
+	0x85, 0xa0, 0x99, 0xc3, 0x0d, 0x6a, 0x3e, 0x5a, 0x8f, 0xcd, 0x6e, 0x66, 0xbf, 0x90, 0xf7, 0x4e,
+	0x16, 0xe0, 0x8c, 0x8a, 0xea, 0xb8, 0x1a, 0xa4, 0x59, 0x9c, 0x6c, 0x2f, 0x04, 0xad, 0x20, 0xe3,
+	0x02, 0x4c, 0x75, 0x66, 0x82, 0xf1, 0x07, 0xec, 0x01, 0xc7, 0x9e, 0x4f, 0x91, 0xd7, 0x60, 0x44,
+	0x99, 0x6d, 0x47, 0xcb, 0xb8, 0x57, 0x76, 0xaf, 0x7f, 0xbd, 0x98, 0x56, 0xa4, 0xdd, 0x56, 0x11,
+	0x24, 0x9f, 0x72, 0xa0, 0xde, 0xe0, 0x51, 0xa8, 0xe9, 0x52, 0x24, 0x5d, 0x0f, 0x56, 0x4a, 0xdc,
+	0x03, 0x2a, 0xbe, 0xd5, 0x08, 0x3f, 0x73, 0x8a, 0x1a, 0x1a, 0xc2, 0x24, 0x86, 0x1a, 0x8f, 0x67,
+	0x93, 0x57, 0xba, 0x1b, 0xe5, 0xb9, 0x8e, 0xb0, 0x5e, 0x85, 0xd3, 0x21, 0xff, 0x17, 0x05, 0x1d,
+	0xf7, 0xf5, 0x31, 0xe8, 0xb6, 0xaa, 0xe7, 0x4d, 0xe8, 0xce, 0xc3, 0x36, 0xa1, 0xb3, 0x8b, 0x5e,
+	0x6a, 0xac, 0xdf, 0x25, 0xec, 0x69, 0x49, 0x75, 0xdc, 0x8e, 0xc8, 0x15, 0xf1, 0xb7, 0x24, 0xc9,
+	0x05, 0x07, 0x1f, 0x5a, 0xf3, 0x6c, 0xc7, 0x06, 0x1b, 0x45, 0x8a, 0x68, 0xd5, 0x71, 0xc2, 0xf7,
+	0x60, 0x64, 0x43, 0x2c, 0x7c, 0x79, 0xf7, 0x5a, 0x3c, 0xec, 0xe4, 0xe6, 0x76, 0x93, 0x59, 0xe6,
+	0xb2, 0x01, 0x15, 0x39, 0xee, 0xae, 0x65, 0x39, 0x94, 0x88, 0x23, 0xab, 0xbc, 0xe8, 0xbb, 0xc1,
+	0xbd, 0x49, 0x3e, 0x0a, 0xe3, 0x09, 0xf5, 0xe3, 0xc8, 0x0f, 0x42, 0xda, 0x98, 0x56, 0x06, 0x96,
+	0xfd, 0x04, 0x5d, 0x71, 0xfd, 0x05, 0x5a, 0x7d, 0x60, 0xae, 0x47, 0xf2, 0x19, 0x07, 0x8e, 0xeb,
+	0x50, 0x7a, 0xf6, 0x41, 0xa8, 0x54, 0xa4, 0x2f, 0x94, 0x14, 0xb8, 0xcf, 0xfb, 0x9c, 0x21, 0xec,
+	0x0e, 0x94, 0x6f, 0xc3, 0x02, 0x5d, 0xf2, 0x01, 0x80, 0x78, 0x4d, 0xf8, 0x64, 0x4d, 0x67, 0x52,
+	0xab, 0xbe, 0x9f, 0x57, 0x15, 0xb9, 0x3f, 0x74, 0x0f, 0x68, 0xf5, 0x46, 0xae, 0x03, 0x88, 0x6d,
+	0xb3, 0xba, 0xdd, 0x16, 0x77, 0x33, 0x13, 0x35, 0x07, 0x2b, 0x1a, 0xf2, 0x60, 0x67, 0xb2, 0x5b,
+	0xcb, 0xc9, 0x1d, 0x4f, 0xac, 0xc7, 0xc9, 0x77, 0xc3, 0x48, 0xda, 0x69, 0xb5, 0x3c, 0xad, 0x73,
+	0x2f, 0x31, 0x1c, 0x54, 0xf4, 0x6b, 0x1d, 0xc1, 0xa2, 0x01, 0x15, 0x45, 0x72, 0x87, 0x31, 0x93,
+	0x54, 0xaa, 0x5f, 0xf9, 0x2e, 0x12, 0xb2, 0xd0, 0x18, 0x7f, 0xa7, 0x77, 0xa9, 0x0b, 0x07, 0xf6,
+	0xc0, 0x79, 0xb0, 0x33, 0xf9, 0x44, 0xbe, 0x7d, 0x21, 0x96, 0x01, 0x9a, 0x3d, 0xfb, 0x24, 0xd7,
+	0x54, 0x6e, 0x32, 0xf6, 0xda, 0x2a, 0x65, 0xce, 0x5b, 0x4d, 0x6e, 0x32, 0xde, 0xdc, 0x7f, 0xce,
+	0xec, 0x87, 0xc9, 0x22, 0x9c, 0xf6, 0xe3, 0x28, 0x4b, 0xe2, 0x30, 0x14, 0xb9, 0xf9, 0xc4, 0x5d,
+	0x57, 0xe8, 0xe4, 0xdf, 0x2c, 0x87, 0x7d, 0x7a, 0xb6, 0x1b, 0x05, 0x7b, 0x3d, 0xe7, 0xfe, 0x40,
+	0x35, 0x6f, 0x20, 0x93, 0xb3, 0xf3, 0x4e, 0x18, 0xa7, 0xf7, 0x32, 0x9a, 0x44, 0x5e, 0x78, 0x13,
+	0x17, 0x94, 0x3a, 0x9a, 0x6f, 0x82, 0x4b, 0x56, 0x3b, 0xe6, 0xb0, 0x88, 0xab, 0x35, 0x44, 0x56,
+	0xd4, 0xb1, 0xd0, 0x10, 0x69, 0x7d, 0xd0, 0x8f, 0x39, 0x70, 0xca, 0xdf, 0x08, 0xc2, 0x86, 0xed,
+	0x34, 0x23, 0x4f, 0xc3, 0x43, 0x2a, 0xf9, 0x67, 0x8b, 0xdd, 0xaa, 0x55, 0xc0, 0x1d, 0x0f, 0xbb,
+	0xa0, 0xd8, 0x3d, 0x0e, 0x6e, 0x9b, 0xf7, 0xda, 0x9e, 0x1f, 0x64, 0x4a, 0xc6, 0xbb, 0x51, 0x0e,
+	0x27, 0x9a, 0x95, 0xbd, 0x4a, 0xdb, 0xbc, 0xfc, 0x85, 0x9a, 0x9a, 0xfb, 0x3f, 0x2a, 0x39, 0x09,
+	0xfd, 0x91, 0x98, 0x29, 0x79, 0xea, 0x2b, 0x95, 0x23, 0x8c, 0x03, 0xe4, 0xcd, 0xb3, 0x4c, 0xca,
+	0x3a, 0xf5, 0xd5, 0x92, 0x4d, 0x08, 0xf3, 0x74, 0xc9, 0x26, 0xd4, 0x36, 0xe2, 0x34, 0x53, 0xf7,
+	0xd1, 0x43, 0x5e, 0x7d, 0xaf, 0xc6, 0x69, 0xc6, 0xc5, 0x4a, 0xfd, 0xda, 0xac, 0x25, 0x45, 0x41,
+	0xc3, 0xfd, 0xf7, 0x4e, 0xce, 0x28, 0x73, 0x9b, 0x7b, 0xb4, 0x6f, 0xd1, 0x88, 0x9d, 0x77, 0xb6,
+	0x0f, 0xdd, 0xb7, 0x16, 0xe2, 0x83, 0xdf, 0xd2, 0x2f, 0x27, 0xe7, 0x5d, 0xd6, 0xc3, 0x14, 0xef,
+	0xc2, 0x72, 0xb7, 0xfb, 0x84, 0x93, 0x0f, 0xf4, 0xae, 0x94, 0x71, 0xe3, 0xb4, 0x93, 0x34, 0xec,
+	0x19, 0x33, 0xee, 0x7e, 0xc9, 0x81, 0x91, 0x19, 0xcf, 0xdf, 0x8c, 0xd7, 0xd7, 0xc9, 0x0b, 0x30,
+	0xda, 0xe8, 0x24, 0x76, 0xcc, 0xb9, 0xd6, 0x24, 0xcd, 0xc9, 0x76, 0xd4, 0x18, 0x6c, 0x6f, 0xaf,
+	0x7b, 0xbe, 0x4a, 0xd5, 0x50, 0x15, 0x7b, 0xfb, 0x32, 0x6f, 0x41, 0x09, 0x21, 0x2f, 0xc1, 0x58,
+	0xcb, 0xbb, 0xa7, 0x1e, 0x2e, 0x5a, 0x84, 0x16, 0x0d, 0x08, 0x6d, 0x3c, 0xf7, 0x1f, 0x39, 0x30,
+	0x31, 0xe3, 0xa5, 0x81, 0x3f, 0xdd, 0xc9, 0x36, 0x66, 0x82, 0x6c, 0xad, 0xe3, 0x6f, 0xd2, 0x4c,
+	0xa4, 0xf4, 0x60, 0xa3, 0xec, 0xa4, 0xec, 0x88, 0xd1, 0x17, 0x7d, 0x3d, 0xca, 0x9b, 0xb2, 0x1d,
+	0x35, 0x06, 0x79, 0x0d, 0xc6, 0xda, 0x5e, 0x9a, 0xde, 0x8d, 0x93, 0x06, 0xd2, 0xf5, 0x72, 0xd2,
+	0x1f, 0xad, 0x50, 0x3f, 0xa1, 0x19, 0xd2, 0x75, 0xe9, 0x3d, 0x61, 0xfa, 0x47, 0x9b, 0x98, 0xfb,
+	0x05, 0x07, 0x9e, 0x9a, 0xa1, 0x5e, 0x42, 0x13, 0x9e, 0x2d, 0x49, 0xbf, 0xc8, 0x6c, 0x18, 0x77,
+	0x1a, 0xe4, 0x55, 0x18, 0xcd, 0x58, 0x33, 0x1b, 0x96, 0x53, 0xee, 0xb0, 0xf8, 0x91, 0xb2, 0x2a,
+	0x3b, 0x47, 0x4d, 0xc6, 0x7d, 0xbd, 0x0a, 0x67, 0x67, 0xbd, 0x76, 0xd6, 0x49, 0x68, 0x83, 0x31,
+	0x04, 0x8f, 0x2d, 0xd0, 0x85, 0xb8, 0x99, 0x92, 0xe7, 0xa0, 0xd6, 0x4c, 0xe2, 0x4e, 0x5b, 0xce,
+	0xa8, 0xde, 0x15, 0x3c, 0x4d, 0x1c, 0x0a, 0x18, 0x39, 0x0f, 0x43, 0x9b, 0x41, 0xd4, 0x28, 0xba,
+	0x1c, 0x5c, 0x0f, 0xa2, 0x06, 0x72, 0x48, 0x5e, 0xdb, 0x5a, 0xdd, 0x47, 0x2a, 0x98, 0xa1, 0xbe,
+	0x1a, 0x1b, 0x76, 0x23, 0x8f, 0x79, 0xee, 0xae, 0xa2, 0x1e, 0x7e, 0x59, 0x34, 0xa3, 0x82, 0x33,
+	0xea, 0xbe, 0x7a, 0x2b, 0x69, 0x90, 0xd3, 0xd4, 0xf5, 0xeb, 0xa2, 0xc1, 0x61, 0xd4, 0xc3, 0xb8,
+	0x29, 0x14, 0xde, 0x16, 0x75, 0x36, 0x23, 0xc8, 0x21, 0xe4, 0xc3, 0x00, 0xbe, 0x9c, 0xb0, 0x03,
+	0x89, 0x4e, 0x46, 0x0e, 0xd5, 0xbd, 0xa0, 0xd5, 0xa3, 0xfb, 0xe7, 0x1d, 0x18, 0xe7, 0x16, 0xf5,
+	0x39, 0x9a, 0x79, 0x41, 0xd8, 0x95, 0xf1, 0xd3, 0x19, 0x30, 0xe3, 0xe7, 0x79, 0x18, 0xda, 0x88,
+	0x5b, 0xb4, 0xf8, 0x69, 0xae, 0xc6, 0x6c, 0x1e, 0x19, 0x84, 0xbc, 0xc8, 0xb6, 0x62, 0x20, 0xdf,
+	0x5c, 0x59, 0x6c, 0x4e, 0x88, 0x6d, 0xa8, 0x9b, 0xd1, 0xc6, 0x71, 0xdf, 0xa8, 0xc1, 0x44, 0x3f,
+	0x16, 0xca, 0x56, 0x4c, 0x16, 0x67, 0x5e, 0xc8, 0x87, 0x58, 0x35, 0x2b, 0x66, 0x95, 0x35, 0xa2,
+	0x80, 0x91, 0x9f, 0x76, 0x60, 0x3c, 0xd5, 0x17, 0x20, 0xcd, 0x3d, 0x36, 0x8e, 0x86, 0xad, 0x5b,
+	0x77, 0x2d, 0x5a, 0xb4, 0xf4, 0xd8, 0x20, 0xcc, 0x8d, 0x89, 0xfc, 0x55, 0x07, 0x8e, 0x6f, 0x58,
+	0xf7, 0x26, 0xed, 0xb6, 0x7d, 0xe7, 0x88, 0x86, 0x79, 0x35, 0x47, 0x4c, 0x0c, 0x54, 0xdb, 0x33,
+	0xf2, 0x40, 0x2c, 0x8c, 0x8c, 0xb1, 0x8c, 0x91, 0x75, 0x2f, 0x08, 0x83, 0xa8, 0x29, 0x2f, 0x6f,
+	0x87, 0xf4, 0x09, 0xb9, 0x2c, 0x3a, 0x2b, 0x0e, 0xd6, 0x6c, 0x33, 0x89, 0x80, 0x8a, 0xec, 0xb9,
+	0xf7, 0xc2, 0xa9, 0xae, 0x89, 0xde, 0x4b, 0x03, 0x5e, 0xb5, 0xf5, 0xe8, 0xd3, 0x70, 0xba, 0xc7,
+	0x14, 0xec, 0xa7, 0x0b, 0xf7, 0x93, 0x00, 0x23, 0xd2, 0xab, 0x6e, 0xe0, 0x1c, 0x50, 0xea, 0xac,
+	0xa9, 0xf4, 0x3d, 0x6b, 0x52, 0x18, 0xf6, 0x79, 0x56, 0x6c, 0x29, 0x7e, 0x5e, 0x2f, 0xc5, 0x0d,
+	0x53, 0x24, 0xda, 0x36, 0xc3, 0x12, 0xbf, 0x51, 0x92, 0x22, 0x5f, 0x74, 0xe0, 0x84, 0x1f, 0x47,
+	0x11, 0xf5, 0xcd, 0x4d, 0x71, 0xa8, 0x0c, 0x6f, 0xbb, 0xd9, 0x7c, 0xa7, 0xc6, 0xd3, 0xa0, 0x00,
+	0xc0, 0x22, 0x79, 0xf2, 0x0a, 0x1c, 0x13, 0x73, 0x76, 0x2b, 0x67, 0x01, 0x35, 0x39, 0x4a, 0x6d,
+	0x20, 0xe6, 0x71, 0xc9, 0x94, 0xb0, 0x24, 0xcb, 0x6c, 0xa0, 0xc3, 0xc6, 0xd0, 0x63, 0xe5, 0x01,
+	0xb5, 0x30, 0x48, 0x02, 0x24, 0xa1, 0xeb, 0x09, 0x4d, 0x37, 0xa4, 0xd7, 0x21, 0x3f, 0x6a, 0x47,
+	0x0e, 0x96, 0x05, 0x05, 0xbb, 0x7a, 0xc2, 0x1e, 0xbd, 0x93, 0x4d, 0xa9, 0x9e, 0x1c, 0x2d, 0x43,
+	0xe0, 0x92, 0x9f, 0xb9, 0xaf, 0x96, 0x72, 0x12, 0x6a, 0xe9, 0x86, 0x97, 0x34, 0xf8, 0xed, 0xb8,
+	0x2a, 0x94, 0x60, 0x2b, 0xac, 0x01, 0x45, 0x3b, 0x99, 0x83, 0x93, 0x85, 0x0c, 0xab, 0x29, 0xbf,
+	0xff, 0x8e, 0x9a, 0x28, 0xcb, 0x42, 0x6e, 0xd6, 0x14, 0xbb, 0x9e, 0xb0, 0x55, 0xd7, 0x63, 0x7b,
+	0xa8, 0xae, 0xb7, 0xb5, 0x6f, 0xbb, 0x30, 0x40, 0xbe, 0xaf, 0x94, 0x09, 0x18, 0xc8, 0x91, 0xfd,
+	0xf3, 0x05, 0x47, 0xf6, 0x63, 0x65, 0x64, 0x07, 0x55, 0x03, 0x38, 0x80, 0xd7, 0xfa, 0x7b, 0xe0,
+	0xb8, 0xd7, 0xc9, 0x62, 0x2b, 0x7f, 0xed, 0xf1, 0xbc, 0x7d, 0x79, 0x3a, 0x07, 0xc5, 0x02, 0xf6,
+	0xa3, 0xf4, 0x62, 0xff, 0x63, 0x07, 0xd4, 0xba, 0x98, 0xf5, 0xfc, 0x0d, 0xca, 0x96, 0x1c, 0x7b,
+	0x1f, 0xad, 0xc8, 0x9c, 0xe5, 0x09, 0xeb, 0x04, 0x7f, 0xd6, 0xef, 0x83, 0x39, 0x28, 0x16, 0xb0,
+	0x99, 0x0c, 0xc5, 0xe6, 0x59, 0x3c, 0x2a, 0x04, 0x7b, 0x2d, 0x43, 0x4d, 0x2f, 0xcf, 0xcb, 0xa7,
+	0x0c, 0x0e, 0x89, 0xe1, 0x54, 0xe8, 0xa5, 0x19, 0x1f, 0x01, 0x63, 0x0b, 0x07, 0xcc, 0x61, 0xc4,
+	0x6f, 0xe4, 0x0b, 0xc5, 0x8e, 0xb0, 0xbb, 0x6f, 0xf7, 0x73, 0x15, 0x38, 0xab, 0x5e, 0x3b, 0x48,
+	0xfc, 0x4e, 0x90, 0xcd, 0x24, 0xd4, 0xdb, 0xa4, 0x09, 0x13, 0x49, 0xd2, 0x4c, 0x05, 0xd3, 0xd6,
+	0xed, 0x68, 0x5c, 0x76, 0xca, 0x09, 0x98, 0x54, 0x97, 0xa4, 0xd4, 0xef, 0x64, 0xc1, 0x16, 0x65,
+	0xcc, 0xad, 0x93, 0xd0, 0x54, 0xbe, 0xaa, 0xad, 0x2e, 0x29, 0xa2, 0x60, 0xaf, 0xe7, 0xc8, 0x2a,
+	0x4f, 0x59, 0x17, 0xf1, 0x33, 0x6b, 0xff, 0x6f, 0x3d, 0x2e, 0x53, 0xdb, 0xf1, 0xe7, 0x51, 0xf7,
+	0x64, 0x27, 0x91, 0x1a, 0xda, 0x3d, 0x89, 0x94, 0xfb, 0x2f, 0x6a, 0x70, 0x2c, 0xc7, 0x68, 0xf6,
+	0x79, 0x41, 0xe2, 0x49, 0xee, 0xc4, 0x9d, 0xa5, 0x3b, 0xc9, 0x9d, 0xbc, 0xcb, 0x68, 0x0c, 0x26,
+	0x9e, 0xae, 0x99, 0x1b, 0x4d, 0xf1, 0x42, 0x67, 0x5d, 0x76, 0xd0, 0xc6, 0xe3, 0x3c, 0x2e, 0x0b,
+	0xd3, 0xd9, 0x30, 0xa0, 0x51, 0x26, 0x86, 0x59, 0x0e, 0x8f, 0x5b, 0x5d, 0x58, 0xb1, 0x3b, 0x35,
+	0x3c, 0xae, 0x00, 0xc0, 0x22, 0x79, 0xf2, 0x29, 0x07, 0x8e, 0x79, 0x77, 0x53, 0x53, 0x09, 0x43,
+	0x46, 0x00, 0x1c, 0x92, 0xe7, 0xe7, 0x8a, 0x6b, 0x08, 0xfb, 0x6b, 0xae, 0x09, 0xf3, 0x44, 0xc9,
+	0x1b, 0x0e, 0x10, 0x7a, 0x8f, 0xfa, 0x2a, 0x46, 0x41, 0x8e, 0x65, 0xb8, 0x0c, 0xf5, 0xe7, 0xa5,
+	0xae, 0x7e, 0x05, 0x93, 0xec, 0x6e, 0xc7, 0x1e, 0x63, 0x20, 0xd7, 0x80, 0x34, 0x82, 0xd4, 0x5b,
+	0x0b, 0xe9, 0x6c, 0xdc, 0x52, 0xd1, 0xfc, 0xd2, 0x39, 0xe8, 0x9c, 0x9c, 0x67, 0x32, 0xd7, 0x85,
+	0x81, 0x3d, 0x9e, 0xe2, 0xab, 0x2c, 0x89, 0xef, 0x6d, 0xdf, 0x4c, 0x42, 0xce, 0x74, 0xed, 0x55,
+	0x26, 0xdb, 0x51, 0x63, 0xb8, 0xbf, 0x58, 0xd5, 0x27, 0x9b, 0x09, 0xc8, 0xf1, 0xac, 0xc0, 0x00,
+	0xe7, 0xe0, 0x81, 0x01, 0xc6, 0xb1, 0xb1, 0x3b, 0x47, 0x45, 0x2e, 0xa4, 0xbd, 0xf2, 0x88, 0x42,
+	0xda, 0xbf, 0xcf, 0xc9, 0xe5, 0xb5, 0x1c, 0xbb, 0xf8, 0x81, 0x72, 0x83, 0x81, 0xa6, 0x84, 0xd3,
+	0x65, 0x81, 0x4d, 0xe7, 0x7d, 0x6d, 0x19, 0x5b, 0xb3, 0xd0, 0xf6, 0xc5, 0x96, 0xfe, 0xd3, 0x10,
+	0x8c, 0x59, 0x22, 0x51, 0x4f, 0xf9, 0xd6, 0x79, 0xcc, 0xe4, 0xdb, 0xca, 0x3e, 0xe4, 0xdb, 0xef,
+	0x85, 0xba, 0xaf, 0xd8, 0x6d, 0x39, 0x55, 0x5c, 0x8a, 0x4c, 0xdc, 0xd2, 0x5a, 0xa8, 0x26, 0x34,
+	0x34, 0xc9, 0x95, 0x5c, 0x20, 0xb4, 0x64, 0xd5, 0x43, 0x9c, 0x7f, 0xf5, 0x8a, 0x54, 0x96, 0x2c,
+	0xbb, 0xfb, 0x19, 0x9e, 0x46, 0xb4, 0x1d, 0xc8, 0xf7, 0x52, 0x21, 0x7b, 0x22, 0x8d, 0xe8, 0xf2,
+	0xbc, 0x6a, 0x46, 0x1b, 0x87, 0xfb, 0x60, 0xfb, 0x39, 0xae, 0x2b, 0x8f, 0xaa, 0x95, 0x72, 0xa6,
+	0x20, 0xd7, 0xb5, 0x30, 0x6e, 0xe5, 0xdb, 0xb0, 0x40, 0xde, 0xfd, 0xaa, 0xa3, 0x97, 0xdb, 0x43,
+	0xc8, 0xc1, 0x75, 0x27, 0x9f, 0x83, 0xeb, 0x52, 0x29, 0x6f, 0xdd, 0x27, 0xf9, 0xd6, 0x0d, 0x18,
+	0x99, 0x8d, 0x5b, 0x2d, 0x2f, 0x6a, 0x90, 0x6f, 0x82, 0x11, 0x5f, 0xfc, 0x2b, 0x0d, 0x2f, 0xdc,
+	0x6f, 0x49, 0x42, 0x51, 0xc1, 0xc8, 0xd3, 0x30, 0xe4, 0x25, 0x4d, 0x65, 0x6c, 0xe1, 0x5e, 0xc3,
+	0xd3, 0x49, 0x33, 0x45, 0xde, 0xea, 0xfe, 0xad, 0x21, 0xe0, 0xce, 0x76, 0x5e, 0x42, 0x1b, 0xab,
+	0x31, 0x4f, 0x66, 0x7e, 0xa4, 0xde, 0x3e, 0xe6, 0x1e, 0xfe, 0x38, 0x7b, 0xfc, 0x58, 0x5e, 0x1f,
+	0xd5, 0x87, 0xed, 0xf5, 0xd1, 0xdb, 0x91, 0x67, 0xe8, 0x31, 0x72, 0xe4, 0x71, 0x3f, 0xe7, 0x00,
+	0xd1, 0x1e, 0x9a, 0xc6, 0xd3, 0x8e, 0xab, 0x5a, 0x65, 0xab, 0x14, 0x32, 0x2d, 0x55, 0xab, 0x04,
+	0xa0, 0xc1, 0x19, 0x40, 0xf9, 0xf2, 0x9c, 0xe2, 0x28, 0xd5, 0xbc, 0xf4, 0xce, 0xf9, 0x90, 0x64,
+	0x30, 0xee, 0x2f, 0x57, 0xe0, 0x09, 0x21, 0x9e, 0x88, 0x7a, 0x0f, 0x2d, 0x36, 0xaa, 0x41, 0x7d,
+	0x27, 0x7d, 0x76, 0xeb, 0x0f, 0x54, 0x00, 0xd1, 0x61, 0xf7, 0xae, 0xd8, 0x73, 0x62, 0x97, 0xcd,
+	0x47, 0x41, 0x86, 0xbc, 0x73, 0x92, 0xc2, 0xa8, 0x2a, 0xba, 0x26, 0xb9, 0x43, 0x49, 0x84, 0xf4,
+	0xb1, 0x24, 0x39, 0x39, 0x45, 0x4d, 0x88, 0x89, 0x57, 0x61, 0xec, 0x6f, 0x22, 0x6d, 0xc7, 0x9c,
+	0x13, 0x58, 0xf1, 0x1b, 0x0b, 0xb2, 0x1d, 0x35, 0x86, 0xfb, 0xcb, 0x0e, 0x14, 0x79, 0xa4, 0x95,
+	0x9c, 0xd7, 0xd9, 0x35, 0x39, 0xef, 0x3e, 0xd2, 0xdb, 0x7e, 0x17, 0x8c, 0x79, 0x19, 0x13, 0x6b,
+	0xb2, 0x03, 0xde, 0x8e, 0xb8, 0xd6, 0x68, 0x31, 0x6e, 0x04, 0xeb, 0x01, 0xbf, 0x1f, 0xd9, 0xdd,
+	0xb9, 0xff, 0x75, 0x08, 0x4e, 0x75, 0x85, 0xdb, 0x92, 0x97, 0x61, 0xdc, 0x97, 0xcb, 0xa3, 0xad,
+	0x0c, 0x2b, 0x75, 0xdb, 0xdf, 0xdf, 0xc0, 0x30, 0x87, 0x39, 0xc0, 0x02, 0x9d, 0x87, 0xd3, 0x09,
+	0x7d, 0xb5, 0x43, 0x3b, 0x74, 0x7a, 0x3d, 0xa3, 0xc9, 0x0a, 0xf5, 0xe3, 0xa8, 0x21, 0x2c, 0xd5,
+	0xd5, 0x99, 0x27, 0xd9, 0xad, 0x11, 0xbb, 0xc1, 0xd8, 0xeb, 0x19, 0xd2, 0x86, 0x63, 0xa1, 0x2d,
+	0x95, 0xca, 0xcb, 0xd0, 0x81, 0x04, 0x5a, 0x2d, 0xb5, 0xe4, 0x9a, 0x31, 0x4f, 0x20, 0x2f, 0xda,
+	0xd6, 0x1e, 0x91, 0x68, 0xfb, 0xfd, 0x46, 0xb4, 0x15, 0x8e, 0x81, 0x1f, 0x2c, 0x39, 0xdc, 0xfa,
+	0xa8, 0x65, 0xdb, 0xf7, 0xc1, 0xa8, 0x72, 0x9a, 0x1e, 0xc8, 0xd9, 0xd8, 0xee, 0xa7, 0xcf, 0x89,
+	0xf6, 0x3c, 0x7c, 0xe3, 0xa5, 0x24, 0xb1, 0x26, 0xf3, 0x46, 0x9c, 0x4d, 0x87, 0x61, 0x7c, 0x97,
+	0x31, 0xe9, 0x9b, 0x29, 0x95, 0xca, 0x3b, 0xf7, 0x41, 0x05, 0x7a, 0x5c, 0xdc, 0xd8, 0x7e, 0x34,
+	0x92, 0x41, 0x6e, 0x3f, 0xee, 0x4f, 0x3a, 0x20, 0xf7, 0x84, 0x63, 0xb9, 0xe0, 0x81, 0xef, 0x2f,
+	0xfb, 0xe2, 0x69, 0x7c, 0xcd, 0x75, 0x94, 0xa8, 0xf6, 0x37, 0xbf, 0x08, 0x60, 0x44, 0x4c, 0xa9,
+	0xef, 0xd0, 0x76, 0x33, 0x23, 0x89, 0xa2, 0x85, 0x45, 0x5e, 0x82, 0xb1, 0x20, 0x4a, 0x33, 0x2f,
+	0x0c, 0xaf, 0x06, 0x51, 0x26, 0xf5, 0xd3, 0x9a, 0xd9, 0xcf, 0x1b, 0x10, 0xda, 0x78, 0xe7, 0xde,
+	0x65, 0x7d, 0xbf, 0xfd, 0x7c, 0xf7, 0x3f, 0xa9, 0xc2, 0x93, 0x7d, 0x0c, 0x25, 0x47, 0x91, 0x7c,
+	0x64, 0x4e, 0xb8, 0x16, 0xaf, 0x98, 0x1c, 0xf5, 0x75, 0x9d, 0x21, 0x0a, 0x8c, 0xed, 0xa5, 0x47,
+	0x51, 0x0f, 0xeb, 0x39, 0xb2, 0x0d, 0xe3, 0xb6, 0xf1, 0x48, 0xce, 0xec, 0x4d, 0x75, 0x16, 0xda,
+	0x26, 0x98, 0xc3, 0xd7, 0x20, 0xc9, 0x91, 0x7a, 0x7c, 0xfc, 0xfc, 0xe6, 0xe0, 0xa4, 0x76, 0x86,
+	0x93, 0x0c, 0x4a, 0x5a, 0x86, 0xb5, 0x6a, 0x7d, 0xa9, 0x00, 0xc7, 0xae, 0x27, 0xdc, 0x0d, 0x78,
+	0xea, 0x4a, 0x90, 0xe9, 0xc0, 0x65, 0x7d, 0xdc, 0x30, 0x71, 0x5d, 0x07, 0xe2, 0x3b, 0x7d, 0x03,
+	0xf1, 0xad, 0xc0, 0xe1, 0x4a, 0x3e, 0xce, 0xb9, 0x18, 0x38, 0xec, 0xbe, 0x0c, 0x67, 0xae, 0x04,
+	0xd9, 0xe5, 0x20, 0xa4, 0xfb, 0x24, 0xe2, 0xfe, 0xd2, 0x30, 0x8c, 0xdb, 0x29, 0x38, 0xf6, 0x93,
+	0x4b, 0xe0, 0x0b, 0x4c, 0x22, 0x97, 0x6f, 0x17, 0x68, 0x2b, 0xed, 0xed, 0x43, 0xe7, 0x03, 0xe9,
+	0x3d, 0x63, 0x96, 0x50, 0x6e, 0x68, 0xa2, 0x3d, 0x00, 0x72, 0x17, 0x6a, 0xeb, 0x3c, 0xb0, 0xb5,
+	0x5a, 0xc6, 0xca, 0xe9, 0x35, 0xa3, 0xe6, 0x34, 0x16, 0xa1, 0xb1, 0x82, 0x1e, 0x13, 0xa4, 0x92,
+	0x7c, 0xb6, 0x04, 0x2b, 0x98, 0x4a, 0xe6, 0x49, 0xd0, 0x18, 0xfd, 0x24, 0x82, 0xda, 0x01, 0x24,
+	0x82, 0x1c, 0x7f, 0x1e, 0x7e, 0x44, 0xfc, 0x99, 0x07, 0x29, 0x67, 0x1b, 0x5c, 0xcc, 0x97, 0xd1,
+	0xa3, 0xc2, 0x35, 0xc2, 0x0a, 0x52, 0xce, 0x81, 0xb1, 0x88, 0x4f, 0x3e, 0xae, 0x39, 0xfc, 0x68,
+	0x19, 0x96, 0x1d, 0x7b, 0x45, 0x1f, 0x35, 0x73, 0xff, 0x5c, 0x05, 0x8e, 0x5f, 0x89, 0x3a, 0xcb,
+	0x57, 0x96, 0x3b, 0x6b, 0x61, 0xe0, 0x5f, 0xa7, 0xdc, 0xc9, 0x61, 0x93, 0x6e, 0xcf, 0xcf, 0x15,
+	0x2d, 0x0a, 0xd7, 0x59, 0x23, 0x0a, 0x18, 0xe3, 0x45, 0xeb, 0x41, 0xd4, 0xa4, 0x49, 0x3b, 0x09,
+	0xa4, 0xd1, 0xc4, 0xe2, 0x45, 0x97, 0x0d, 0x08, 0x6d, 0x3c, 0xd6, 0x77, 0x7c, 0x37, 0xa2, 0x49,
+	0xf1, 0xbe, 0xb3, 0xc4, 0x1a, 0x51, 0xc0, 0xb8, 0x97, 0x45, 0xd2, 0x49, 0x33, 0xb9, 0x18, 0x8d,
+	0x97, 0x05, 0x6b, 0x44, 0x01, 0x63, 0x3b, 0x3d, 0xed, 0xac, 0x71, 0x07, 0xdc, 0x82, 0x8b, 0xcc,
+	0x8a, 0x68, 0x46, 0x05, 0x67, 0xa8, 0x9b, 0x74, 0x7b, 0xce, 0xcb, 0xbc, 0x62, 0xc4, 0xfa, 0x75,
+	0xd1, 0x8c, 0x0a, 0xce, 0x73, 0x9c, 0xe7, 0xa7, 0xe3, 0x4f, 0x5d, 0x8e, 0xf3, 0xfc, 0xf0, 0xfb,
+	0xa8, 0x59, 0xfe, 0x5c, 0x05, 0x72, 0xbc, 0x90, 0x34, 0x0b, 0x57, 0xa1, 0xa5, 0xae, 0x12, 0x19,
+	0x87, 0xad, 0xd7, 0xb5, 0xff, 0xbb, 0xd4, 0xa3, 0x28, 0x92, 0x76, 0x1b, 0x4e, 0x75, 0xa5, 0x46,
+	0x18, 0x40, 0xda, 0xd9, 0x33, 0x31, 0x8d, 0x8b, 0x30, 0xc6, 0x3a, 0x56, 0xb9, 0x3d, 0x67, 0xe1,
+	0x94, 0xd8, 0xbc, 0x8c, 0xd2, 0x8a, 0xbf, 0x41, 0x5b, 0x3a, 0xdd, 0x05, 0xb7, 0x0a, 0xde, 0x2a,
+	0x02, 0xb1, 0x1b, 0xdf, 0xfd, 0xbc, 0x03, 0xc7, 0x72, 0xd9, 0x2a, 0x4a, 0x92, 0xcf, 0xf9, 0xee,
+	0x8e, 0x79, 0xe4, 0x08, 0x0f, 0x1b, 0xac, 0x72, 0x06, 0x6e, 0x76, 0xb7, 0x01, 0xa1, 0x8d, 0xe7,
+	0x7e, 0xa9, 0x02, 0xa3, 0xca, 0xc9, 0x74, 0x80, 0xa1, 0x7c, 0xd6, 0x81, 0x63, 0xda, 0x12, 0xcb,
+	0x35, 0xcb, 0x95, 0x32, 0x42, 0x83, 0xd9, 0x08, 0xb4, 0x26, 0x28, 0x5a, 0x8f, 0xcd, 0x65, 0x11,
+	0x6d, 0x62, 0x98, 0xa7, 0x4d, 0x6e, 0x31, 0xf9, 0x33, 0xcd, 0x68, 0xcb, 0xd2, 0x71, 0xbb, 0xd6,
+	0x2a, 0x9b, 0xf2, 0xe3, 0x84, 0xb2, 0x35, 0x75, 0x23, 0x6e, 0xd0, 0x15, 0x8d, 0x69, 0xa4, 0x31,
+	0xd3, 0x86, 0x56, 0x4f, 0xee, 0xdf, 0xa8, 0xc0, 0xc9, 0xe2, 0x90, 0xc8, 0x07, 0x61, 0x5c, 0x51,
+	0xb7, 0x2a, 0xd0, 0x2b, 0xcf, 0xda, 0x71, 0xb4, 0x60, 0x0f, 0x76, 0x26, 0x27, 0x8d, 0x87, 0xed,
+	0x05, 0x36, 0x8a, 0x0b, 0x5b, 0x96, 0x37, 0x31, 0x9b, 0xcf, 0x5c, 0x67, 0xc2, 0x1c, 0x2e, 0xfd,
+	0x3e, 0x66, 0xb6, 0xa7, 0xdb, 0x6d, 0x69, 0xe8, 0xb5, 0xcc, 0xe1, 0x36, 0x14, 0x0b, 0xd8, 0x64,
+	0x19, 0xce, 0x58, 0x2d, 0x37, 0x68, 0xd0, 0xdc, 0x58, 0x8b, 0x13, 0x75, 0xe9, 0x7f, 0xda, 0x04,
+	0x05, 0x74, 0xe3, 0x60, 0xcf, 0x27, 0x99, 0x84, 0x91, 0x73, 0x28, 0xaf, 0x9a, 0xf3, 0xb0, 0x87,
+	0x13, 0xf8, 0x22, 0x0c, 0x0d, 0xb8, 0x82, 0x06, 0xba, 0x6c, 0xbe, 0x0f, 0x46, 0x59, 0x77, 0x4a,
+	0xa4, 0x2c, 0xa3, 0xcb, 0x18, 0x46, 0x55, 0x39, 0x50, 0xe2, 0x42, 0x35, 0xf0, 0x94, 0xc7, 0x81,
+	0x7e, 0xad, 0xf9, 0x34, 0xed, 0x70, 0xfd, 0x0d, 0x03, 0x92, 0xe7, 0xa0, 0x4a, 0xef, 0xb5, 0x8b,
+	0xae, 0x05, 0x97, 0xee, 0xb5, 0x83, 0x84, 0xa6, 0x0c, 0x89, 0xde, 0x6b, 0x93, 0x73, 0x50, 0x09,
+	0x1a, 0x92, 0x31, 0x82, 0xc4, 0xa9, 0xcc, 0xcf, 0x61, 0x25, 0x68, 0xb8, 0xf7, 0xa0, 0xae, 0xeb,
+	0x8f, 0x92, 0x4d, 0xc5, 0x2f, 0x9c, 0x32, 0xbc, 0xc2, 0x55, 0xbf, 0x7d, 0x38, 0x45, 0x07, 0xc0,
+	0xa4, 0xed, 0x28, 0xeb, 0x7c, 0x39, 0x0f, 0x43, 0x7e, 0x2c, 0x53, 0x0a, 0x8d, 0x9a, 0x6e, 0x44,
+	0x89, 0x46, 0x06, 0x71, 0x6f, 0xc3, 0xf1, 0xeb, 0x51, 0x7c, 0x97, 0x17, 0x99, 0xe2, 0x39, 0x95,
+	0x59, 0xc7, 0xeb, 0xec, 0x9f, 0xa2, 0x58, 0xc2, 0xa1, 0x28, 0x60, 0x3a, 0xdb, 0x6b, 0xa5, 0x5f,
+	0xb6, 0x57, 0xf7, 0x13, 0x0e, 0x8c, 0xeb, 0xf8, 0xfd, 0x2b, 0x5b, 0x9b, 0x83, 0x79, 0x01, 0x5b,
+	0x89, 0x31, 0x2a, 0x7b, 0x24, 0xc6, 0x50, 0x0e, 0xc3, 0xd5, 0x7e, 0x0e, 0xc3, 0xee, 0x2f, 0x3a,
+	0x70, 0x52, 0x0f, 0x41, 0x31, 0x84, 0x97, 0x61, 0x7c, 0xad, 0x13, 0x84, 0x0d, 0x95, 0x2c, 0xba,
+	0xa0, 0xc4, 0x9b, 0xb1, 0x60, 0x98, 0xc3, 0x24, 0x17, 0x01, 0xd6, 0x82, 0xc8, 0x4b, 0xb6, 0x97,
+	0x0d, 0x07, 0xd2, 0x87, 0xd2, 0x8c, 0x86, 0xa0, 0x85, 0xc5, 0xa8, 0xa5, 0x34, 0xbb, 0x91, 0x73,
+	0x5b, 0x1e, 0xb5, 0x1c, 0x47, 0x2d, 0x18, 0xe6, 0x30, 0xdd, 0xd7, 0xab, 0x70, 0x3c, 0x9f, 0xff,
+	0x60, 0x80, 0xcb, 0xe0, 0x73, 0x50, 0xe3, 0x29, 0x11, 0x8a, 0x8b, 0x42, 0x64, 0x66, 0x16, 0x30,
+	0x92, 0xc2, 0xb0, 0x48, 0xc6, 0x56, 0x4e, 0xa1, 0x59, 0x3d, 0x48, 0xad, 0x34, 0xe4, 0xce, 0xfa,
+	0x32, 0xff, 0x9b, 0x24, 0x45, 0x3e, 0xe5, 0xc0, 0x48, 0xdc, 0xb6, 0xf3, 0x8b, 0xbe, 0xbf, 0xcc,
+	0xdc, 0x10, 0x32, 0x34, 0x5d, 0xca, 0xef, 0x7a, 0xd1, 0xa8, 0x0f, 0xa9, 0x48, 0x9f, 0xfb, 0x36,
+	0x18, 0xb7, 0x31, 0xf7, 0x12, 0xe1, 0x47, 0x6d, 0x11, 0xfe, 0xb3, 0xf6, 0x72, 0x92, 0xd9, 0x2f,
+	0x06, 0xd8, 0xa8, 0x37, 0xa1, 0xe6, 0x6b, 0x87, 0xa7, 0x03, 0x15, 0x27, 0xd0, 0xd9, 0xd1, 0xb8,
+	0xad, 0x55, 0xf4, 0xe6, 0x7e, 0xd5, 0xb1, 0xd6, 0x07, 0xd2, 0x74, 0xbe, 0x41, 0x12, 0xa8, 0x36,
+	0xb7, 0x36, 0xa5, 0xe0, 0x7c, 0xad, 0xa4, 0xe9, 0xbd, 0xb2, 0xb5, 0x69, 0xd6, 0xab, 0xdd, 0x8a,
+	0x8c, 0xd8, 0x00, 0x9a, 0xed, 0xfd, 0xba, 0xed, 0xbb, 0x6f, 0x54, 0xe0, 0x54, 0xd7, 0xa2, 0x22,
+	0xaf, 0x41, 0x2d, 0x61, 0x6f, 0x29, 0x5f, 0x6f, 0xa1, 0xb4, 0xb4, 0x26, 0xe9, 0x7c, 0xc3, 0x70,
+	0xec, 0x7c, 0x3b, 0x0a, 0x92, 0xe4, 0x1a, 0x10, 0xe3, 0xd6, 0xa7, 0xd5, 0xea, 0xe2, 0x95, 0xb5,
+	0xb3, 0xca, 0x74, 0x17, 0x06, 0xf6, 0x78, 0x8a, 0xbc, 0x52, 0xd4, 0xce, 0x57, 0xf3, 0xee, 0x01,
+	0xbb, 0x29, 0xda, 0xdd, 0x7f, 0x50, 0x81, 0x63, 0xb9, 0x74, 0xaf, 0x24, 0x84, 0x51, 0x1a, 0x72,
+	0x4b, 0x95, 0x62, 0x53, 0x87, 0x2d, 0xde, 0xa2, 0x59, 0xeb, 0x25, 0xd9, 0x2f, 0x6a, 0x0a, 0x8f,
+	0x87, 0x0f, 0xcb, 0xcb, 0x30, 0xae, 0x06, 0xf4, 0x7e, 0xaf, 0x15, 0xca, 0x09, 0xd4, 0x6b, 0xf4,
+	0x92, 0x05, 0xc3, 0x1c, 0xa6, 0xfb, 0x2b, 0x55, 0x98, 0x28, 0x96, 0x72, 0x5f, 0x54, 0xb7, 0xc3,
+	0x1f, 0x32, 0x49, 0x99, 0x9d, 0x32, 0xaa, 0xf4, 0xf7, 0x23, 0x34, 0x90, 0x27, 0xeb, 0x4f, 0x16,
+	0x3c, 0x59, 0x85, 0xc0, 0xde, 0x3c, 0xa2, 0x11, 0xed, 0xdf, 0xb5, 0xf5, 0x51, 0xba, 0xa6, 0xde,
+	0xe9, 0xfe, 0x88, 0x3a, 0xaf, 0xf9, 0xde, 0xc7, 0xf1, 0x0b, 0x30, 0xda, 0xf2, 0xa2, 0x60, 0x9d,
+	0xa6, 0x59, 0xd1, 0x25, 0x71, 0x51, 0xb6, 0xa3, 0xc6, 0x70, 0xff, 0x72, 0x05, 0x4e, 0x14, 0x8a,
+	0xde, 0x91, 0xd7, 0xf3, 0x75, 0x52, 0x9c, 0x32, 0x8c, 0x4d, 0xbb, 0xd6, 0x41, 0xdb, 0x5f, 0xb5,
+	0x94, 0x47, 0xb4, 0x2d, 0xdd, 0xdf, 0xae, 0xc0, 0xf1, 0x7c, 0xb5, 0xbe, 0xc7, 0x70, 0xa6, 0xde,
+	0x0e, 0x75, 0x5e, 0x90, 0xea, 0x3a, 0xdd, 0x56, 0xb6, 0x2a, 0x51, 0xfb, 0x47, 0x35, 0xa2, 0x81,
+	0x3f, 0x16, 0x45, 0x68, 0xdc, 0xbf, 0xe6, 0xc0, 0x59, 0xf1, 0x96, 0xc5, 0x75, 0xf8, 0xff, 0xf6,
+	0x9a, 0xdd, 0x0f, 0x95, 0x3b, 0xc0, 0x42, 0xe2, 0xf2, 0xbd, 0xe6, 0x97, 0x57, 0xf5, 0x97, 0xa3,
+	0xcd, 0x2f, 0x85, 0xc7, 0x70, 0xb0, 0xfb, 0x5a, 0x0c, 0xee, 0x6f, 0x57, 0xa1, 0xae, 0xad, 0x39,
+	0x24, 0x90, 0x59, 0x3c, 0x4a, 0x49, 0xe0, 0xbe, 0xb2, 0x1d, 0xf9, 0xba, 0x6b, 0x61, 0x3b, 0xb5,
+	0x92, 0x78, 0xfc, 0xa0, 0x03, 0x63, 0x41, 0x14, 0x64, 0x81, 0x97, 0xe9, 0x62, 0xe1, 0x87, 0xf6,
+	0xde, 0xd5, 0xe4, 0xe6, 0x45, 0xcf, 0x71, 0x62, 0x1b, 0x38, 0x35, 0x31, 0xb4, 0x29, 0x93, 0x8f,
+	0xca, 0xc0, 0x96, 0x6a, 0x69, 0x79, 0x77, 0x46, 0x0b, 0xd1, 0x2c, 0x6d, 0x26, 0xe4, 0x65, 0x49,
+	0x49, 0xe9, 0xaa, 0x90, 0x75, 0xa5, 0x6b, 0x81, 0x68, 0x31, 0x9a, 0x37, 0xa3, 0x20, 0xe4, 0xa6,
+	0x40, 0xba, 0xe7, 0x62, 0x9f, 0x5e, 0xee, 0x17, 0xa0, 0xee, 0x75, 0xb2, 0xb8, 0xc5, 0xa6, 0x49,
+	0x1a, 0xe1, 0x4c, 0x58, 0x83, 0x02, 0xa0, 0xc1, 0x71, 0x5f, 0xaf, 0x41, 0x21, 0xad, 0x06, 0xb9,
+	0x07, 0x75, 0x6d, 0x19, 0x2c, 0x27, 0x62, 0xd7, 0xac, 0x28, 0x3d, 0x18, 0xdd, 0x84, 0x86, 0x18,
+	0x69, 0x42, 0xad, 0xbd, 0xe1, 0xa5, 0x4a, 0x84, 0x7f, 0x9f, 0xbe, 0x33, 0xb2, 0xc6, 0x07, 0x3b,
+	0x93, 0xdf, 0x31, 0x98, 0x3e, 0x9a, 0xad, 0xd5, 0x0b, 0x22, 0x31, 0xa1, 0x21, 0xcd, 0xfb, 0x40,
+	0xd1, 0xff, 0x7e, 0xaa, 0x79, 0x7f, 0x52, 0x56, 0xde, 0x42, 0x9a, 0x76, 0xc2, 0x4c, 0xae, 0x86,
+	0xf7, 0x95, 0xb8, 0xcb, 0x44, 0xc7, 0x26, 0x11, 0x96, 0xf8, 0x8d, 0x16, 0x51, 0xf2, 0x41, 0xa8,
+	0xa7, 0x99, 0x97, 0x64, 0x07, 0x4c, 0xe1, 0xa2, 0x27, 0x7d, 0x45, 0x75, 0x82, 0xa6, 0x3f, 0xf2,
+	0x01, 0x5e, 0xcf, 0x22, 0x48, 0x37, 0x0e, 0x18, 0x8f, 0xa6, 0x6a, 0x5f, 0xc8, 0x1e, 0xd0, 0xea,
+	0x8d, 0x5c, 0x04, 0xe0, 0x6b, 0x5b, 0xf8, 0xee, 0x8e, 0x72, 0x5d, 0x98, 0x3e, 0x0a, 0x51, 0x43,
+	0xd0, 0xc2, 0x72, 0xbf, 0x19, 0xf2, 0x99, 0xdc, 0xc8, 0xa4, 0x4a, 0x1c, 0x27, 0x74, 0xe5, 0x3c,
+	0xae, 0x2c, 0x97, 0xe3, 0xed, 0x17, 0x1c, 0xb0, 0xd3, 0xcd, 0x91, 0x57, 0x45, 0x5e, 0x3b, 0xa7,
+	0x0c, 0x9b, 0xaa, 0xd5, 0xef, 0xd4, 0xa2, 0xd7, 0x2e, 0xf8, 0x76, 0xa8, 0xe4, 0x76, 0xe7, 0xde,
+	0x05, 0xa3, 0x0a, 0xba, 0x2f, 0x01, 0xf2, 0xe3, 0x70, 0x5a, 0x65, 0x83, 0x50, 0xda, 0x5d, 0x69,
+	0x8f, 0x2b, 0x23, 0x4c, 0x5d, 0x89, 0xa0, 0xd5, 0x7e, 0x22, 0xa8, 0xfb, 0xe3, 0x15, 0x98, 0x28,
+	0x0e, 0x40, 0xdf, 0x0f, 0x1f, 0xba, 0x9a, 0x6c, 0x80, 0x30, 0xf9, 0xb0, 0x78, 0x67, 0xad, 0x1d,
+	0xdc, 0xa3, 0xec, 0xd4, 0x9e, 0x97, 0xdc, 0xdf, 0xaa, 0xc2, 0xb3, 0xc5, 0xe9, 0x49, 0x67, 0x43,
+	0xea, 0x45, 0x9d, 0xb6, 0x4c, 0x52, 0xf7, 0x36, 0x18, 0xa1, 0x91, 0xb7, 0x16, 0xd2, 0x46, 0x31,
+	0xb9, 0xfb, 0x25, 0xd1, 0x8c, 0x0a, 0x4e, 0x9e, 0x87, 0xe1, 0x46, 0xb2, 0x8d, 0x9d, 0x48, 0x9e,
+	0xcc, 0xfa, 0xc6, 0x35, 0xc7, 0x5b, 0x51, 0x42, 0xc9, 0x77, 0x43, 0xcd, 0x0b, 0xc3, 0xf8, 0xae,
+	0xe4, 0x6e, 0x87, 0x34, 0x2d, 0xf7, 0xfb, 0xbc, 0xe6, 0x7b, 0x72, 0x37, 0x2c, 0x14, 0x34, 0xc9,
+	0xc7, 0x61, 0x58, 0x78, 0xc7, 0x4a, 0xdd, 0xd8, 0x51, 0x51, 0xd7, 0x2f, 0x2f, 0xdc, 0x73, 0x51,
+	0x52, 0x65, 0x93, 0xd4, 0x0a, 0xa2, 0xe9, 0x26, 0x2d, 0xd6, 0xdb, 0x5f, 0xe4, 0xad, 0x28, 0xa1,
+	0xec, 0xea, 0xdd, 0xf2, 0xee, 0xa9, 0xe2, 0x55, 0xa2, 0x08, 0x60, 0xd5, 0x5c, 0xbd, 0x17, 0x2d,
+	0x18, 0xe6, 0x30, 0xdd, 0x7f, 0x58, 0x81, 0xf3, 0x5d, 0x1f, 0x75, 0x31, 0x8e, 0x82, 0x2c, 0x4e,
+	0x56, 0x68, 0x96, 0x05, 0x51, 0x93, 0x27, 0xa6, 0xbe, 0xeb, 0x25, 0xaa, 0xb8, 0x16, 0x17, 0x0e,
+	0x6e, 0x7b, 0x49, 0x84, 0xbc, 0x95, 0x6c, 0xeb, 0x49, 0xaa, 0x94, 0x11, 0x58, 0xda, 0xe3, 0x08,
+	0xe8, 0x3b, 0x3f, 0xdf, 0xef, 0xc0, 0x88, 0x2f, 0x56, 0xa0, 0xbc, 0x08, 0x7c, 0x57, 0xb9, 0xc4,
+	0xf3, 0xeb, 0x5b, 0x7a, 0xe0, 0x8b, 0x26, 0x54, 0x94, 0xdd, 0xaf, 0x39, 0x40, 0x96, 0xb6, 0x68,
+	0x92, 0x04, 0x0d, 0xcb, 0xf5, 0x9a, 0xd7, 0x8e, 0xb5, 0x6a, 0xc4, 0xda, 0xd9, 0x93, 0x0a, 0xb5,
+	0x63, 0xad, 0x5f, 0xbd, 0x6b, 0xc7, 0x56, 0xf6, 0x57, 0x3b, 0x96, 0x2c, 0xc1, 0xd9, 0x96, 0xb8,
+	0x8a, 0x8b, 0x7a, 0x8c, 0xe2, 0x5e, 0xae, 0x33, 0x40, 0x3c, 0x75, 0x7f, 0x67, 0xf2, 0xec, 0x62,
+	0x2f, 0x04, 0xec, 0xfd, 0x9c, 0xfb, 0x2e, 0x20, 0xc2, 0xe3, 0x7a, 0xb6, 0x97, 0xfb, 0x6c, 0xdf,
+	0x5b, 0xbd, 0xfb, 0x13, 0x35, 0x38, 0x51, 0x28, 0x00, 0x43, 0x7e, 0xc8, 0xe9, 0xe1, 0xaf, 0x7b,
+	0x68, 0xc9, 0xb9, 0x7b, 0x78, 0x03, 0x79, 0x00, 0x47, 0x50, 0x0b, 0xa2, 0x76, 0x27, 0x2b, 0x27,
+	0x0d, 0x8f, 0x18, 0xc4, 0x3c, 0xeb, 0xd0, 0x32, 0x27, 0xb1, 0x9f, 0x28, 0xc8, 0x94, 0xe9, 0x4f,
+	0x9c, 0xbb, 0x06, 0x0f, 0x3d, 0x22, 0xa5, 0xdf, 0x27, 0x8d, 0x77, 0x6f, 0xad, 0x0c, 0xf3, 0x41,
+	0x61, 0xb1, 0x1c, 0xb5, 0xfb, 0xcf, 0xcf, 0x55, 0x60, 0xcc, 0xfa, 0x68, 0xe4, 0xa7, 0xf2, 0x69,
+	0x90, 0x9d, 0xf2, 0x5e, 0x89, 0xf7, 0x3f, 0x65, 0x12, 0x1d, 0x8b, 0x57, 0x7a, 0xbe, 0x3b, 0x03,
+	0xf2, 0x83, 0x9d, 0xc9, 0x93, 0x85, 0x1c, 0xc7, 0xb9, 0xac, 0xc8, 0xe7, 0xbe, 0x07, 0x4e, 0x14,
+	0xba, 0xe9, 0xf1, 0xca, 0xab, 0xf6, 0x2b, 0x1f, 0x5a, 0xf9, 0x6c, 0x4f, 0xd9, 0x57, 0x1c, 0x78,
+	0x52, 0xfa, 0x27, 0x6b, 0xb1, 0x3d, 0x95, 0xfc, 0xff, 0x15, 0x38, 0xd6, 0xf2, 0xee, 0xcd, 0xc6,
+	0x91, 0xdf, 0x49, 0x12, 0xaa, 0xe3, 0xd0, 0xb5, 0x12, 0x7d, 0xd1, 0x06, 0x62, 0x1e, 0x97, 0xdc,
+	0x84, 0x63, 0xed, 0x24, 0x88, 0x93, 0x20, 0xdb, 0x9e, 0x0d, 0xbd, 0x34, 0x95, 0x22, 0xd4, 0x05,
+	0xf5, 0xf0, 0xb2, 0x0d, 0x7c, 0xb0, 0x33, 0xf9, 0x84, 0xb9, 0xce, 0xd8, 0x10, 0xcc, 0xf7, 0xe2,
+	0x7e, 0x85, 0x7d, 0x62, 0x99, 0x0c, 0x21, 0x0e, 0x07, 0x51, 0x45, 0x16, 0xd2, 0xf1, 0x54, 0x06,
+	0x4c, 0xc7, 0xf3, 0x56, 0x18, 0x6d, 0xb3, 0x69, 0x08, 0x74, 0x6d, 0x04, 0x1e, 0xe9, 0xbd, 0x2c,
+	0xdb, 0x50, 0x43, 0xc9, 0x5d, 0xa8, 0xdf, 0xb9, 0x9b, 0x09, 0x6b, 0xb6, 0x94, 0x2c, 0xca, 0x32,
+	0x62, 0xeb, 0xeb, 0x8d, 0x36, 0x97, 0xa3, 0xa1, 0x45, 0x5c, 0x18, 0xe6, 0x82, 0xaa, 0x8a, 0xfb,
+	0xe3, 0x16, 0x41, 0x2e, 0xc1, 0xa6, 0x28, 0x21, 0xee, 0xcf, 0xd4, 0xe1, 0x4c, 0xaf, 0xaa, 0x61,
+	0xe4, 0x63, 0x30, 0x2c, 0xc6, 0x58, 0x4e, 0x61, 0xca, 0x5e, 0x34, 0xae, 0xf0, 0x0e, 0xe5, 0xb0,
+	0xf8, 0xff, 0x28, 0x69, 0x4a, 0xea, 0xa1, 0xb7, 0x26, 0x57, 0xf4, 0xd1, 0x50, 0x5f, 0xf0, 0x0c,
+	0xf5, 0x05, 0x4f, 0x50, 0x0f, 0xbd, 0x35, 0x72, 0x0f, 0x6a, 0xcd, 0x20, 0xa3, 0x9e, 0x94, 0x32,
+	0x6e, 0x1f, 0x09, 0x71, 0xea, 0x89, 0xfb, 0x1c, 0xff, 0x17, 0x05, 0x41, 0xf2, 0x65, 0x07, 0x4e,
+	0xac, 0xe5, 0xb3, 0xa1, 0xc9, 0xc3, 0xde, 0x3b, 0x82, 0xca, 0x70, 0x79, 0x42, 0xa2, 0xd8, 0x73,
+	0xa1, 0x11, 0x8b, 0xc3, 0xe1, 0x52, 0xd8, 0x7a, 0x10, 0x5a, 0xc5, 0x79, 0x8e, 0xe0, 0xe3, 0x5c,
+	0xe6, 0x04, 0xac, 0x0c, 0x45, 0x82, 0x20, 0x2a, 0xca, 0xfd, 0x38, 0xeb, 0xf0, 0x61, 0x39, 0xeb,
+	0xc8, 0x23, 0xe2, 0xac, 0x9f, 0x71, 0xa0, 0xae, 0x67, 0x5a, 0x26, 0xad, 0xf9, 0xe0, 0x11, 0x7e,
+	0x72, 0xa1, 0x63, 0xd5, 0x3f, 0xd1, 0x10, 0x27, 0x5f, 0x74, 0x60, 0xcc, 0x7b, 0xad, 0x93, 0xd0,
+	0x06, 0xdd, 0x8a, 0xdb, 0xa2, 0x5a, 0xd0, 0xa1, 0xb5, 0xc4, 0xbd, 0x06, 0x33, 0xcd, 0x88, 0xcc,
+	0xd1, 0xad, 0xa5, 0x76, 0x2a, 0x63, 0x92, 0x4d, 0x03, 0xda, 0x43, 0x70, 0x77, 0x2a, 0x30, 0xb9,
+	0x47, 0x0f, 0xec, 0x56, 0x14, 0x27, 0x4d, 0x2f, 0x0a, 0x5e, 0xb3, 0xd3, 0x1b, 0x6a, 0xa9, 0x70,
+	0xc9, 0x82, 0x61, 0x0e, 0xd3, 0x4e, 0xab, 0x53, 0xd9, 0x23, 0xad, 0xce, 0x79, 0x18, 0x4a, 0x68,
+	0x3b, 0x2e, 0xde, 0xe3, 0x79, 0xf4, 0x1d, 0x87, 0x90, 0x67, 0xa0, 0xea, 0xb5, 0x03, 0x79, 0x8d,
+	0xd7, 0xda, 0x92, 0xe9, 0xe5, 0x79, 0x64, 0xed, 0xb9, 0x94, 0x80, 0xb5, 0x87, 0x92, 0x12, 0x90,
+	0xb1, 0x01, 0x69, 0x51, 0x1d, 0x36, 0x6c, 0x20, 0x6f, 0xe9, 0x74, 0xdf, 0xa8, 0xc2, 0x33, 0xbb,
+	0xae, 0x17, 0xe3, 0xcb, 0xec, 0xec, 0xe2, 0xcb, 0xac, 0xa6, 0xa7, 0xb2, 0xd7, 0xf4, 0x54, 0xfb,
+	0x4c, 0xcf, 0xf7, 0xb3, 0x6d, 0xa0, 0xd2, 0x42, 0x96, 0x53, 0xeb, 0xbf, 0x5f, 0x96, 0x49, 0xb9,
+	0x03, 0x14, 0x14, 0x0d, 0x5d, 0x76, 0x67, 0xc9, 0xe5, 0x40, 0xa9, 0x95, 0xc1, 0x06, 0xfa, 0xa6,
+	0x89, 0x14, 0x6b, 0xbf, 0x5f, 0x62, 0x15, 0xf7, 0x47, 0x2a, 0xf0, 0xdc, 0x00, 0xa7, 0xb7, 0xbd,
+	0x8a, 0x9d, 0x01, 0x57, 0xf1, 0x9f, 0xee, 0xcf, 0xe4, 0xfe, 0x7f, 0x0e, 0x9c, 0xeb, 0xcf, 0x3c,
+	0xc8, 0x8b, 0x30, 0xb6, 0x96, 0x78, 0x91, 0xbf, 0xb1, 0xc8, 0x9d, 0xb1, 0xe4, 0xa4, 0xf0, 0xb9,
+	0x36, 0xcd, 0x68, 0xe3, 0xb0, 0xeb, 0xb8, 0xf0, 0x94, 0xb2, 0x30, 0x54, 0xe6, 0x08, 0x76, 0x1d,
+	0x5f, 0x2d, 0x02, 0xb1, 0x1b, 0xdf, 0xfd, 0xa3, 0x4a, 0xef, 0x61, 0x09, 0x21, 0x63, 0x3f, 0xdf,
+	0x49, 0x7e, 0x85, 0xca, 0x00, 0x67, 0x49, 0xf5, 0x61, 0x9f, 0x25, 0x43, 0xfd, 0xce, 0x12, 0x32,
+	0x07, 0x27, 0xad, 0x02, 0xb3, 0x22, 0x1b, 0x48, 0x2d, 0x1f, 0x90, 0xb5, 0x5c, 0x80, 0x63, 0xd7,
+	0x13, 0xe4, 0x05, 0x18, 0x0d, 0x78, 0x2e, 0xa6, 0x44, 0x04, 0xcb, 0x58, 0xf1, 0xce, 0xf3, 0xb2,
+	0x1d, 0x35, 0x86, 0xfb, 0xd3, 0x15, 0x78, 0xaa, 0xaf, 0x9c, 0xf5, 0x90, 0xce, 0x2e, 0xfb, 0x73,
+	0x0c, 0x3d, 0x9c, 0xcf, 0x61, 0x4f, 0x52, 0x6d, 0xcf, 0x49, 0xfa, 0x9d, 0xfe, 0x0b, 0x93, 0xc9,
+	0xdc, 0x7f, 0x66, 0x67, 0xe9, 0x15, 0x38, 0xe6, 0xb5, 0xdb, 0x02, 0xcf, 0xca, 0x32, 0xab, 0xef,
+	0xa9, 0xd3, 0x36, 0x10, 0xf3, 0xb8, 0x03, 0x71, 0xcf, 0x10, 0xce, 0xe8, 0x32, 0x01, 0xdc, 0xd8,
+	0xb6, 0x1a, 0xb4, 0x82, 0xa8, 0x39, 0xc0, 0xe5, 0xf3, 0x22, 0x80, 0xca, 0xb6, 0xbc, 0xa8, 0x32,
+	0x94, 0x69, 0x2b, 0xd1, 0x9c, 0x86, 0xa0, 0x85, 0xe5, 0xde, 0xaf, 0xc0, 0x49, 0x43, 0x2e, 0x89,
+	0xd7, 0x83, 0x90, 0xe6, 0xed, 0x64, 0x4e, 0xc9, 0x76, 0xb2, 0x03, 0x8c, 0x92, 0x4c, 0xc3, 0x09,
+	0x9f, 0x67, 0x22, 0x09, 0xd2, 0x38, 0x5a, 0xa0, 0x5b, 0x54, 0xb9, 0x88, 0x59, 0x39, 0x7c, 0x72,
+	0x60, 0x2c, 0xe2, 0x93, 0xd7, 0x60, 0x98, 0xdb, 0x2c, 0xd5, 0xad, 0x19, 0x0f, 0x6b, 0x88, 0xee,
+	0xfe, 0x44, 0x46, 0xcb, 0xc4, 0x1b, 0x53, 0x94, 0x14, 0xdd, 0xdf, 0x73, 0xa0, 0x8e, 0x74, 0x5d,
+	0x1c, 0xf8, 0xe4, 0x8e, 0x5c, 0xf5, 0x4e, 0x19, 0xe5, 0x42, 0xd8, 0x5e, 0x49, 0x03, 0x5e, 0x46,
+	0xa3, 0xd7, 0xfe, 0xe9, 0xae, 0xe9, 0x5c, 0xd9, 0x57, 0x4d, 0x67, 0x5d, 0xd5, 0xb7, 0xda, 0xbf,
+	0xaa, 0xaf, 0xfb, 0x95, 0x11, 0xf6, 0x7a, 0xed, 0x78, 0x36, 0xa1, 0x8d, 0x94, 0x6d, 0xd9, 0x4e,
+	0x12, 0xca, 0x65, 0xaa, 0xb7, 0xec, 0x4d, 0x5c, 0x40, 0xd6, 0x9e, 0xb3, 0xc3, 0x57, 0xf6, 0x95,
+	0x6d, 0xae, 0xba, 0x67, 0xb6, 0xb9, 0x57, 0xe0, 0x58, 0x9a, 0x6e, 0x2c, 0x27, 0xc1, 0x96, 0x97,
+	0xd1, 0xeb, 0x74, 0x5b, 0x0a, 0xce, 0x26, 0x4f, 0xd3, 0xca, 0x55, 0x03, 0xc4, 0x3c, 0x2e, 0xb9,
+	0x02, 0xa7, 0x4c, 0xce, 0x37, 0x9a, 0x64, 0x3c, 0xe8, 0x4d, 0x6c, 0x6e, 0x9d, 0x02, 0xc5, 0x64,
+	0x89, 0x93, 0x08, 0xd8, 0xfd, 0x0c, 0x63, 0x59, 0xb9, 0x46, 0x36, 0x90, 0x42, 0x0c, 0x71, 0xae,
+	0x1f, 0x36, 0x96, 0xae, 0x27, 0xc8, 0x22, 0x9c, 0x16, 0x0b, 0x63, 0xba, 0xdd, 0xb6, 0xde, 0x68,
+	0x24, 0x5f, 0xa6, 0xe1, 0x4a, 0x37, 0x0a, 0xf6, 0x7a, 0x8e, 0xbc, 0x04, 0x63, 0xba, 0x79, 0x7e,
+	0x4e, 0x9a, 0x90, 0xb5, 0x62, 0x4a, 0x77, 0x33, 0xdf, 0x40, 0x1b, 0x8f, 0xbc, 0x1f, 0x9e, 0x34,
+	0x3f, 0x45, 0x60, 0xbc, 0xf0, 0xab, 0x98, 0x93, 0xd9, 0x49, 0x75, 0x0d, 0xd9, 0x2b, 0x3d, 0xd1,
+	0x1a, 0xd8, 0xef, 0x79, 0xb2, 0x06, 0xe7, 0x34, 0xe8, 0x52, 0x94, 0xf1, 0x30, 0xc7, 0x94, 0xce,
+	0x78, 0x29, 0xbd, 0x99, 0x84, 0xb2, 0xf4, 0xa2, 0x2b, 0x7b, 0x3f, 0x77, 0x25, 0xc8, 0xae, 0xf6,
+	0xc2, 0xc4, 0x05, 0xdc, 0xa5, 0x17, 0x72, 0x01, 0xea, 0xc2, 0x68, 0xb8, 0x34, 0x3b, 0x2f, 0x8b,
+	0x31, 0x9a, 0x10, 0x12, 0x05, 0x40, 0x83, 0xa3, 0x83, 0x20, 0xc6, 0xfb, 0x05, 0x41, 0x90, 0x65,
+	0x38, 0xd3, 0xf4, 0xdb, 0x4c, 0x9c, 0x0c, 0x7c, 0x3a, 0xed, 0x73, 0xcf, 0x6d, 0xf6, 0x61, 0x44,
+	0xfd, 0x0c, 0x1d, 0xe1, 0x73, 0x65, 0x76, 0xb9, 0x0b, 0x07, 0x7b, 0x3e, 0xc9, 0x3d, 0xfc, 0x93,
+	0xf8, 0xde, 0xf6, 0xc4, 0xe9, 0x82, 0x87, 0x3f, 0x6b, 0x44, 0x01, 0x23, 0xd7, 0x80, 0xf0, 0x70,
+	0xb1, 0xab, 0x59, 0xd6, 0xd6, 0xf2, 0xeb, 0xc4, 0x99, 0x7c, 0x72, 0xbd, 0xcb, 0x5d, 0x18, 0xd8,
+	0xe3, 0x29, 0xf7, 0x5f, 0x3b, 0x70, 0x4c, 0xef, 0xd7, 0x87, 0x10, 0xa4, 0x19, 0xe6, 0x83, 0x34,
+	0xaf, 0x1c, 0xfe, 0xc4, 0xe3, 0x23, 0xef, 0x13, 0x75, 0xf3, 0xe9, 0x31, 0x00, 0x73, 0x2a, 0x6a,
+	0x19, 0xc3, 0xe9, 0x2b, 0x63, 0x3c, 0xb6, 0x27, 0x52, 0xaf, 0x4c, 0x78, 0xb5, 0x47, 0x9b, 0x09,
+	0x6f, 0x05, 0xce, 0x2a, 0x09, 0x50, 0x98, 0x2b, 0xaf, 0xc6, 0xa9, 0x3e, 0xe0, 0x46, 0x67, 0x9e,
+	0x91, 0x1d, 0x9d, 0x9d, 0xef, 0x85, 0x84, 0xbd, 0x9f, 0xcd, 0x09, 0x9e, 0x23, 0x7b, 0x09, 0x9e,
+	0x66, 0x4f, 0x2f, 0xac, 0xab, 0x62, 0xb1, 0x85, 0x3d, 0xbd, 0x70, 0x79, 0x05, 0x0d, 0x4e, 0xef,
+	0x83, 0xbd, 0x5e, 0xd2, 0xc1, 0x0e, 0xfb, 0x3e, 0xd8, 0xd5, 0x11, 0x33, 0xd6, 0xf7, 0x88, 0x51,
+	0x92, 0xde, 0x78, 0x5f, 0x49, 0xef, 0x3d, 0x70, 0x3c, 0x88, 0x36, 0x68, 0x12, 0x64, 0xb4, 0xc1,
+	0xf7, 0x02, 0x3f, 0x7e, 0xac, 0x2c, 0xc4, 0xf3, 0x39, 0x28, 0x16, 0xb0, 0xf3, 0xe7, 0xe2, 0xf1,
+	0x01, 0xce, 0xc5, 0x3e, 0xdc, 0xe8, 0x44, 0x39, 0xdc, 0xe8, 0xe4, 0xe1, 0xb9, 0xd1, 0xa9, 0x23,
+	0xe5, 0x46, 0xa4, 0x14, 0x6e, 0x34, 0xd0, 0x41, 0x6f, 0xdd, 0xe8, 0xcf, 0xec, 0x71, 0xa3, 0xef,
+	0xc7, 0x8a, 0xce, 0x1e, 0x98, 0x15, 0xf5, 0xe6, 0x32, 0x4f, 0x1c, 0x88, 0xcb, 0x7c, 0xa6, 0x02,
+	0x67, 0xcd, 0x39, 0xcc, 0x56, 0x7f, 0xb0, 0xce, 0x4e, 0x22, 0x7e, 0x4f, 0x11, 0xa6, 0x43, 0x2b,
+	0x7e, 0xd7, 0x84, 0x02, 0x6b, 0x08, 0x5a, 0x58, 0x3c, 0x0c, 0x96, 0x26, 0xbc, 0x18, 0x4e, 0xf1,
+	0x90, 0x9e, 0x95, 0xed, 0xa8, 0x31, 0xd8, 0xfa, 0x62, 0xff, 0xcb, 0x74, 0x06, 0xc5, 0xb4, 0xc3,
+	0xb3, 0x06, 0x84, 0x36, 0x1e, 0x79, 0xab, 0x20, 0xc2, 0x0f, 0x08, 0x76, 0x50, 0x8f, 0xcb, 0x62,
+	0x4b, 0xea, 0x4c, 0xd0, 0x50, 0x35, 0x1c, 0x1e, 0xef, 0x5c, 0xeb, 0x1e, 0x0e, 0xf7, 0x7f, 0xd5,
+	0x18, 0xee, 0x7f, 0x73, 0xe0, 0xa9, 0x9e, 0x53, 0xf1, 0x10, 0x98, 0xef, 0xbd, 0x3c, 0xf3, 0x5d,
+	0x29, 0xeb, 0xba, 0x61, 0xbd, 0x45, 0x1f, 0x46, 0xfc, 0xaf, 0x1c, 0x38, 0x6e, 0xf0, 0x1f, 0xc2,
+	0xab, 0x06, 0xf9, 0x57, 0x2d, 0xef, 0x66, 0x55, 0xef, 0x7a, 0xb7, 0x5f, 0xa9, 0x80, 0xce, 0x8c,
+	0x3e, 0xed, 0x0f, 0x98, 0xd7, 0x69, 0x1b, 0x86, 0xb9, 0x2d, 0x3e, 0x2d, 0xc7, 0xdb, 0x29, 0x4f,
+	0x9f, 0xdb, 0xf5, 0xad, 0x1b, 0x28, 0x27, 0x84, 0x92, 0x20, 0x2f, 0xd5, 0x24, 0xb2, 0x2c, 0x37,
+	0x64, 0xc0, 0xaa, 0x29, 0xd5, 0x24, 0xdb, 0x51, 0x63, 0x30, 0xf6, 0x10, 0xf8, 0x71, 0x24, 0x4c,
+	0xe9, 0x43, 0xf9, 0xf8, 0xbe, 0x79, 0x05, 0x40, 0x83, 0xc3, 0xcd, 0xde, 0x41, 0xda, 0x0e, 0xbd,
+	0x6d, 0x4b, 0x25, 0x62, 0xa5, 0xed, 0xd1, 0x20, 0xb4, 0xf1, 0xdc, 0x16, 0x4c, 0xe4, 0x5f, 0x62,
+	0x8e, 0xae, 0x73, 0xef, 0xf4, 0x41, 0xd3, 0x64, 0x79, 0xfc, 0xa9, 0x85, 0x8e, 0x57, 0x4c, 0x93,
+	0x35, 0xad, 0x00, 0x68, 0x70, 0xdc, 0xbf, 0xe2, 0xc0, 0xe9, 0x1e, 0x93, 0x56, 0x62, 0x64, 0x76,
+	0x66, 0x4e, 0x9b, 0x5e, 0x8c, 0xfd, 0x6d, 0x30, 0xd2, 0xa0, 0xeb, 0x9e, 0xf2, 0x7f, 0xb6, 0xce,
+	0xf6, 0x39, 0xd1, 0x8c, 0x0a, 0xee, 0xfe, 0x67, 0x07, 0x4e, 0xe4, 0xc7, 0x9a, 0xf2, 0x98, 0x45,
+	0x31, 0x4d, 0x41, 0xea, 0xc7, 0x5b, 0x34, 0xd9, 0x66, 0x6f, 0xee, 0x14, 0x62, 0x16, 0xbb, 0x30,
+	0xb0, 0xc7, 0x53, 0xbc, 0xae, 0x42, 0x43, 0xcf, 0xb6, 0x5a, 0x91, 0xb7, 0xca, 0x5c, 0x91, 0xe6,
+	0x63, 0xda, 0x1e, 0x10, 0x9a, 0x24, 0xda, 0xf4, 0xdd, 0xff, 0xc2, 0xf5, 0x50, 0xf9, 0x52, 0x77,
+	0xfc, 0x18, 0x6f, 0x77, 0xa4, 0x86, 0x31, 0x2d, 0x16, 0x37, 0x9a, 0x5d, 0xbe, 0xa9, 0x40, 0x68,
+	0xe3, 0xf1, 0x64, 0xa3, 0xed, 0x0e, 0x2f, 0xd2, 0x9b, 0x16, 0x17, 0xc6, 0xec, 0xf2, 0x4d, 0x01,
+	0x40, 0x83, 0xc3, 0xc4, 0xa9, 0x16, 0x6d, 0xc5, 0xc9, 0xb6, 0x26, 0x55, 0xcd, 0x6b, 0x49, 0x16,
+	0x73, 0x50, 0x2c, 0x60, 0x73, 0x1f, 0x4a, 0xde, 0x22, 0x69, 0x0e, 0xe5, 0xad, 0x85, 0x8b, 0x16,
+	0x0c, 0x73, 0x98, 0x3c, 0x15, 0x4f, 0x16, 0x27, 0x5e, 0xb3, 0x3b, 0x15, 0x8f, 0x68, 0x46, 0x05,
+	0xe7, 0xa1, 0xe2, 0xb1, 0xb6, 0x4a, 0x9b, 0x50, 0xf1, 0xb8, 0x91, 0x22, 0x87, 0x88, 0x64, 0x54,
+	0xa2, 0x6e, 0xbf, 0x2c, 0x69, 0xbc, 0x4b, 0x65, 0x7f, 0xf7, 0x6b, 0x43, 0xa0, 0x93, 0x65, 0x70,
+	0xa7, 0xc3, 0xc7, 0xb7, 0xa6, 0xd7, 0x4b, 0x30, 0x26, 0xf4, 0x52, 0xb6, 0xfe, 0x5f, 0xaf, 0x83,
+	0x55, 0x03, 0x42, 0x1b, 0x8f, 0x8d, 0x24, 0x0c, 0xb6, 0xa8, 0x78, 0xa8, 0x50, 0xdf, 0x6b, 0x41,
+	0x01, 0xd0, 0xe0, 0xb0, 0x91, 0x34, 0x82, 0xf5, 0xf5, 0x62, 0x7d, 0x2f, 0x36, 0x3b, 0xc8, 0x21,
+	0xa2, 0x6e, 0x56, 0xbc, 0x29, 0xef, 0x1d, 0x56, 0xdd, 0xac, 0x78, 0x13, 0x39, 0x84, 0x49, 0xca,
+	0x51, 0x9c, 0xb4, 0xbc, 0x30, 0x78, 0x8d, 0x36, 0x34, 0x15, 0x79, 0xdf, 0xd0, 0x92, 0xf2, 0x8d,
+	0x6e, 0x14, 0xec, 0xf5, 0x1c, 0xdb, 0xf3, 0xed, 0x84, 0x36, 0x02, 0x3f, 0xb3, 0x7b, 0x83, 0xfc,
+	0x9e, 0x5f, 0xee, 0xc2, 0xc0, 0x1e, 0x4f, 0x91, 0x69, 0x38, 0xa1, 0x92, 0x9d, 0xa8, 0xec, 0x89,
+	0x63, 0x79, 0x2d, 0x2a, 0xe6, 0xc1, 0x58, 0xc4, 0xe7, 0xa1, 0x96, 0x32, 0xc1, 0x2a, 0xbf, 0x9e,
+	0x58, 0x7c, 0x44, 0x25, 0x5e, 0x45, 0x8d, 0xe1, 0x7e, 0xb2, 0xca, 0xe4, 0x9e, 0x3e, 0x89, 0x85,
+	0x1f, 0x9a, 0x73, 0x7e, 0x7e, 0x45, 0x0e, 0x0d, 0xb0, 0x22, 0xdf, 0x09, 0xe3, 0x77, 0xd2, 0x38,
+	0xd2, 0xee, 0xb7, 0xb5, 0xbe, 0xee, 0xb7, 0x16, 0x56, 0x6f, 0xf7, 0xdb, 0xe1, 0xb2, 0xdc, 0x6f,
+	0x47, 0x0e, 0xe8, 0x7e, 0xfb, 0xeb, 0x35, 0xd0, 0xb5, 0x66, 0x6f, 0xd0, 0xec, 0x6e, 0x9c, 0x6c,
+	0x06, 0x51, 0x93, 0x27, 0x89, 0xf9, 0xb2, 0x03, 0xe3, 0x62, 0xbf, 0x2c, 0xd8, 0x41, 0xd2, 0xeb,
+	0x25, 0xd5, 0xea, 0xcc, 0x11, 0x9b, 0x5a, 0xb5, 0x08, 0x15, 0x6a, 0xad, 0xd9, 0x20, 0xcc, 0x8d,
+	0x88, 0x7c, 0x0f, 0x80, 0xd2, 0x48, 0xaf, 0x2b, 0x26, 0x35, 0x5f, 0xce, 0xf8, 0x90, 0xae, 0x9b,
+	0x5b, 0xc7, 0xaa, 0x26, 0x82, 0x16, 0x41, 0xf2, 0x19, 0x13, 0x40, 0x2e, 0x62, 0x08, 0x3e, 0x7a,
+	0x24, 0x73, 0x33, 0x48, 0xf8, 0x38, 0xc2, 0x48, 0x10, 0x35, 0xd9, 0x3a, 0x91, 0x06, 0x8c, 0xb7,
+	0xf4, 0x4a, 0xb0, 0xb4, 0x10, 0x7b, 0x8d, 0x19, 0x2f, 0xf4, 0x22, 0x9f, 0x26, 0xf3, 0x02, 0xdd,
+	0xb0, 0x14, 0xd9, 0x80, 0xaa, 0xa3, 0xae, 0x22, 0xbd, 0xb5, 0x41, 0x8a, 0xf4, 0x9e, 0x7b, 0x2f,
+	0x9c, 0xea, 0xfa, 0x98, 0xfb, 0x8a, 0x16, 0x3f, 0x78, 0xa0, 0xb9, 0xfb, 0x8f, 0xc1, 0x30, 0xad,
+	0x1b, 0x71, 0x43, 0x94, 0x44, 0x4d, 0xcc, 0x17, 0x95, 0xb7, 0x8a, 0x12, 0x97, 0x88, 0x66, 0x33,
+	0x56, 0x23, 0xda, 0x24, 0xd9, 0x1a, 0x6d, 0x7b, 0xdc, 0x2b, 0xf5, 0x68, 0xd7, 0xe8, 0xb2, 0x26,
+	0x82, 0x16, 0x41, 0xb2, 0x91, 0x0b, 0xe1, 0xbc, 0x7c, 0xf8, 0x10, 0x4e, 0x9e, 0xee, 0xb2, 0x57,
+	0x61, 0xb2, 0x2f, 0x3a, 0x70, 0x3c, 0xca, 0xad, 0xdc, 0x72, 0x7c, 0xc7, 0x7b, 0xef, 0x0a, 0x51,
+	0xcd, 0x21, 0xdf, 0x86, 0x05, 0xfa, 0xbd, 0x58, 0x5a, 0x6d, 0x9f, 0x2c, 0xcd, 0xd4, 0x9c, 0x1e,
+	0xee, 0x5b, 0x73, 0x3a, 0xd2, 0x55, 0xf7, 0x47, 0x4a, 0xaf, 0xba, 0x0f, 0x3d, 0x2a, 0xee, 0xdf,
+	0x86, 0xba, 0x9f, 0x50, 0x2f, 0x3b, 0x60, 0x15, 0x51, 0xee, 0xe5, 0x32, 0xab, 0x3a, 0x40, 0xd3,
+	0x17, 0xf9, 0xb8, 0x3e, 0xcf, 0xea, 0x65, 0x0a, 0xfc, 0x6c, 0x2b, 0x0e, 0x74, 0x8a, 0x7d, 0xa9,
+	0x90, 0x04, 0x03, 0xca, 0xc8, 0x1f, 0x90, 0x1b, 0xc5, 0x01, 0x6a, 0xba, 0xd9, 0x35, 0xbb, 0xc7,
+	0x1e, 0x66, 0xcd, 0xee, 0x47, 0x99, 0x72, 0xe3, 0x7f, 0x0e, 0x99, 0x0b, 0x97, 0x8a, 0x40, 0x62,
+	0x92, 0x92, 0x58, 0x81, 0xe6, 0x62, 0xa9, 0x25, 0xa5, 0xab, 0x0a, 0x80, 0x06, 0x87, 0x49, 0xe6,
+	0x9d, 0x94, 0x2e, 0xb5, 0x69, 0xb4, 0x10, 0xac, 0xa5, 0xd2, 0x6d, 0x44, 0xcf, 0xf8, 0x4d, 0x03,
+	0x42, 0x1b, 0x8f, 0x5d, 0x7b, 0xc4, 0x9d, 0x34, 0x2d, 0xc6, 0x0d, 0xcb, 0xbb, 0x2e, 0x2a, 0x38,
+	0xf9, 0xd1, 0x9e, 0x35, 0x2f, 0xca, 0x89, 0x98, 0xef, 0x0a, 0xbc, 0xda, 0x5f, 0xb1, 0x0b, 0xf2,
+	0x17, 0x1d, 0x38, 0x2b, 0x5a, 0xd5, 0x4c, 0xde, 0x6c, 0x37, 0xbc, 0x8c, 0xa6, 0xe5, 0xd4, 0xe3,
+	0xea, 0x31, 0x3e, 0x63, 0x60, 0xe9, 0x45, 0x16, 0x7b, 0x8f, 0x86, 0xbc, 0xee, 0xc0, 0x89, 0xcd,
+	0x5c, 0x4a, 0x38, 0x25, 0x44, 0x1c, 0x36, 0xe7, 0x52, 0xae, 0x53, 0x73, 0xe8, 0xe6, 0xdb, 0x53,
+	0x2c, 0x52, 0x77, 0xff, 0xc8, 0x01, 0x9b, 0xa1, 0x3e, 0x82, 0x10, 0xd9, 0x7d, 0x5f, 0x0a, 0xd4,
+	0x3d, 0xa3, 0xd6, 0xf7, 0x9e, 0xf1, 0x0c, 0x54, 0x3b, 0x41, 0x43, 0xde, 0x34, 0x8d, 0xe7, 0xc3,
+	0xfc, 0x1c, 0xb2, 0x76, 0xf7, 0xef, 0xd5, 0x8c, 0xce, 0x50, 0x06, 0xa4, 0xff, 0x99, 0x78, 0xed,
+	0x75, 0x9d, 0xff, 0x56, 0xbc, 0xf9, 0x8d, 0xae, 0xfc, 0xb7, 0xdf, 0xbe, 0xff, 0x7c, 0x03, 0x62,
+	0x82, 0xfa, 0xa5, 0xbf, 0x1d, 0xd9, 0x23, 0xd9, 0xc0, 0x1d, 0x18, 0x65, 0x97, 0x71, 0xae, 0xfc,
+	0x1f, 0xcd, 0x0d, 0x6a, 0xf4, 0xaa, 0x6c, 0x7f, 0xb0, 0x33, 0xf9, 0x6d, 0xfb, 0x1f, 0x96, 0x7a,
+	0x1a, 0x75, 0xff, 0x24, 0x85, 0x3a, 0xfb, 0x9f, 0xbb, 0xfc, 0xc8, 0x6b, 0xfe, 0x4d, 0x7d, 0x66,
+	0x2a, 0x40, 0x29, 0x49, 0x17, 0x0c, 0x1d, 0x12, 0x41, 0x9d, 0x21, 0x0a, 0xa2, 0x42, 0x1b, 0xb0,
+	0xac, 0xbd, 0xae, 0x14, 0xe0, 0xc1, 0xce, 0xe4, 0x2b, 0xfb, 0x27, 0xaa, 0x1f, 0x47, 0x43, 0xc2,
+	0xfd, 0xd2, 0x90, 0x59, 0xbb, 0x32, 0xed, 0xf1, 0x9f, 0x89, 0xb5, 0xfb, 0x72, 0x61, 0xed, 0x9e,
+	0xef, 0x5a, 0xbb, 0xc5, 0x8a, 0x09, 0x6a, 0x35, 0x3e, 0x6c, 0x91, 0x70, 0x6f, 0xcd, 0x13, 0x97,
+	0x85, 0x5f, 0xed, 0x04, 0x09, 0x4d, 0x97, 0x93, 0x4e, 0x14, 0x44, 0x4d, 0xbe, 0x1c, 0x47, 0x6d,
+	0x59, 0x38, 0x07, 0xc6, 0x22, 0x3e, 0x79, 0x01, 0x46, 0xd9, 0x37, 0xbf, 0xed, 0x6d, 0x89, 0x55,
+	0x65, 0x69, 0x10, 0x57, 0x64, 0x3b, 0x6a, 0x0c, 0xf7, 0x2b, 0xdc, 0x8f, 0xc4, 0x4a, 0xc8, 0xc2,
+	0xd6, 0x44, 0x18, 0xb4, 0x82, 0xac, 0x58, 0xe4, 0x9d, 0x6b, 0x3b, 0x51, 0xc0, 0xc8, 0x5d, 0x18,
+	0x59, 0xf3, 0xfc, 0xcd, 0x78, 0x7d, 0xbd, 0x9c, 0xca, 0x4a, 0x33, 0xa2, 0x33, 0x9e, 0xab, 0x7a,
+	0x44, 0xfe, 0x78, 0x60, 0xfe, 0x45, 0x45, 0xcd, 0xfd, 0x5f, 0x35, 0x38, 0xa1, 0x3c, 0xdb, 0xae,
+	0x06, 0x29, 0x77, 0x0f, 0xb1, 0x13, 0xf8, 0x57, 0xf6, 0x4c, 0xe0, 0xff, 0x61, 0x80, 0x06, 0x6d,
+	0x87, 0xf1, 0x36, 0x17, 0xcc, 0x87, 0x0e, 0x5e, 0xde, 0x7f, 0x4e, 0xf7, 0x82, 0x56, 0x8f, 0x32,
+	0x8f, 0xad, 0xa8, 0x07, 0x50, 0xc8, 0x63, 0x6b, 0xd5, 0x5f, 0x1b, 0x7e, 0xb8, 0xf5, 0xd7, 0x02,
+	0x38, 0x21, 0x86, 0xa8, 0xdd, 0x39, 0x0f, 0x90, 0xdd, 0x84, 0x87, 0x83, 0xcd, 0xe5, 0xbb, 0xc1,
+	0x62, 0xbf, 0x76, 0x71, 0xb5, 0xd1, 0x87, 0x5d, 0x5c, 0xed, 0xed, 0x50, 0x57, 0xdf, 0x59, 0xdc,
+	0x8e, 0x64, 0xea, 0x28, 0xb5, 0x0c, 0x52, 0x34, 0xf0, 0xae, 0x0c, 0x4e, 0xf0, 0xc8, 0x32, 0x38,
+	0xbd, 0x0d, 0x46, 0xc4, 0x09, 0xb1, 0x2d, 0x1d, 0xdc, 0xf4, 0x1b, 0x8a, 0x03, 0x64, 0x1b, 0x15,
+	0xdc, 0xfd, 0x02, 0xb7, 0xb1, 0x88, 0x57, 0xd0, 0x39, 0x12, 0x9f, 0x87, 0x61, 0xaf, 0x93, 0x6d,
+	0xc4, 0x5d, 0xd5, 0xf0, 0xa7, 0x79, 0x2b, 0x4a, 0x28, 0x59, 0x80, 0xa1, 0x86, 0xc9, 0x45, 0xb7,
+	0xaf, 0xa2, 0xc5, 0x5a, 0x8f, 0xee, 0x65, 0x14, 0x79, 0x2f, 0xe4, 0x69, 0x18, 0xca, 0xbc, 0xa6,
+	0x0a, 0x76, 0xe5, 0x69, 0x21, 0x56, 0xbd, 0x66, 0x8a, 0xbc, 0x75, 0x1f, 0xe5, 0x8c, 0xb9, 0x83,
+	0x55, 0xd0, 0x8c, 0xbc, 0xac, 0x93, 0x50, 0xcb, 0x1a, 0x6f, 0x1c, 0xac, 0x6c, 0x20, 0xe6, 0x71,
+	0xdd, 0xeb, 0x40, 0x30, 0x0e, 0x43, 0x76, 0x3e, 0x2c, 0x45, 0x73, 0xb4, 0x99, 0x78, 0x0d, 0xca,
+	0x6b, 0x16, 0x37, 0x13, 0xcf, 0xa7, 0xcb, 0x34, 0x09, 0xe2, 0x46, 0xd1, 0xea, 0x74, 0xc5, 0x80,
+	0xd0, 0xc6, 0x73, 0x7f, 0x69, 0x1c, 0xce, 0xac, 0xcc, 0x2e, 0xaa, 0x3a, 0x46, 0x47, 0x16, 0xfc,
+	0xda, 0x8b, 0xc6, 0xc3, 0x0b, 0x7e, 0xed, 0x43, 0x3d, 0xb4, 0x82, 0x5f, 0x43, 0x2b, 0xf8, 0x35,
+	0x1f, 0x89, 0x58, 0x2d, 0x23, 0x12, 0xb1, 0xd7, 0x08, 0x06, 0x89, 0x44, 0x3c, 0xb2, 0x68, 0xd8,
+	0x5d, 0x07, 0xb4, 0xaf, 0x68, 0x58, 0x1d, 0x2a, 0x5c, 0x4a, 0x8c, 0x58, 0x9f, 0x4f, 0xd5, 0x33,
+	0x54, 0x58, 0x87, 0x69, 0x8a, 0xf8, 0x47, 0xc9, 0x62, 0x3e, 0x54, 0xfe, 0x00, 0x06, 0x08, 0xd3,
+	0x94, 0x21, 0x98, 0x76, 0x68, 0xf0, 0x48, 0x19, 0xa1, 0xc1, 0xbd, 0x86, 0xb3, 0x67, 0x68, 0xf0,
+	0x2b, 0x70, 0xcc, 0x0f, 0xe3, 0x88, 0x2e, 0x27, 0x71, 0x16, 0xfb, 0xb1, 0xaa, 0x46, 0xad, 0xcf,
+	0x97, 0x59, 0x1b, 0x88, 0x79, 0xdc, 0x7e, 0x71, 0xc5, 0xf5, 0xc3, 0xc6, 0x15, 0xc3, 0x23, 0x8a,
+	0x2b, 0xfe, 0x01, 0x93, 0xb1, 0x63, 0x8c, 0x7f, 0x91, 0x0f, 0x97, 0xff, 0x45, 0x06, 0x49, 0xdb,
+	0x41, 0xde, 0x10, 0xc5, 0xd8, 0x99, 0x40, 0x3e, 0x1b, 0xb7, 0x98, 0xc0, 0x39, 0xce, 0xa7, 0xe4,
+	0x23, 0x47, 0xb0, 0x60, 0x6f, 0xaf, 0x18, 0x32, 0xba, 0x40, 0xbb, 0x69, 0xc2, 0xfc, 0x40, 0x0e,
+	0x93, 0x51, 0xe4, 0x27, 0x2a, 0xf0, 0x0d, 0x7b, 0x0e, 0x81, 0xdc, 0x05, 0xc8, 0xbc, 0xa6, 0x5c,
+	0xa8, 0xd2, 0x64, 0x77, 0x48, 0x97, 0xea, 0x55, 0xd5, 0x9f, 0x48, 0x42, 0xa7, 0x7f, 0x72, 0x63,
+	0x98, 0xfa, 0x9f, 0x7b, 0x52, 0xc7, 0x61, 0x57, 0x5e, 0x70, 0x8c, 0x43, 0x8a, 0x1c, 0xc2, 0x64,
+	0x89, 0x84, 0x36, 0x99, 0x28, 0x5d, 0xcd, 0xcb, 0x12, 0xc8, 0x5b, 0x51, 0x42, 0x19, 0x87, 0xf5,
+	0xc2, 0x50, 0x84, 0x39, 0xd2, 0x54, 0x56, 0x20, 0x35, 0x7a, 0x5a, 0x03, 0x42, 0x1b, 0xcf, 0xfd,
+	0xc3, 0x0a, 0x4c, 0xee, 0x71, 0xa6, 0x74, 0x05, 0x6e, 0xd7, 0x06, 0x0e, 0xdc, 0x96, 0xa1, 0x66,
+	0xc3, 0x7d, 0x42, 0xcd, 0x5e, 0x82, 0xb1, 0x8c, 0x7a, 0x2d, 0xe9, 0x84, 0x29, 0x35, 0x10, 0xc6,
+	0x07, 0xc1, 0x80, 0xd0, 0xc6, 0x63, 0xa7, 0xd8, 0x71, 0xcf, 0xf7, 0x69, 0x9a, 0xaa, 0x58, 0x32,
+	0xa9, 0xcf, 0x2f, 0x2d, 0x50, 0x8d, 0x9b, 0x49, 0xa6, 0x73, 0x24, 0xb0, 0x40, 0xb2, 0x38, 0xe1,
+	0xf5, 0x01, 0x27, 0xfc, 0x67, 0x2a, 0xf0, 0xcc, 0xae, 0xdc, 0x6d, 0xe0, 0x30, 0xbf, 0x4e, 0x4a,
+	0x93, 0xe2, 0xc2, 0xb9, 0x99, 0xd2, 0x04, 0x39, 0x44, 0xcc, 0x52, 0xbb, 0xad, 0x1d, 0xe8, 0xcb,
+	0x8f, 0x41, 0x15, 0xb3, 0x94, 0x23, 0x81, 0x05, 0x92, 0x07, 0x5d, 0x96, 0x7f, 0xbd, 0x02, 0xcf,
+	0x0d, 0x20, 0x03, 0x94, 0x18, 0xab, 0x9b, 0x8f, 0x98, 0xae, 0x3e, 0xa2, 0xc0, 0xf6, 0x03, 0x4e,
+	0xd7, 0x57, 0x2a, 0x70, 0xae, 0x3f, 0x2b, 0x26, 0xef, 0x86, 0x13, 0x89, 0xf6, 0xbc, 0xb4, 0x83,
+	0xad, 0x4f, 0x0b, 0x0d, 0x46, 0x0e, 0x84, 0x45, 0x5c, 0x32, 0x05, 0xd0, 0xf6, 0xb2, 0x8d, 0xf4,
+	0xd2, 0xbd, 0x80, 0x67, 0x03, 0x67, 0xd7, 0x8b, 0xe3, 0xc2, 0x7a, 0xaa, 0x5a, 0xd1, 0xc2, 0x60,
+	0xe4, 0xf8, 0xaf, 0xb9, 0xf8, 0x46, 0x9c, 0x89, 0x87, 0xc4, 0x9d, 0xe4, 0xb4, 0x2a, 0x5f, 0x67,
+	0x81, 0xb0, 0x88, 0xcb, 0xc8, 0x71, 0xcb, 0x96, 0x18, 0xa8, 0xb8, 0xac, 0x70, 0x72, 0x0b, 0xba,
+	0x15, 0x2d, 0x8c, 0x62, 0x18, 0x79, 0x6d, 0xef, 0x30, 0x72, 0xf7, 0xe7, 0x2b, 0xf0, 0x54, 0x5f,
+	0x51, 0x6e, 0xb0, 0x0d, 0xf8, 0xf8, 0x85, 0x7e, 0x1f, 0x6c, 0xed, 0xec, 0x33, 0x44, 0xf9, 0xf7,
+	0xfa, 0xac, 0x34, 0x19, 0xa2, 0x7c, 0xf0, 0x1c, 0x1f, 0x8f, 0xdf, 0x7c, 0x76, 0x45, 0x25, 0x0f,
+	0xed, 0x23, 0x2a, 0xb9, 0xf0, 0x31, 0x6a, 0x03, 0x6e, 0xe4, 0x3f, 0xae, 0xf6, 0x9d, 0x5e, 0x76,
+	0xf5, 0x1b, 0x48, 0x3f, 0x3c, 0x07, 0x27, 0x83, 0x88, 0x97, 0x32, 0x5d, 0xe9, 0xac, 0xc9, 0xfc,
+	0x52, 0x22, 0xa9, 0xa7, 0x0e, 0xa9, 0x99, 0x2f, 0xc0, 0xb1, 0xeb, 0x89, 0xc7, 0x30, 0x4a, 0xfc,
+	0x60, 0x53, 0xba, 0xbf, 0x3c, 0x05, 0x64, 0x09, 0xce, 0xaa, 0xa9, 0xd8, 0xf0, 0x12, 0xda, 0x90,
+	0x6c, 0x24, 0x95, 0x41, 0x54, 0x4f, 0x89, 0x40, 0xac, 0x1e, 0x08, 0xd8, 0xfb, 0x39, 0x5e, 0x3d,
+	0x32, 0x6e, 0x07, 0xbe, 0xbc, 0xe4, 0x98, 0xea, 0x91, 0xac, 0x11, 0x05, 0xcc, 0xfd, 0x30, 0xd4,
+	0xf5, 0xfb, 0x8b, 0x50, 0x0e, 0xbd, 0xe8, 0xba, 0x42, 0x39, 0xf4, 0x8a, 0xb3, 0xb0, 0xd8, 0xd7,
+	0x62, 0x22, 0x71, 0x61, 0xf7, 0x5c, 0xa7, 0xdb, 0x5c, 0x3e, 0x76, 0xbf, 0x05, 0xc6, 0xb5, 0xd2,
+	0x66, 0xd0, 0x9a, 0x9a, 0xee, 0xfd, 0x1a, 0x9c, 0x5a, 0xd9, 0x8e, 0x7c, 0xa9, 0xd6, 0x45, 0xea,
+	0xc7, 0x49, 0x43, 0xaa, 0x53, 0x9d, 0x9e, 0xea, 0xd4, 0x9c, 0xba, 0xaf, 0xb2, 0x4f, 0x75, 0x5f,
+	0xf5, 0x91, 0xa9, 0xfb, 0x72, 0xa1, 0xf9, 0x43, 0x47, 0x9a, 0xc2, 0xba, 0x56, 0x76, 0x0a, 0x6b,
+	0x2b, 0xec, 0x7f, 0x78, 0xa0, 0xb0, 0x7f, 0x9d, 0xc7, 0x7c, 0xe4, 0xe1, 0xe5, 0x31, 0x1f, 0xdd,
+	0x43, 0xe1, 0xb8, 0xcd, 0xd6, 0x8d, 0x52, 0x52, 0xd7, 0xcb, 0x30, 0xa2, 0xe7, 0x6d, 0xc2, 0x6a,
+	0x15, 0x4a, 0x12, 0x68, 0xa8, 0xb9, 0x5f, 0x1d, 0x81, 0x63, 0xb9, 0x94, 0xe7, 0x39, 0xeb, 0x85,
+	0xb3, 0xa7, 0xf5, 0x82, 0xc7, 0x9f, 0x75, 0x22, 0x55, 0x55, 0xda, 0x8a, 0x3f, 0xeb, 0x44, 0x6c,
+	0x2a, 0xd8, 0x1f, 0x2b, 0xb9, 0x72, 0x75, 0xd7, 0xe4, 0xca, 0x9f, 0x70, 0x60, 0x5c, 0x14, 0x0f,
+	0x17, 0xb6, 0x1f, 0xb9, 0x18, 0xaf, 0x1d, 0x3e, 0xa3, 0xbb, 0x4e, 0xef, 0xcf, 0x1d, 0x11, 0xed,
+	0x16, 0xcc, 0x51, 0x24, 0x9f, 0x72, 0xec, 0x6f, 0x31, 0x5c, 0x46, 0x7c, 0x53, 0x31, 0xa3, 0xbc,
+	0x30, 0x1a, 0xe8, 0x5d, 0xd3, 0xeb, 0xb3, 0x90, 0x54, 0x1b, 0x66, 0x46, 0x8e, 0xc6, 0x30, 0x03,
+	0x3d, 0x8c, 0x32, 0x6f, 0x87, 0xba, 0xaa, 0x68, 0x23, 0x6c, 0x25, 0xaa, 0xd0, 0x85, 0x6a, 0x44,
+	0x03, 0x67, 0x52, 0x67, 0xca, 0x5f, 0x2c, 0xb3, 0x8c, 0x1b, 0x5c, 0xea, 0x5c, 0x31, 0xcd, 0x68,
+	0xe3, 0xd8, 0x96, 0x18, 0x78, 0xa4, 0x96, 0x98, 0xb1, 0x3d, 0x8e, 0xe6, 0x77, 0xc3, 0x09, 0x7f,
+	0xc3, 0x8b, 0x9a, 0x54, 0x43, 0x27, 0xc6, 0x8d, 0x00, 0x3f, 0x9b, 0x07, 0x61, 0x11, 0x97, 0xbc,
+	0x07, 0x8e, 0xe7, 0x9b, 0x64, 0x20, 0xbf, 0x0e, 0xfd, 0xc8, 0xf7, 0x80, 0x05, 0x6c, 0x2e, 0xc0,
+	0x70, 0x9f, 0x1b, 0xbe, 0x89, 0xb8, 0x9d, 0x53, 0x06, 0xd4, 0x1a, 0x01, 0xa6, 0x00, 0xc7, 0xae,
+	0x27, 0xdc, 0xbf, 0xe9, 0xc0, 0xd9, 0x9e, 0x4b, 0xef, 0xf1, 0x75, 0x92, 0x77, 0xff, 0x65, 0x0d,
+	0x4e, 0xf7, 0x28, 0xc0, 0x90, 0x3f, 0x20, 0x9d, 0x87, 0x79, 0x40, 0xee, 0xd3, 0x98, 0x6b, 0x0c,
+	0xaa, 0xd5, 0x87, 0x6b, 0x50, 0xb5, 0xf6, 0xd6, 0xd0, 0x23, 0xdd, 0x5b, 0xb5, 0x3d, 0xf6, 0xd6,
+	0xcf, 0x39, 0x30, 0xd1, 0xea, 0x53, 0x61, 0x4c, 0x9a, 0x08, 0x6e, 0x1d, 0x4d, 0xfd, 0xb2, 0x99,
+	0xa7, 0xef, 0xef, 0x4c, 0xf6, 0x2d, 0xec, 0x86, 0x7d, 0x47, 0xc5, 0xf7, 0xb3, 0xd7, 0x66, 0xb2,
+	0x63, 0x63, 0x21, 0x6e, 0xa6, 0xf3, 0x73, 0x52, 0x76, 0x30, 0xfb, 0x39, 0x07, 0xc5, 0x02, 0xb6,
+	0xfb, 0xb5, 0x2a, 0xf0, 0xea, 0x21, 0x32, 0x2b, 0xf1, 0xc7, 0xed, 0x3a, 0x30, 0x4e, 0x59, 0x35,
+	0x4b, 0x44, 0xe7, 0xba, 0x8e, 0x8c, 0xf8, 0x02, 0xbd, 0xca, 0xca, 0x14, 0x4f, 0xee, 0xca, 0x00,
+	0x27, 0x77, 0xa8, 0x0a, 0xee, 0x54, 0xcb, 0x2f, 0xb8, 0x53, 0x2f, 0x16, 0xdb, 0xd9, 0x7d, 0x89,
+	0x0c, 0x3d, 0x8e, 0x4b, 0xc4, 0xfd, 0xf9, 0x8a, 0x38, 0xb8, 0x0a, 0x5f, 0xc1, 0x88, 0x47, 0xce,
+	0x2e, 0xe2, 0xd1, 0x0b, 0x30, 0x9a, 0xd2, 0x70, 0xfd, 0x2a, 0xf5, 0x42, 0x29, 0x46, 0x19, 0x0f,
+	0x19, 0xd9, 0x8e, 0x1a, 0x83, 0x09, 0xbd, 0xbc, 0x1a, 0xc4, 0xa5, 0x56, 0x3b, 0xdb, 0x96, 0x02,
+	0x95, 0x16, 0x7a, 0xa7, 0x35, 0x04, 0x2d, 0x2c, 0xf2, 0x86, 0x03, 0x24, 0xe9, 0xb2, 0x4a, 0xcb,
+	0xb9, 0x3c, 0xe4, 0x95, 0xa3, 0xdb, 0xda, 0x2d, 0x2a, 0xbc, 0x77, 0xb7, 0x63, 0x8f, 0x31, 0xb8,
+	0x7f, 0xa1, 0x22, 0x36, 0x87, 0xf4, 0x00, 0x7b, 0xb9, 0x50, 0xf8, 0x7e, 0x70, 0xe7, 0xa9, 0x8f,
+	0x01, 0x88, 0xfc, 0x5c, 0xb4, 0xb1, 0x1a, 0x4b, 0xc3, 0xf4, 0xd5, 0xc3, 0xe6, 0x34, 0x51, 0xfd,
+	0x99, 0x19, 0x36, 0x6d, 0x68, 0xd1, 0xcb, 0xb1, 0x89, 0xea, 0x9e, 0x6c, 0x22, 0x77, 0x62, 0x0e,
+	0xed, 0x7e, 0x62, 0xba, 0x7f, 0xe8, 0x40, 0x4e, 0x62, 0x25, 0x6d, 0xa8, 0xb1, 0xe1, 0x6e, 0xcb,
+	0xc3, 0x63, 0xa9, 0x3c, 0xf1, 0x98, 0x9d, 0xfa, 0x72, 0x47, 0xf2, 0x7f, 0x51, 0x10, 0x22, 0xa1,
+	0x74, 0x14, 0xab, 0x94, 0xe1, 0xc9, 0x6e, 0x13, 0xbc, 0x1a, 0xc7, 0x9b, 0xc2, 0x55, 0xc3, 0x38,
+	0x9d, 0xb9, 0x2f, 0x8b, 0x7b, 0x77, 0x6e, 0x50, 0xbc, 0xde, 0x74, 0xcc, 0x18, 0x6b, 0x61, 0x27,
+	0xf1, 0xcc, 0x11, 0x28, 0x60, 0xee, 0x57, 0x1c, 0x38, 0x59, 0xec, 0x9e, 0x2d, 0xfe, 0x53, 0x69,
+	0xb1, 0xbf, 0xa3, 0x9a, 0x3b, 0xed, 0xec, 0xdd, 0x05, 0xc2, 0xee, 0x41, 0xb8, 0x7f, 0x22, 0x17,
+	0xff, 0xed, 0x20, 0x6a, 0xc4, 0x77, 0xb5, 0xcc, 0xe5, 0xf4, 0x95, 0xb9, 0xd8, 0x51, 0xe1, 0x6f,
+	0xd0, 0x46, 0x27, 0xec, 0x4a, 0x59, 0xb1, 0x22, 0xdb, 0x51, 0x63, 0xf0, 0x08, 0x7d, 0x79, 0xf3,
+	0x2d, 0x2e, 0x4a, 0x75, 0x3b, 0x46, 0x8d, 0x41, 0xde, 0x09, 0xe3, 0xd6, 0x4b, 0xaa, 0x75, 0xc9,
+	0x2f, 0x4c, 0x96, 0x34, 0x90, 0x62, 0x0e, 0x8b, 0x4c, 0x01, 0x68, 0xf9, 0x4d, 0x71, 0x7f, 0x7e,
+	0x67, 0xd7, 0x87, 0x64, 0x8a, 0x16, 0x06, 0xcf, 0x87, 0x11, 0x76, 0x52, 0x6e, 0x68, 0x1c, 0x36,
+	0x69, 0xe9, 0x67, 0x65, 0x1b, 0x6a, 0x28, 0x3b, 0xe8, 0x5a, 0x5e, 0xd4, 0xf1, 0x42, 0x36, 0x43,
+	0x52, 0xff, 0xa4, 0xb7, 0xe1, 0xa2, 0x86, 0xa0, 0x85, 0xc5, 0xde, 0x38, 0x0b, 0x5a, 0xf4, 0x03,
+	0x71, 0xa4, 0x6e, 0xdd, 0xc6, 0xf6, 0x2c, 0xdb, 0x51, 0x63, 0xb8, 0xff, 0xc1, 0x81, 0x13, 0x26,
+	0xbb, 0x0e, 0xaf, 0xc3, 0x91, 0x53, 0x97, 0x39, 0x7b, 0xaa, 0xcb, 0xf2, 0x69, 0x47, 0x2a, 0x03,
+	0xa5, 0x1d, 0xb1, 0x33, 0x82, 0x54, 0x77, 0xcd, 0x08, 0xf2, 0x4d, 0x30, 0xb2, 0x49, 0xb7, 0xad,
+	0xd4, 0x21, 0xbc, 0xe0, 0xcb, 0x75, 0xd1, 0x84, 0x0a, 0x46, 0x5c, 0x18, 0xf6, 0x3d, 0x9d, 0x5a,
+	0x6e, 0x5c, 0xdc, 0xed, 0x66, 0xa7, 0x39, 0x92, 0x84, 0xb8, 0x4b, 0x50, 0xd7, 0x26, 0x58, 0xa5,
+	0x2d, 0x73, 0x7a, 0x6b, 0xcb, 0x06, 0xca, 0x4c, 0x30, 0xb3, 0xf6, 0x6b, 0x7f, 0xf0, 0xec, 0x9b,
+	0x7e, 0xf3, 0x0f, 0x9e, 0x7d, 0xd3, 0xef, 0xfe, 0xc1, 0xb3, 0x6f, 0xfa, 0xc4, 0xfd, 0x67, 0x9d,
+	0x5f, 0xbb, 0xff, 0xac, 0xf3, 0x9b, 0xf7, 0x9f, 0x75, 0x7e, 0xf7, 0xfe, 0xb3, 0xce, 0xd7, 0xee,
+	0x3f, 0xeb, 0x7c, 0xf1, 0xdf, 0x3e, 0xfb, 0xa6, 0x0f, 0xf4, 0xf4, 0xd2, 0x66, 0xff, 0xbc, 0xc3,
+	0x6f, 0x5c, 0xd8, 0xba, 0xc8, 0x55, 0x29, 0x6c, 0x7b, 0x5d, 0xb0, 0xd6, 0xd4, 0x05, 0xb5, 0xbd,
+	0xfe, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x07, 0xd1, 0xb8, 0x27, 0x02, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5371,6 +5925,48 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManagedNamespaceTemplates) > 0 {
+		for iNdEx := len(m.ManagedNamespaceTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedNamespaceTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Operations != nil {
+		{
+			size, err := m.Operations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.DestinationServiceAccounts) > 0 {
+		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationServiceAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	i--
 	if m.PermitOnlyProjectScopedClusters {
 		dAtA[i] = 1
@@ -5691,6 +6287,49 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Sync)
+	copy(dAtA[i:], m.Sync)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sync)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Health)
+	copy(dAtA[i:], m.Health)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Health)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5729,6 +6368,44 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDestinationServiceAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DefaultServiceAccount)
+	copy(dAtA[i:], m.DefaultServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultServiceAccount)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5818,6 +6495,51 @@ func (m *ApplicationMatchExpression) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.PausedBy)
+	copy(dAtA[i:], m.PausedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PausedBy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationPreservedFields) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7687,6 +8409,32 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Sources) > 0 {
 		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7951,6 +8699,30 @@ func (m *ApplicationSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChildApplications != nil {
+		{
+			size, err := m.ChildApplications.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Images) > 0 {
 		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Images[iNdEx])
@@ -8188,6 +8960,74 @@ func (m *BearerTokenBitbucketCloud) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *CapturedContainerLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapturedContainerLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapturedContainerLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CapturedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Logs)
+	copy(dAtA[i:], m.Logs)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Logs)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.PodName)
+	copy(dAtA[i:], m.PodName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ChartDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8230,6 +9070,90 @@ func (m *ChartDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChildApplicationsSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildApplicationsSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildApplicationsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failing) > 0 {
+		for iNdEx := len(m.Failing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HealthStatuses) > 0 {
+		keysForHealthStatuses := make([]string, 0, len(m.HealthStatuses))
+		for k := range m.HealthStatuses {
+			keysForHealthStatuses = append(keysForHealthStatuses, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHealthStatuses)
+		for iNdEx := len(keysForHealthStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.HealthStatuses[string(keysForHealthStatuses[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForHealthStatuses[iNdEx])
+			copy(dAtA[i:], keysForHealthStatuses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHealthStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SyncStatuses) > 0 {
+		keysForSyncStatuses := make([]string, 0, len(m.SyncStatuses))
+		for k := range m.SyncStatuses {
+			keysForSyncStatuses = append(keysForSyncStatuses, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSyncStatuses)
+		for iNdEx := len(keysForSyncStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SyncStatuses[string(keysForSyncStatuses[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForSyncStatuses[iNdEx])
+			copy(dAtA[i:], keysForSyncStatuses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSyncStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Total))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8250,6 +9174,14 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.AutoNamespaces {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -8426,6 +9358,54 @@ func (m *ClusterCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	if m.OpenedAt != nil {
+		{
+			size, err := m.OpenedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.State)
+	copy(dAtA[i:], m.State)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8598,6 +9578,18 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.APIVersions) > 0 {
 		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.APIVersions[iNdEx])
@@ -9147,6 +10139,68 @@ func (m *ExecProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailingChildApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailingChildApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailingChildApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.OperationMessage)
+	copy(dAtA[i:], m.OperationMessage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OperationMessage)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.HealthStatus)
+	copy(dAtA[i:], m.HealthStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthStatus)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.SyncStatus)
+	copy(dAtA[i:], m.SyncStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncStatus)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GitDirectoryGeneratorItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9436,6 +10490,18 @@ func (m *HealthStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -10253,7 +11319,7 @@ func (m *ManagedNamespaceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MatrixGenerator) Marshal() (dAtA []byte, err error) {
+func (m *ManagedNamespaceTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10263,44 +11329,30 @@ func (m *MatrixGenerator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MatrixGenerator) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MatrixGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Manifest)
+	copy(dAtA[i:], m.Manifest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manifest)))
 	i--
 	dAtA[i] = 0x12
-	if len(m.Generators) > 0 {
-		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeGenerator) Marshal() (dAtA []byte, err error) {
+func (m *MatrixGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10310,12 +11362,12 @@ func (m *MergeGenerator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeGenerator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MatrixGenerator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MatrixGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10329,16 +11381,7 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.MergeKeys) > 0 {
-		for iNdEx := len(m.MergeKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MergeKeys[iNdEx])
-			copy(dAtA[i:], m.MergeKeys[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
+	dAtA[i] = 0x12
 	if len(m.Generators) > 0 {
 		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10356,7 +11399,7 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
+func (m *MergeGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10366,53 +11409,109 @@ func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NestedMatrixGenerator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MergeGenerator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NestedMatrixGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Generators) > 0 {
-		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NestedMergeGenerator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NestedMergeGenerator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MergeKeys) > 0 {
+		for iNdEx := len(m.MergeKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MergeKeys[iNdEx])
+			copy(dAtA[i:], m.MergeKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Generators) > 0 {
+		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NestedMatrixGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NestedMatrixGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Generators) > 0 {
+		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NestedMergeGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NestedMergeGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.MergeKeys) > 0 {
 		for iNdEx := len(m.MergeKeys) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MergeKeys[iNdEx])
@@ -10741,6 +11840,136 @@ func (m *OrphanedResourceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourceSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourceSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LabelSelector != nil {
+		{
+			size, err := m.LabelSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrphanedResourcesCleanupPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesCleanupPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourcesCleanupPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxDeletions))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.MinAge)
+	copy(dAtA[i:], m.MinAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinAge)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Ignore) > 0 {
+		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ignore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10761,6 +11990,18 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Cleanup != nil {
+		{
+			size, err := m.Cleanup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Ignore) > 0 {
 		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -11000,6 +12241,37 @@ func (m *PluginInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectOperationsPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectOperationsPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectOperationsPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PriorityClass)
+	copy(dAtA[i:], m.PriorityClass)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClass)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxConcurrent))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11591,6 +12863,92 @@ func (m *PullRequestGeneratorGithub) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ReconcilePhaseTiming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcilePhaseTiming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcilePhaseTiming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationMs))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.ComparisonLevel)
+	copy(dAtA[i:], m.ComparisonLevel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ComparisonLevel)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationMs))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RefTarget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12273,6 +13631,60 @@ func (m *ResourceActions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Pods))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.Storage)
+	copy(dAtA[i:], m.Storage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Storage)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.MemoryLimits)
+	copy(dAtA[i:], m.MemoryLimits)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MemoryLimits)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.MemoryRequests)
+	copy(dAtA[i:], m.MemoryRequests)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MemoryRequests)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.CPULimits)
+	copy(dAtA[i:], m.CPULimits)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CPULimits)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.CPURequests)
+	copy(dAtA[i:], m.CPURequests)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CPURequests)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12560,6 +13972,18 @@ func (m *ResourceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -13042,6 +14466,14 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Healthy {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -13175,6 +14607,34 @@ func (m *RevisionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollbackOnDegraded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackOnDegraded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackOnDegraded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.GracePeriod)
+	copy(dAtA[i:], m.GracePeriod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GracePeriod)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SCMProviderGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13885,6 +15345,100 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x3a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationMs))
+	i--
+	dAtA[i] = 0x30
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13905,6 +15459,14 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.IgnorePruneLimit {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
 	i -= len(m.ChangeRevision)
 	copy(dAtA[i:], m.ChangeRevision)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChangeRevision)))
@@ -14085,6 +15647,31 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SyncWavePhase)
+	copy(dAtA[i:], m.SyncWavePhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncWavePhase)))
+	i--
+	dAtA[i] = 0x52
+	if m.SyncWaveAppliedAt != nil {
+		{
+			size, err := m.SyncWaveAppliedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.CapturedLogsID)
+	copy(dAtA[i:], m.CapturedLogsID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CapturedLogsID)))
+	i--
+	dAtA[i] = 0x3a
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -14240,6 +15827,18 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RollbackOnDegraded != nil {
+		{
+			size, err := m.RollbackOnDegraded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i--
 	if m.AllowEmpty {
 		dAtA[i] = 1
@@ -14729,6 +16328,22 @@ func (m *AppProjectSpec) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, e := range m.DestinationServiceAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Operations != nil {
+		l = m.Operations.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ManagedNamespaceTemplates) > 0 {
+		for _, e := range m.ManagedNamespaceTemplates {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -14786,6 +16401,23 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Health)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Sync)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14801,6 +16433,21 @@ func (m *ApplicationDestination) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDestinationServiceAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DefaultServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14837,6 +16484,23 @@ func (m *ApplicationMatchExpression) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PausedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationPreservedFields) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15565,6 +17229,16 @@ func (m *ApplicationSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15643,6 +17317,14 @@ func (m *ApplicationSummary) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ChildApplications != nil {
+		l = m.ChildApplications.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15730,6 +17412,31 @@ func (m *BearerTokenBitbucketCloud) Size() (n int) {
 	return n
 }
 
+func (m *CapturedContainerLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Logs)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CapturedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ChartDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15749,6 +17456,38 @@ func (m *ChartDetails) Size() (n int) {
 	return n
 }
 
+func (m *ChildApplicationsSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Total))
+	if len(m.SyncStatuses) > 0 {
+		for k, v := range m.SyncStatuses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.HealthStatuses) > 0 {
+		for k, v := range m.HealthStatuses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Failing) > 0 {
+		for _, e := range m.Failing {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15799,6 +17538,7 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	n += 2
 	return n
 }
 
@@ -15817,6 +17557,24 @@ func (m *ClusterCacheInfo) Size() (n int) {
 	return n
 }
 
+func (m *ClusterCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	if m.OpenedAt != nil {
+		l = m.OpenedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ClusterConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15885,6 +17643,10 @@ func (m *ClusterInfo) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16080,6 +17842,31 @@ func (m *ExecProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *FailingChildApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.OperationMessage)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GitDirectoryGeneratorItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16191,6 +17978,10 @@ func (m *HealthStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16491,6 +18282,19 @@ func (m *ManagedNamespaceMetadata) Size() (n int) {
 	return n
 }
 
+func (m *ManagedNamespaceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Manifest)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *MatrixGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16675,6 +18479,53 @@ func (m *OrphanedResourceKey) Size() (n int) {
 	return n
 }
 
+func (m *OrphanedResourceSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LabelSelector != nil {
+		l = m.LabelSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OrphanedResourcesCleanupPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	if len(m.Allow) > 0 {
+		for _, e := range m.Allow {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Ignore) > 0 {
+		for _, e := range m.Ignore {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.MinAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxDeletions))
+	return n
+}
+
 func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16690,6 +18541,10 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16775,6 +18630,18 @@ func (m *PluginInput) Size() (n int) {
 	return n
 }
 
+func (m *ProjectOperationsPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxConcurrent))
+	l = len(m.PriorityClass)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ProjectRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17009,6 +18876,38 @@ func (m *PullRequestGeneratorGithub) Size() (n int) {
 	return n
 }
 
+func (m *ReconcilePhaseTiming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationMs))
+	return n
+}
+
+func (m *ReconcileProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationMs))
+	l = len(m.ComparisonLevel)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RefTarget) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17245,6 +19144,27 @@ func (m *ResourceActions) Size() (n int) {
 	return n
 }
 
+func (m *ResourceCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CPURequests)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CPULimits)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MemoryRequests)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MemoryLimits)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Storage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Pods))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	return n
+}
+
 func (m *ResourceDiff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17410,6 +19330,10 @@ func (m *ResourceNode) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17560,6 +19484,7 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17586,6 +19511,17 @@ func (m *RevisionMetadata) Size() (n int) {
 	return n
 }
 
+func (m *RollbackOnDegraded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GracePeriod)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SCMProviderGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17841,6 +19777,41 @@ func (m *SignatureKey) Size() (n int) {
 	return n
 }
 
+func (m *SyncHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ID))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.DurationMs))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SyncOperation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17897,6 +19868,7 @@ func (m *SyncOperation) Size() (n int) {
 	}
 	l = len(m.ChangeRevision)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17949,6 +19921,15 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.CapturedLogsID)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SyncWave))
+	if m.SyncWaveAppliedAt != nil {
+		l = m.SyncWaveAppliedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SyncWavePhase)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17988,6 +19969,10 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	if m.RollbackOnDegraded != nil {
+		l = m.RollbackOnDegraded.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18213,6 +20198,16 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceBlacklist += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForClusterResourceBlacklist += "}"
+	repeatedStringForDestinationServiceAccounts := "[]ApplicationDestinationServiceAccount{"
+	for _, f := range this.DestinationServiceAccounts {
+		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForManagedNamespaceTemplates := "[]ManagedNamespaceTemplate{"
+	for _, f := range this.ManagedNamespaceTemplates {
+		repeatedStringForManagedNamespaceTemplates += strings.Replace(strings.Replace(f.String(), "ManagedNamespaceTemplate", "ManagedNamespaceTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForManagedNamespaceTemplates += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -18227,6 +20222,9 @@ func (this *AppProjectSpec) String() string {
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
+		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`Operations:` + strings.Replace(this.Operations.String(), "ProjectOperationsPolicy", "ProjectOperationsPolicy", 1) + `,`,
+		`ManagedNamespaceTemplates:` + repeatedStringForManagedNamespaceTemplates + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18276,6 +20274,19 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Health:` + fmt.Sprintf("%v", this.Health) + `,`,
+		`Sync:` + fmt.Sprintf("%v", this.Sync) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -18288,6 +20299,18 @@ func (this *ApplicationDestination) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDestinationServiceAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestinationServiceAccount{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`DefaultServiceAccount:` + fmt.Sprintf("%v", this.DefaultServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -18316,6 +20339,18 @@ func (this *ApplicationMatchExpression) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPause{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`PausedBy:` + fmt.Sprintf("%v", this.PausedBy) + `,`,
+		`Until:` + strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPreservedFields) String() string {
 	if this == nil {
 		return "nil"
@@ -18810,6 +20845,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -18819,6 +20859,8 @@ func (this *ApplicationSpec) String() string {
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "ApplicationPause", "ApplicationPause", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18867,6 +20909,8 @@ func (this *ApplicationSummary) String() string {
 	s := strings.Join([]string{`&ApplicationSummary{`,
 		`ExternalURLs:` + fmt.Sprintf("%v", this.ExternalURLs) + `,`,
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`ChildApplications:` + strings.Replace(this.ChildApplications.String(), "ChildApplicationsSummary", "ChildApplicationsSummary", 1) + `,`,
+		`Capacity:` + strings.Replace(this.Capacity.String(), "ResourceCapacity", "ResourceCapacity", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18942,6 +20986,23 @@ func (this *BearerTokenBitbucketCloud) String() string {
 	}, "")
 	return s
 }
+func (this *CapturedContainerLogs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CapturedContainerLogs{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`Logs:` + fmt.Sprintf("%v", this.Logs) + `,`,
+		`CapturedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CapturedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ChartDetails) String() string {
 	if this == nil {
 		return "nil"
@@ -18954,6 +21015,44 @@ func (this *ChartDetails) String() string {
 	}, "")
 	return s
 }
+func (this *ChildApplicationsSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFailing := "[]FailingChildApplication{"
+	for _, f := range this.Failing {
+		repeatedStringForFailing += strings.Replace(strings.Replace(f.String(), "FailingChildApplication", "FailingChildApplication", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFailing += "}"
+	keysForSyncStatuses := make([]string, 0, len(this.SyncStatuses))
+	for k := range this.SyncStatuses {
+		keysForSyncStatuses = append(keysForSyncStatuses, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSyncStatuses)
+	mapStringForSyncStatuses := "map[string]int64{"
+	for _, k := range keysForSyncStatuses {
+		mapStringForSyncStatuses += fmt.Sprintf("%v: %v,", k, this.SyncStatuses[k])
+	}
+	mapStringForSyncStatuses += "}"
+	keysForHealthStatuses := make([]string, 0, len(this.HealthStatuses))
+	for k := range this.HealthStatuses {
+		keysForHealthStatuses = append(keysForHealthStatuses, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHealthStatuses)
+	mapStringForHealthStatuses := "map[string]int64{"
+	for _, k := range keysForHealthStatuses {
+		mapStringForHealthStatuses += fmt.Sprintf("%v: %v,", k, this.HealthStatuses[k])
+	}
+	mapStringForHealthStatuses += "}"
+	s := strings.Join([]string{`&ChildApplicationsSummary{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`SyncStatuses:` + mapStringForSyncStatuses + `,`,
+		`HealthStatuses:` + mapStringForHealthStatuses + `,`,
+		`Failing:` + repeatedStringForFailing + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
@@ -18992,6 +21091,7 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`AutoNamespaces:` + fmt.Sprintf("%v", this.AutoNamespaces) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19008,6 +21108,19 @@ func (this *ClusterCacheInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterCircuitBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCircuitBreaker{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`OpenedAt:` + strings.Replace(fmt.Sprintf("%v", this.OpenedAt), "Time", "v1.Time", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -19057,6 +21170,7 @@ func (this *ClusterInfo) String() string {
 		`CacheInfo:` + strings.Replace(strings.Replace(this.CacheInfo.String(), "ClusterCacheInfo", "ClusterCacheInfo", 1), `&`, ``, 1) + `,`,
 		`ApplicationsCount:` + fmt.Sprintf("%v", this.ApplicationsCount) + `,`,
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "ClusterCircuitBreaker", "ClusterCircuitBreaker", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19217,6 +21331,26 @@ func (this *ExecProviderConfig) String() string {
 	}, "")
 	return s
 }
+func (this *FailingChildApplication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]ApplicationCondition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&FailingChildApplication{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`SyncStatus:` + fmt.Sprintf("%v", this.SyncStatus) + `,`,
+		`HealthStatus:` + fmt.Sprintf("%v", this.HealthStatus) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`OperationMessage:` + fmt.Sprintf("%v", this.OperationMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GitDirectoryGeneratorItem) String() string {
 	if this == nil {
 		return "nil"
@@ -19313,6 +21447,7 @@ func (this *HealthStatus) String() string {
 	s := strings.Join([]string{`&HealthStatus{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19582,6 +21717,17 @@ func (this *ManagedNamespaceMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *ManagedNamespaceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManagedNamespaceTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MatrixGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19733,6 +21879,45 @@ func (this *OrphanedResourceKey) String() string {
 	}, "")
 	return s
 }
+func (this *OrphanedResourceSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourceSelector{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesCleanupPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAllow := "[]OrphanedResourceSelector{"
+	for _, f := range this.Allow {
+		repeatedStringForAllow += strings.Replace(strings.Replace(f.String(), "OrphanedResourceSelector", "OrphanedResourceSelector", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAllow += "}"
+	repeatedStringForIgnore := "[]OrphanedResourceSelector{"
+	for _, f := range this.Ignore {
+		repeatedStringForIgnore += strings.Replace(strings.Replace(f.String(), "OrphanedResourceSelector", "OrphanedResourceSelector", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIgnore += "}"
+	s := strings.Join([]string{`&OrphanedResourcesCleanupPolicy{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Allow:` + repeatedStringForAllow + `,`,
+		`Ignore:` + repeatedStringForIgnore + `,`,
+		`MinAge:` + fmt.Sprintf("%v", this.MinAge) + `,`,
+		`MaxDeletions:` + fmt.Sprintf("%v", this.MaxDeletions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OrphanedResourcesMonitorSettings) String() string {
 	if this == nil {
 		return "nil"
@@ -19745,6 +21930,7 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
 		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
 		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Cleanup:` + strings.Replace(this.Cleanup.String(), "OrphanedResourcesCleanupPolicy", "OrphanedResourcesCleanupPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19815,6 +22001,17 @@ func (this *PluginInput) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectOperationsPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectOperationsPolicy{`,
+		`MaxConcurrent:` + fmt.Sprintf("%v", this.MaxConcurrent) + `,`,
+		`PriorityClass:` + fmt.Sprintf("%v", this.PriorityClass) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectRole) String() string {
 	if this == nil {
 		return "nil"
@@ -19954,6 +22151,35 @@ func (this *PullRequestGeneratorGithub) String() string {
 	}, "")
 	return s
 }
+func (this *ReconcilePhaseTiming) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReconcilePhaseTiming{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DurationMs:` + fmt.Sprintf("%v", this.DurationMs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReconcileProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPhases := "[]ReconcilePhaseTiming{"
+	for _, f := range this.Phases {
+		repeatedStringForPhases += strings.Replace(strings.Replace(f.String(), "ReconcilePhaseTiming", "ReconcilePhaseTiming", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPhases += "}"
+	s := strings.Join([]string{`&ReconcileProfile{`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DurationMs:` + fmt.Sprintf("%v", this.DurationMs) + `,`,
+		`ComparisonLevel:` + fmt.Sprintf("%v", this.ComparisonLevel) + `,`,
+		`Phases:` + repeatedStringForPhases + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RefTarget) String() string {
 	if this == nil {
 		return "nil"
@@ -20142,6 +22368,22 @@ func (this *ResourceActions) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceCapacity{`,
+		`CPURequests:` + fmt.Sprintf("%v", this.CPURequests) + `,`,
+		`CPULimits:` + fmt.Sprintf("%v", this.CPULimits) + `,`,
+		`MemoryRequests:` + fmt.Sprintf("%v", this.MemoryRequests) + `,`,
+		`MemoryLimits:` + fmt.Sprintf("%v", this.MemoryLimits) + `,`,
+		`Storage:` + fmt.Sprintf("%v", this.Storage) + `,`,
+		`Pods:` + fmt.Sprintf("%v", this.Pods) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceDiff) String() string {
 	if this == nil {
 		return "nil"
@@ -20268,6 +22510,7 @@ func (this *ResourceNode) String() string {
 		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`Capacity:` + strings.Replace(this.Capacity.String(), "ResourceCapacity", "ResourceCapacity", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20374,6 +22617,7 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20392,6 +22636,16 @@ func (this *RevisionMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *RollbackOnDegraded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackOnDegraded{`,
+		`GracePeriod:` + fmt.Sprintf("%v", this.GracePeriod) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SCMProviderGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -20566,6 +22820,29 @@ func (this *SignatureKey) String() string {
 	}, "")
 	return s
 }
+func (this *SyncHistoryRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResources := "[]*ResourceResult{"
+	for _, f := range this.Resources {
+		repeatedStringForResources += strings.Replace(f.String(), "ResourceResult", "ResourceResult", 1) + ","
+	}
+	repeatedStringForResources += "}"
+	s := strings.Join([]string{`&SyncHistoryRecord{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`DurationMs:` + fmt.Sprintf("%v", this.DurationMs) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Resources:` + repeatedStringForResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -20593,6 +22870,7 @@ func (this *SyncOperation) String() string {
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ChangeRevisions:` + fmt.Sprintf("%v", this.ChangeRevisions) + `,`,
 		`ChangeRevision:` + fmt.Sprintf("%v", this.ChangeRevision) + `,`,
+		`IgnorePruneLimit:` + fmt.Sprintf("%v", this.IgnorePruneLimit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20631,6 +22909,10 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`CapturedLogsID:` + fmt.Sprintf("%v", this.CapturedLogsID) + `,`,
+		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
+		`SyncWaveAppliedAt:` + strings.Replace(fmt.Sprintf("%v", this.SyncWaveAppliedAt), "Time", "v1.Time", 1) + `,`,
+		`SyncWavePhase:` + fmt.Sprintf("%v", this.SyncWavePhase) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20656,6 +22938,7 @@ func (this *SyncPolicyAutomated) String() string {
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
 		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
 		`AllowEmpty:` + fmt.Sprintf("%v", this.AllowEmpty) + `,`,
+		`RollbackOnDegraded:` + strings.Replace(this.RollbackOnDegraded.String(), "RollbackOnDegraded", "RollbackOnDegraded", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21619,6 +23902,110 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermitOnlyProjectScopedClusters = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationServiceAccounts = append(m.DestinationServiceAccounts, ApplicationDestinationServiceAccount{})
+			if err := m.DestinationServiceAccounts[len(m.DestinationServiceAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operations == nil {
+				m.Operations = &ProjectOperationsPolicy{}
+			}
+			if err := m.Operations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedNamespaceTemplates = append(m.ManagedNamespaceTemplates, ManagedNamespaceTemplate{})
+			if err := m.ManagedNamespaceTemplates[len(m.ManagedNamespaceTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22154,7 +24541,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22177,77 +24564,13 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -22279,61 +24602,43 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22343,30 +24648,29 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Health = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22376,25 +24680,23 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sync = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22417,7 +24719,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22440,15 +24742,15 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22476,11 +24778,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22508,11 +24810,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22540,7 +24842,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22563,7 +24865,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22586,15 +24888,15 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22622,11 +24924,11 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22654,7 +24956,39 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22677,7 +25011,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22700,15 +25034,15 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22735,46 +25069,13 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22801,7 +25102,8 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22826,7 +25128,7 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22849,15 +25151,15 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22885,47 +25187,11 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22953,75 +25219,11 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Step = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23049,7 +25251,7 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23072,7 +25274,7 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23095,15 +25297,15 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23131,11 +25333,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23163,11 +25365,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23194,16 +25396,66 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
+			if m.Until == nil {
+				m.Until = &v1.Time{}
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23231,11 +25483,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23263,7 +25515,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23286,7 +25538,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23309,15 +25561,15 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23344,16 +25596,13 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23380,16 +25629,13 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23416,54 +25662,65 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23473,31 +25730,27 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
-			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23524,18 +25777,18 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23545,33 +25798,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Matrix == nil {
-				m.Matrix = &MatrixGenerator{}
-			}
-			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23581,33 +25830,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &MergeGenerator{}
-			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23617,33 +25862,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23653,27 +25894,23 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23696,7 +25933,7 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23719,17 +25956,17 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23739,28 +25976,59 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23787,11 +26055,77 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ApplicationSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23813,7 +26147,7 @@ func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23836,10 +26170,10 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24088,7 +26422,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Matrix == nil {
-				m.Matrix = &v11.JSON{}
+				m.Matrix = &MatrixGenerator{}
 			}
 			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24124,7 +26458,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Merge == nil {
-				m.Merge = &v11.JSON{}
+				m.Merge = &MergeGenerator{}
 			}
 			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24223,7 +26557,7 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24246,17 +26580,17 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24266,29 +26600,30 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24298,55 +26633,25 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ApplicationSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24369,7 +26674,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24392,15 +26697,15 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetNestedGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24427,14 +26732,16 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
-			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24461,66 +26768,16 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUpdate == nil {
-				m.MaxUpdate = &intstr.IntOrString{}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
 			}
-			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24547,84 +26804,16 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.GoTemplate = bool(v != 0)
-		case 2:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24651,14 +26840,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
+			}
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24685,13 +26876,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24718,16 +26912,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matrix", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24754,16 +26948,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &ApplicationSetStrategy{}
+			if m.Matrix == nil {
+				m.Matrix = &v11.JSON{}
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Matrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24790,68 +26984,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreservedFields == nil {
-				m.PreservedFields = &ApplicationPreservedFields{}
+			if m.Merge == nil {
+				m.Merge = &v11.JSON{}
 			}
-			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ApplyNestedSelectors = bool(v != 0)
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24878,16 +27020,18 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
-			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24897,24 +27041,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TemplatePatch = &s
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24937,7 +27084,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24960,17 +27107,17 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24980,31 +27127,29 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25014,31 +27159,29 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
-			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25048,25 +27191,23 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25089,7 +27230,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25112,17 +27253,17 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25132,27 +27273,29 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
+			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25179,10 +27322,10 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollingSync == nil {
-				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			if m.MaxUpdate == nil {
+				m.MaxUpdate = &intstr.IntOrString{}
 			}
-			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25207,7 +27350,7 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25230,37 +27373,17 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25270,24 +27393,25 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
-			m.ApplicationsSync = &s
+			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25310,7 +27434,7 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25333,15 +27457,35 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoTemplate = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25368,13 +27512,14 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25401,65 +27546,15 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25469,27 +27564,793 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &ApplicationSetStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreservedFields == nil {
+				m.PreservedFields = &ApplicationPreservedFields{}
+			}
+			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyNestedSelectors = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			}
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveResourcesOnDeletion = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27676,7 +30537,439 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
 			}
-			var v int
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonLabels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonAnnotations = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceNamespace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceNamespace = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommonAnnotationsEnvsubst = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelWithoutSelector = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27686,17 +30979,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonLabels = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27706,17 +31011,33 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonAnnotations = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceNamespace", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalMap == nil {
+				m.OptionalMap = &OptionalMap{}
+			}
+			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27726,15 +31047,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceNamespace = bool(v != 0)
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalArray == nil {
+				m.OptionalArray = &OptionalArray{}
+			}
+			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27762,13 +31099,64 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.String_ = &s
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27778,15 +31166,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CommonAnnotationsEnvsubst = bool(v != 0)
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27813,14 +31217,45 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, KustomizeReplica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27847,16 +31282,18 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patches = append(m.Patches, KustomizePatch{})
-			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27866,29 +31303,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27898,67 +31337,51 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LabelWithoutSelector = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Info = append(m.Info, Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RevisionHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27968,27 +31391,29 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28015,14 +31440,14 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, &EnvEntry{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28049,8 +31474,10 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pause == nil {
+				m.Pause = &ApplicationPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28075,7 +31502,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28098,17 +31525,17 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28118,27 +31545,29 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28165,16 +31594,46 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalMap == nil {
-				m.OptionalMap = &OptionalMap{}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28201,18 +31660,16 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalArray == nil {
-				m.OptionalArray = &OptionalArray{}
-			}
-			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, RevisionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28222,78 +31679,65 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.String_ = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &v1.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28320,16 +31764,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28356,13 +31800,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObservedAt == nil {
+				m.ObservedAt = &v1.Time{}
+			}
+			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28390,11 +31837,11 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28421,18 +31868,15 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
-			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28442,31 +31886,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28476,51 +31918,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevisionHistoryLimit = &v
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28530,25 +31950,23 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28571,7 +31989,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28594,17 +32012,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28614,31 +32032,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28648,28 +32064,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildApplications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28696,13 +32111,16 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChildApplications == nil {
+				m.ChildApplications = &ChildApplicationsSummary{}
+			}
+			if err := m.ChildApplications.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28729,14 +32147,66 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, RevisionHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Capacity == nil {
+				m.Capacity = &ResourceCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28763,14 +32233,14 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28797,16 +32267,14 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReconciledAt == nil {
-				m.ReconciledAt = &v1.Time{}
-			}
-			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28833,16 +32301,96 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
+			m.Hosts = append(m.Hosts, HostInfo{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28869,16 +32417,63 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObservedAt == nil {
-				m.ObservedAt = &v1.Time{}
-			}
-			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28906,13 +32501,33 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28922,28 +32537,77 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28971,13 +32635,13 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28987,29 +32651,83 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29019,23 +32737,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29058,7 +32780,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+func (m *CapturedContainerLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29081,15 +32803,15 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapturedContainerLogs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapturedContainerLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29117,11 +32839,11 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29149,63 +32871,13 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29215,31 +32887,29 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29249,31 +32919,29 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
-			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29283,79 +32951,59 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hosts = append(m.Hosts, HostInfo{})
-			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29383,11 +33031,11 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29414,7 +33062,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CapturedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29439,7 +33087,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29462,15 +33110,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29498,13 +33146,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29514,15 +33162,27 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Factor = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Home = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29550,7 +33210,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29573,7 +33233,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *ChildApplicationsSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29596,17 +33256,36 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildApplicationsSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildApplicationsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29616,27 +33295,108 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.SyncStatuses == nil {
+				m.SyncStatuses = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SyncStatuses[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29663,66 +33423,93 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
+			if m.HealthStatuses == nil {
+				m.HealthStatuses = make(map[string]int64)
 			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.HealthStatuses[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29749,10 +33536,8 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failing = append(m.Failing, FailingChildApplication{})
+			if err := m.Failing[len(m.Failing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29777,152 +33562,6 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Home = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *Cluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30412,11 +34051,246 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoNamespaces", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoNamespaces = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			}
+			m.ResourcesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			}
+			m.APIsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.APIsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30443,103 +34317,12 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30562,7 +34345,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+func (m *ClusterCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30585,17 +34368,17 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.ResourcesCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30605,16 +34388,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-			m.APIsCount = 0
+			m.ConsecutiveFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30624,14 +34420,14 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.APIsCount |= int64(b&0x7F) << shift
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30658,13 +34454,45 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCacheSyncTime == nil {
-				m.LastCacheSyncTime = &v1.Time{}
+			if m.OpenedAt == nil {
+				m.OpenedAt = &v1.Time{}
 			}
-			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31410,6 +35238,42 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &ClusterCircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32895,13 +36759,350 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailingChildApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailingChildApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailingChildApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncStatus = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32911,124 +37112,29 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Env[mapkey] = mapvalue
+			m.HealthStatus = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33038,27 +37144,29 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33086,7 +37194,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			m.OperationMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34139,6 +38247,42 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36865,6 +41009,120 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ManagedNamespaceTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedNamespaceTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedNamespaceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38295,6 +42553,429 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OrphanedResourceSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourceSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourceSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourcesCleanupPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesCleanupPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesCleanupPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allow = append(m.Allow, OrphanedResourceSelector{})
+			if err := m.Allow[len(m.Allow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ignore = append(m.Ignore, OrphanedResourceSelector{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletions", wireType)
+			}
+			m.MaxDeletions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeletions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38379,6 +43060,42 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cleanup == nil {
+				m.Cleanup = &OrphanedResourcesCleanupPolicy{}
+			}
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39103,6 +43820,107 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectOperationsPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectOperationsPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectOperationsPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrent", wireType)
+			}
+			m.MaxConcurrent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClass = OperationPriorityClass(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39854,18 +44672,232 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39875,23 +44907,27 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucketCloud{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39914,7 +44950,7 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39937,15 +44973,15 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39973,7 +45009,7 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -40075,11 +45111,61 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40089,27 +45175,57 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucketCloud{}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
 			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranchMatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40132,7 +45248,7 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40155,10 +45271,10 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40195,7 +45311,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40223,13 +45339,13 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40239,29 +45355,33 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40271,81 +45391,27 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40373,14 +45439,13 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.PullRequestState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40390,25 +45455,12 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TargetBranchMatch = &s
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40430,7 +45482,7 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40453,15 +45505,15 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40489,11 +45541,11 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40521,47 +45573,11 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40589,13 +45605,13 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40605,25 +45621,29 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullRequestState = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
@@ -40664,7 +45684,7 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40687,10 +45707,10 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40826,10 +45846,10 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40839,12 +45859,56 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40866,7 +45930,7 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *ReconcilePhaseTiming) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40889,15 +45953,15 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcilePhaseTiming: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcilePhaseTiming: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40925,13 +45989,13 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
 			}
-			var stringLen uint64
+			m.DurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40941,29 +46005,66 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40973,29 +46074,30 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
 			}
-			var msglen int
+			m.DurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41005,31 +46107,14 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparisonLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41057,13 +46142,13 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.ComparisonLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41073,23 +46158,25 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Phases = append(m.Phases, ReconcilePhaseTiming{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43104,11 +48191,303 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconClass = string(dAtA[iNdEx:postIndex])
+			m.IconClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43136,7 +48515,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Default = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43159,7 +48538,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43182,15 +48561,15 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43218,13 +48597,13 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43234,23 +48613,25 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43273,7 +48654,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+func (m *ResourceCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43296,15 +48677,15 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPURequests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43332,11 +48713,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CPURequests = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPULimits", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43364,11 +48745,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.CPULimits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryRequests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43396,11 +48777,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.MemoryRequests = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimits", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43428,61 +48809,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			m.MemoryLimits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43510,13 +48841,13 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			m.Storage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
-			var msglen int
+			m.Pods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43526,26 +48857,30 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Pods |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45211,6 +50546,42 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capacity == nil {
+				m.Capacity = &ResourceCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46801,6 +52172,26 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47033,6 +52424,88 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollbackOnDegraded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackOnDegraded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackOnDegraded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49355,6 +54828,326 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
+			}
+			m.DurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49690,11 +55483,209 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeRevisions = append(m.ChangeRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnorePruneLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnorePruneLimit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49722,11 +55713,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeRevisions = append(m.ChangeRevisions, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49754,7 +55745,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeRevision = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49777,7 +55768,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49800,17 +55791,17 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49820,27 +55811,29 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49868,13 +55861,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49884,29 +55877,30 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49916,77 +55910,61 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50013,14 +55991,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedLogsID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50048,13 +56028,13 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.CapturedLogsID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
 			}
-			var msglen int
+			m.SyncWave = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50064,28 +56044,14 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SyncWave |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWaveAppliedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50112,14 +56078,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncWaveAppliedAt == nil {
+				m.SyncWaveAppliedAt = &v1.Time{}
+			}
+			if err := m.SyncWaveAppliedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWavePhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50147,43 +56115,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncWavePhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50485,6 +56417,42 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowEmpty = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackOnDegraded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackOnDegraded == nil {
+				m.RollbackOnDegraded = &RollbackOnDegraded{}
+			}
+			if err := m.RollbackOnDegraded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
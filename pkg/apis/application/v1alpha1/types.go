@@ -24,8 +24,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -39,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v2/util/http"
 	"github.com/argoproj/argo-cd/v2/util/security"
@@ -85,6 +88,78 @@ type ApplicationSpec struct {
 
 	// Sources is a reference to the location of the application's manifests or chart
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+
+	// DependsOn is a list of applications which must reach the required state before this application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,9,rep,name=dependsOn"`
+	// Pause pauses the reconciliation of the application until it is resumed or the pause expires
+	Pause *ApplicationPause `json:"pause,omitempty" protobuf:"bytes,10,opt,name=pause"`
+}
+
+// ApplicationPause describes why, by whom and until when the reconciliation of an application is paused
+type ApplicationPause struct {
+	// Reason is a human-readable explanation of why the application is paused
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// PausedBy is the user who paused the application
+	PausedBy string `json:"pausedBy,omitempty" protobuf:"bytes,2,opt,name=pausedBy"`
+	// Until is the time at which the reconciliation resumes automatically. The pause does not expire if unset.
+	Until *metav1.Time `json:"until,omitempty" protobuf:"bytes,3,opt,name=until"`
+}
+
+// IsActive returns whether the reconciliation is paused at the given time
+func (p *ApplicationPause) IsActive(now time.Time) bool {
+	return p != nil && (p.Until == nil || now.Before(p.Until.Time))
+}
+
+// Message returns a human-readable description of the pause
+func (p *ApplicationPause) Message() string {
+	message := "Reconciliation paused"
+	if p.PausedBy != "" {
+		message = fmt.Sprintf("%s by %s", message, p.PausedBy)
+	}
+	if p.Until != nil {
+		message = fmt.Sprintf("%s until %s", message, p.Until.UTC().Format(time.RFC3339))
+	}
+	if p.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, p.Reason)
+	}
+	return message
+}
+
+// ApplicationDependency is a reference to an application which must reach the required health and sync status
+// before the dependent application is synced. If neither health nor sync is set, the application must be healthy.
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application. Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Health is the health status the application must report
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,3,opt,name=health"`
+	// Sync is the sync status the application must report
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,4,opt,name=sync,casttype=SyncStatusCode"`
+}
+
+// Key returns the namespace/name key of the application the given application depends on
+func (d ApplicationDependency) Key(app *Application) string {
+	namespace := d.Namespace
+	if namespace == "" {
+		namespace = app.Namespace
+	}
+	return namespace + "/" + d.Name
+}
+
+// IsSatisfiedBy returns whether the given application reports the required health and sync status
+func (d ApplicationDependency) IsSatisfiedBy(app *Application) bool {
+	requiredHealth := d.Health
+	if requiredHealth == "" && d.Sync == "" {
+		requiredHealth = health.HealthStatusHealthy
+	}
+	if requiredHealth != "" && app.Status.Health.Status != requiredHealth {
+		return false
+	}
+	if d.Sync != "" && app.Status.Sync.Status != d.Sync {
+		return false
+	}
+	return true
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -1116,6 +1191,8 @@ type SyncOperation struct {
 	Revisions       []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	ChangeRevisions []string `json:"changeRevisions,omitempty" protobuf:"bytes,12,opt,name=changeRevisions"`
 	ChangeRevision  string   `json:"changeRevision,omitempty" protobuf:"bytes,13,opt,name=changeRevision"`
+	// IgnorePruneLimit allows the sync to prune more resources than permitted by the PruneLimit sync option
+	IgnorePruneLimit bool `json:"ignorePruneLimit,omitempty" protobuf:"bytes,14,opt,name=ignorePruneLimit"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1273,6 +1350,29 @@ type SyncPolicyAutomated struct {
 	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 	// AllowEmpty allows apps have zero live resources (default: false)
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
+	// RollbackOnDegraded rolls the application back to the last healthy revision when it stays degraded after an automated sync
+	RollbackOnDegraded *RollbackOnDegraded `json:"rollbackOnDegraded,omitempty" protobuf:"bytes,4,opt,name=rollbackOnDegraded"`
+}
+
+// DefaultRollbackOnDegradedGracePeriod is the time an application may stay degraded after an automated sync before it is rolled back
+const DefaultRollbackOnDegradedGracePeriod = 5 * time.Minute
+
+// RollbackOnDegraded controls the automated rollback of applications which become degraded after an automated sync
+type RollbackOnDegraded struct {
+	// GracePeriod is the amount of time the application may stay degraded after an automated sync before it is rolled back (e.g. 10m, default: 5m)
+	GracePeriod string `json:"gracePeriod,omitempty" protobuf:"bytes,1,opt,name=gracePeriod"`
+}
+
+// GetGracePeriod returns the grace period of the rollback policy, or the default one if it is unset or invalid
+func (r *RollbackOnDegraded) GetGracePeriod() time.Duration {
+	if r == nil || r.GracePeriod == "" {
+		return DefaultRollbackOnDegradedGracePeriod
+	}
+	gracePeriod, err := parseStringToDuration(r.GracePeriod)
+	if err != nil {
+		return DefaultRollbackOnDegradedGracePeriod
+	}
+	return gracePeriod
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -1352,6 +1452,77 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// CapturedLogsID identifies the tail of the container logs captured from the pods which failed during the sync.
+	// The logs are kept in the cache for a limited time.
+	CapturedLogsID string `json:"capturedLogsID,omitempty" protobuf:"bytes,7,opt,name=capturedLogsID"`
+	// SyncWave is the last sync wave applied by the operation, which is waited for before applying the next wave
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"varint,8,opt,name=syncWave"`
+	// SyncWaveAppliedAt is the time at which SyncWave was applied. It is unset once the wave is no longer waited for.
+	SyncWaveAppliedAt *metav1.Time `json:"syncWaveAppliedAt,omitempty" protobuf:"bytes,9,opt,name=syncWaveAppliedAt"`
+	// SyncWavePhase is the sync phase of SyncWave
+	SyncWavePhase synccommon.SyncPhase `json:"syncWavePhase,omitempty" protobuf:"bytes,10,opt,name=syncWavePhase"`
+}
+
+// CapturedContainerLogs holds the tail of the logs of a container of a pod which failed during a sync operation
+type CapturedContainerLogs struct {
+	// Group specifies the API group of the resource which failed, e.g. a hook Job
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind specifies the API kind of the resource which failed
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the namespace of the resource which failed
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Name specifies the name of the resource which failed
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// PodName is the name of the pod the logs were captured from
+	PodName string `json:"podName" protobuf:"bytes,5,opt,name=podName"`
+	// Container is the name of the container the logs were captured from
+	Container string `json:"container" protobuf:"bytes,6,opt,name=container"`
+	// Logs contains the tail of the container logs
+	Logs string `json:"logs,omitempty" protobuf:"bytes,7,opt,name=logs"`
+	// CapturedAt is the time the logs were captured
+	CapturedAt metav1.Time `json:"capturedAt,omitempty" protobuf:"bytes,8,opt,name=capturedAt"`
+}
+
+// ReconcileProfile holds the time spent in each phase of a reconciliation of an application
+type ReconcileProfile struct {
+	// StartedAt is the time the reconciliation started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,1,opt,name=startedAt"`
+	// DurationMs is the total duration of the reconciliation in milliseconds
+	DurationMs int64 `json:"durationMs" protobuf:"bytes,2,opt,name=durationMs"`
+	// ComparisonLevel describes what the application was compared with, e.g. the latest or the most recent revision
+	ComparisonLevel string `json:"comparisonLevel,omitempty" protobuf:"bytes,3,opt,name=comparisonLevel"`
+	// Phases holds the duration of the phases of the reconciliation, in the order they ran
+	Phases []ReconcilePhaseTiming `json:"phases,omitempty" protobuf:"bytes,4,rep,name=phases"`
+}
+
+// ReconcilePhaseTiming holds the time spent in a phase of a reconciliation
+type ReconcilePhaseTiming struct {
+	// Name is the name of the phase, e.g. git, diff or health
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// DurationMs is the duration of the phase in milliseconds
+	DurationMs int64 `json:"durationMs" protobuf:"bytes,2,opt,name=durationMs"`
+}
+
+// SyncHistoryRecord holds the result of a sync operation of an application, kept in the extended sync history
+type SyncHistoryRecord struct {
+	// ID is an auto incrementing identifier of the record
+	ID int64 `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Revisions holds the revisions the application was synced to, one per source
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,rep,name=revisions"`
+	// InitiatedBy contains information about who initiated the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,3,opt,name=initiatedBy"`
+	// StartedAt is the time the operation started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,4,opt,name=startedAt"`
+	// FinishedAt is the time the operation completed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,5,opt,name=finishedAt"`
+	// DurationMs is the duration of the operation in milliseconds
+	DurationMs int64 `json:"durationMs" protobuf:"bytes,6,opt,name=durationMs"`
+	// Phase is the phase the operation completed with
+	Phase synccommon.OperationPhase `json:"phase" protobuf:"bytes,7,opt,name=phase"`
+	// Message holds the message the operation completed with
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+	// Resources holds the result of the operation for each resource
+	Resources ResourceResults `json:"resources,omitempty" protobuf:"bytes,9,opt,name=resources"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1429,6 +1600,8 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// Healthy is set once the application has been observed healthy and synced to the revision of this entry
+	Healthy bool `json:"healthy,omitempty" protobuf:"bytes,11,opt,name=healthy"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1496,6 +1669,14 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyWarning indicates that the application is waiting for its dependencies to reach the required state
+	ApplicationConditionDependencyWarning = "DependencyWarning"
+	// ApplicationConditionRollbackWarning indicates that the application has been rolled back after becoming degraded and that auto-sync is paused
+	ApplicationConditionRollbackWarning = "RollbackWarning"
+	// ApplicationConditionReconcilePausedWarning indicates that the reconciliation of the application is paused
+	ApplicationConditionReconcilePausedWarning = "ReconcilePausedWarning"
+	// ApplicationConditionClusterUnreachable indicates that the destination cluster of the application is unreachable and that the controller stopped reconciling and syncing the application
+	ApplicationConditionClusterUnreachable = "ClusterUnreachable"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1539,6 +1720,8 @@ type HealthStatus struct {
 	Status health.HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	// Message is a human-readable informational message describing the health status
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the health status of the application last changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 // InfoItem contains arbitrary, human readable information about an application
@@ -1608,6 +1791,87 @@ type ApplicationSummary struct {
 	ExternalURLs []string `json:"externalURLs,omitempty" protobuf:"bytes,1,opt,name=externalURLs"`
 	// Images holds all images of application child resources.
 	Images []string `json:"images,omitempty" protobuf:"bytes,2,opt,name=images"`
+	// ChildApplications holds the aggregated status of the child applications of an app-of-apps. Only set if the
+	// aggregation is enabled with the argocd.argoproj.io/aggregate-child-status annotation.
+	ChildApplications *ChildApplicationsSummary `json:"childApplications,omitempty" protobuf:"bytes,3,opt,name=childApplications"`
+	// Capacity holds the totals of the compute resources, replicas and storage of the application child resources.
+	Capacity *ResourceCapacity `json:"capacity,omitempty" protobuf:"bytes,4,opt,name=capacity"`
+}
+
+// ResourceCapacity holds the compute resources, replicas and storage reserved by Kubernetes resources. The quantities
+// are formatted as Kubernetes quantities, e.g. 500m or 1Gi.
+type ResourceCapacity struct {
+	// CPURequests is the total of the CPU requests of the pods which are not completed
+	CPURequests string `json:"cpuRequests,omitempty" protobuf:"bytes,1,opt,name=cpuRequests"`
+	// CPULimits is the total of the CPU limits of the pods which are not completed
+	CPULimits string `json:"cpuLimits,omitempty" protobuf:"bytes,2,opt,name=cpuLimits"`
+	// MemoryRequests is the total of the memory requests of the pods which are not completed
+	MemoryRequests string `json:"memoryRequests,omitempty" protobuf:"bytes,3,opt,name=memoryRequests"`
+	// MemoryLimits is the total of the memory limits of the pods which are not completed
+	MemoryLimits string `json:"memoryLimits,omitempty" protobuf:"bytes,4,opt,name=memoryLimits"`
+	// Storage is the total of the storage requested by the persistent volume claims
+	Storage string `json:"storage,omitempty" protobuf:"bytes,5,opt,name=storage"`
+	// Pods is the number of pods which are not completed, i.e. pending or running
+	Pods int64 `json:"pods,omitempty" protobuf:"bytes,6,opt,name=pods"`
+	// Replicas is the total of the desired replicas of the workloads
+	Replicas int64 `json:"replicas,omitempty" protobuf:"bytes,7,opt,name=replicas"`
+}
+
+// addQuantity returns the sum of two quantities, ignoring the quantities which cannot be parsed
+func addQuantity(a string, b string) string {
+	var sum resource.Quantity
+	for _, value := range []string{a, b} {
+		if value == "" {
+			continue
+		}
+		if quantity, err := resource.ParseQuantity(value); err == nil {
+			sum.Add(quantity)
+		}
+	}
+	if sum.IsZero() {
+		return ""
+	}
+	return sum.String()
+}
+
+// Add adds the capacity of another resource to the capacity
+func (c *ResourceCapacity) Add(other *ResourceCapacity) {
+	if other == nil {
+		return
+	}
+	c.CPURequests = addQuantity(c.CPURequests, other.CPURequests)
+	c.CPULimits = addQuantity(c.CPULimits, other.CPULimits)
+	c.MemoryRequests = addQuantity(c.MemoryRequests, other.MemoryRequests)
+	c.MemoryLimits = addQuantity(c.MemoryLimits, other.MemoryLimits)
+	c.Storage = addQuantity(c.Storage, other.Storage)
+	c.Pods += other.Pods
+	c.Replicas += other.Replicas
+}
+
+// ChildApplicationsSummary holds the aggregated status of the child applications managed by an app-of-apps
+type ChildApplicationsSummary struct {
+	// Total is the number of child applications
+	Total int64 `json:"total" protobuf:"bytes,1,opt,name=total"`
+	// SyncStatuses holds the number of child applications by sync status
+	SyncStatuses map[string]int64 `json:"syncStatuses,omitempty" protobuf:"bytes,2,rep,name=syncStatuses"`
+	// HealthStatuses holds the number of child applications by health status
+	HealthStatuses map[string]int64 `json:"healthStatuses,omitempty" protobuf:"bytes,3,rep,name=healthStatuses"`
+	// Failing holds the child applications which are degraded, have an error condition or whose last operation failed
+	Failing []FailingChildApplication `json:"failing,omitempty" protobuf:"bytes,4,rep,name=failing"`
+}
+
+// FailingChildApplication holds the status of a failing child application of an app-of-apps
+type FailingChildApplication struct {
+	Name      string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// SyncStatus is the sync status of the child application
+	SyncStatus SyncStatusCode `json:"syncStatus,omitempty" protobuf:"bytes,3,opt,name=syncStatus,casttype=SyncStatusCode"`
+	// HealthStatus is the health status of the child application
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,4,opt,name=healthStatus"`
+	// Conditions holds the conditions of the child application
+	Conditions []ApplicationCondition `json:"conditions,omitempty" protobuf:"bytes,5,rep,name=conditions"`
+	// OperationMessage is the message of the last operation of the child application, if it failed
+	OperationMessage string `json:"operationMessage,omitempty" protobuf:"bytes,6,opt,name=operationMessage"`
 }
 
 // TODO: Document purpose of this method
@@ -1624,7 +1888,14 @@ func (t *ApplicationTree) FindNode(group string, kind string, namespace string,
 func (t *ApplicationTree) GetSummary(app *Application) ApplicationSummary {
 	urlsSet := make(map[string]bool)
 	imagesSet := make(map[string]bool)
+	var capacity *ResourceCapacity
 	for _, node := range t.Nodes {
+		if node.Capacity != nil {
+			if capacity == nil {
+				capacity = &ResourceCapacity{}
+			}
+			capacity.Add(node.Capacity)
+		}
 		if node.NetworkingInfo != nil {
 			for _, url := range node.NetworkingInfo.ExternalURLs {
 				urlsSet[url] = true
@@ -1654,7 +1925,7 @@ func (t *ApplicationTree) GetSummary(app *Application) ApplicationSummary {
 	sort.Slice(images, func(i, j int) bool {
 		return images[i] < images[j]
 	})
-	return ApplicationSummary{ExternalURLs: urls, Images: images}
+	return ApplicationSummary{ExternalURLs: urls, Images: images, Capacity: capacity}
 }
 
 // ResourceRef includes fields which uniquely identify a resource
@@ -1691,6 +1962,8 @@ type ResourceNode struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,9,opt,name=labels"`
 	// available for managed resource without k8s-last-applied-configuration
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,10,opt,name=annotations"`
+	// available for pods, persistent volume claims and workloads
+	Capacity *ResourceCapacity `json:"capacity,omitempty" protobuf:"bytes,11,opt,name=capacity"`
 }
 
 // FullName returns a resource node's full name in the format "group/kind/namespace/name"
@@ -1847,6 +2120,9 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// AutoNamespaces indicates that the controller only watches the namespaces the applications deployed to the cluster
+	// use, and the kinds of cluster level resources the applications manage. It cannot be combined with Namespaces.
+	AutoNamespaces bool `json:"autoNamespaces,omitempty" protobuf:"bytes,14,opt,name=autoNamespaces"`
 }
 
 // Equals returns true if two cluster objects are considered to be equal
@@ -1876,6 +2152,10 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if c.AutoNamespaces != other.AutoNamespaces {
+		return false
+	}
+
 	if !collections.StringMapsEqual(c.Annotations, other.Annotations) {
 		return false
 	}
@@ -1899,6 +2179,8 @@ type ClusterInfo struct {
 	ApplicationsCount int64 `json:"applicationsCount" protobuf:"bytes,4,opt,name=applicationsCount"`
 	// APIVersions contains list of API versions supported by the cluster
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,5,opt,name=apiVersions"`
+	// CircuitBreaker contains information about the circuit breaker which stops the controller from reaching the cluster while it is unreachable
+	CircuitBreaker *ClusterCircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,6,opt,name=circuitBreaker"`
 }
 
 func (c *ClusterInfo) GetKubeVersion() string {
@@ -1919,6 +2201,30 @@ type ClusterCacheInfo struct {
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
 }
 
+// ClusterCircuitBreakerState represents the state of the circuit breaker of a cluster
+type ClusterCircuitBreakerState = string
+
+const (
+	// ClusterCircuitBreakerStateClosed indicates that the cluster is reachable
+	ClusterCircuitBreakerStateClosed ClusterCircuitBreakerState = "Closed"
+	// ClusterCircuitBreakerStateOpen indicates that the cluster is unreachable and that the controller stopped reaching it
+	ClusterCircuitBreakerStateOpen ClusterCircuitBreakerState = "Open"
+	// ClusterCircuitBreakerStateHalfOpen indicates that the controller is probing whether the cluster is reachable again
+	ClusterCircuitBreakerStateHalfOpen ClusterCircuitBreakerState = "HalfOpen"
+)
+
+// ClusterCircuitBreaker contains information about the circuit breaker of a cluster
+type ClusterCircuitBreaker struct {
+	// State is the state of the circuit breaker: Closed, Open or HalfOpen
+	State ClusterCircuitBreakerState `json:"state" protobuf:"bytes,1,opt,name=state"`
+	// ConsecutiveFailures is the number of consecutive attempts to reach the cluster which failed
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"bytes,2,opt,name=consecutiveFailures"`
+	// OpenedAt is the time the circuit breaker opened
+	OpenedAt *metav1.Time `json:"openedAt,omitempty" protobuf:"bytes,3,opt,name=openedAt"`
+	// Message is the error returned by the last failed attempt to reach the cluster
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
 // ClusterList is a collection of Clusters.
 type ClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -2244,6 +2550,40 @@ type OrphanedResourcesMonitorSettings struct {
 	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,name=warn"`
 	// Ignore contains a list of resources that are to be excluded from orphaned resources monitoring
 	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,opt,name=ignore"`
+	// Cleanup holds the policy to delete the orphaned resources of the applications of the project
+	Cleanup *OrphanedResourcesCleanupPolicy `json:"cleanup,omitempty" protobuf:"bytes,3,opt,name=cleanup"`
+}
+
+// OrphanedResourcesCleanupPolicy holds the policy to delete the orphaned resources found in the destination namespaces
+// of the applications of a project. The resources excluded from the orphaned resources monitoring are never deleted.
+type OrphanedResourcesCleanupPolicy struct {
+	// Enabled indicates if the controller deletes the orphaned resources matching the policy
+	Enabled bool `json:"enabled,omitempty" protobuf:"bytes,1,opt,name=enabled"`
+	// DryRun indicates if the orphaned resources matching the policy are only reported as Kubernetes events instead of
+	// being deleted
+	DryRun bool `json:"dryRun,omitempty" protobuf:"bytes,2,opt,name=dryRun"`
+	// Allow restricts the cleanup to the orphaned resources matching one of the selectors. Nothing is cleaned up if
+	// empty. Persistent volume claims, resources with owner references and resources with a kubernetes.io managed-by
+	// label are only cleaned up if a selector explicitly lists their kind.
+	Allow []OrphanedResourceSelector `json:"allow,omitempty" protobuf:"bytes,3,rep,name=allow"`
+	// Ignore excludes the orphaned resources matching one of the selectors from the cleanup
+	Ignore []OrphanedResourceSelector `json:"ignore,omitempty" protobuf:"bytes,4,rep,name=ignore"`
+	// MinAge is the minimum age of an orphaned resource before it is cleaned up, e.g. 24h. Defaults to 1h.
+	MinAge string `json:"minAge,omitempty" protobuf:"bytes,5,opt,name=minAge"`
+	// MaxDeletions is the maximum number of orphaned resources of an application cleaned up per cleanup interval.
+	// Defaults to 10.
+	MaxDeletions int64 `json:"maxDeletions,omitempty" protobuf:"bytes,6,opt,name=maxDeletions"`
+}
+
+// OrphanedResourceSelector selects orphaned resources by group, version, kind, name and labels. The group, version,
+// kind and name support glob patterns, and match any value if empty.
+type OrphanedResourceSelector struct {
+	Group   string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind    string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Name    string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// LabelSelector selects the resources by labels
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,5,opt,name=labelSelector"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -2258,6 +2598,48 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 	return s.Warn != nil && *s.Warn
 }
 
+// IsCleanupEnabled returns true if the orphaned resources matching the cleanup policy are deleted or reported
+func (s *OrphanedResourcesMonitorSettings) IsCleanupEnabled() bool {
+	return s.Cleanup != nil && s.Cleanup.Enabled
+}
+
+// GetMinAge returns the minimum age of an orphaned resource before it is cleaned up
+func (p *OrphanedResourcesCleanupPolicy) GetMinAge() (time.Duration, error) {
+	if p.MinAge == "" {
+		return time.Hour, nil
+	}
+	minAge, err := time.ParseDuration(p.MinAge)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse minimum age '%s': %w", p.MinAge, err)
+	}
+	return minAge, nil
+}
+
+// GetMaxDeletions returns the maximum number of orphaned resources of an application cleaned up per cleanup interval
+func (p *OrphanedResourcesCleanupPolicy) GetMaxDeletions() int64 {
+	if p.MaxDeletions <= 0 {
+		return 10
+	}
+	return p.MaxDeletions
+}
+
+// Matches returns true if the resource with the given group, version, kind, name and labels matches the selector
+func (s *OrphanedResourceSelector) Matches(gvk schema.GroupVersionKind, name string, resourceLabels map[string]string) (bool, error) {
+	for _, item := range []struct{ pattern, value string }{{s.Group, gvk.Group}, {s.Version, gvk.Version}, {s.Kind, gvk.Kind}, {s.Name, name}} {
+		if item.pattern != "" && !glob.Match(item.pattern, item.value) {
+			return false, nil
+		}
+	}
+	if s.LabelSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid label selector: %w", err)
+	}
+	return selector.Matches(labels.Set(resourceLabels)), nil
+}
+
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
 	// The ID of the key in hexadecimal notation
@@ -2292,6 +2674,70 @@ type AppProjectSpec struct {
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
 	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
+	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// Operations controls how the operations of the applications of the project are scheduled by the controller
+	Operations *ProjectOperationsPolicy `json:"operations,omitempty" protobuf:"bytes,15,opt,name=operations"`
+	// ManagedNamespaceTemplates are the resources created in the destination namespace of the applications of the
+	// project which create it with the CreateNamespace=true sync option
+	ManagedNamespaceTemplates []ManagedNamespaceTemplate `json:"managedNamespaceTemplates,omitempty" protobuf:"bytes,16,rep,name=managedNamespaceTemplates"`
+}
+
+// OperationPriorityClass is the priority of the operations of the applications of a project
+type OperationPriorityClass string
+
+const (
+	OperationPriorityClassHigh   OperationPriorityClass = "high"
+	OperationPriorityClassNormal OperationPriorityClass = "normal"
+	OperationPriorityClassLow    OperationPriorityClass = "low"
+)
+
+// ProjectOperationsPolicy controls how the operations of the applications of a project are scheduled by the controller
+type ProjectOperationsPolicy struct {
+	// MaxConcurrent is the maximum number of operations of the applications of the project processed concurrently by
+	// the controller. Unlimited if 0.
+	MaxConcurrent int64 `json:"maxConcurrent,omitempty" protobuf:"bytes,1,opt,name=maxConcurrent"`
+	// PriorityClass is the priority of the operations of the applications of the project: high, normal or low.
+	// Defaults to normal.
+	PriorityClass OperationPriorityClass `json:"priorityClass,omitempty" protobuf:"bytes,2,opt,name=priorityClass,casttype=OperationPriorityClass"`
+}
+
+// GetMaxConcurrent returns the maximum number of operations processed concurrently, or 0 if unlimited
+func (p *ProjectOperationsPolicy) GetMaxConcurrent() int64 {
+	if p == nil || p.MaxConcurrent < 0 {
+		return 0
+	}
+	return p.MaxConcurrent
+}
+
+// GetPriorityClass returns the priority of the operations
+func (p *ProjectOperationsPolicy) GetPriorityClass() OperationPriorityClass {
+	if p == nil || p.PriorityClass == "" {
+		return OperationPriorityClassNormal
+	}
+	return p.PriorityClass
+}
+
+// ManagedNamespaceTemplate is a resource, such as a ResourceQuota, a LimitRange or a NetworkPolicy, created in the
+// namespaces created by the applications of a project
+type ManagedNamespaceTemplate struct {
+	// Name identifies the template within the project
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Manifest is the YAML or JSON manifest of the namespaced resource. It can reference the $ARGOCD_APP_NAME,
+	// $ARGOCD_APP_NAMESPACE, $ARGOCD_APP_PROJECT_NAME, $ARGOCD_APP_DESTINATION_SERVER and
+	// $ARGOCD_APP_DESTINATION_NAME variables.
+	Manifest string `json:"manifest" protobuf:"bytes,2,opt,name=manifest"`
+}
+
+// ApplicationDestinationServiceAccount holds information about the service account to be impersonated for the application sync operation.
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster's Kubernetes control plane API, glob patterns are supported.
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace specifies the target namespace for the application's resources, glob patterns are supported.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount to be used for impersonation during the sync operation. It is looked up in the destination
+	// namespace of the application, unless it is prefixed with its namespace, e.g. my-namespace:my-service-account.
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2738,6 +3184,35 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// IsChildStatusAggregationEnabled returns true if the status of the child applications of the app-of-apps is
+// aggregated into its summary
+func (app *Application) IsChildStatusAggregationEnabled() bool {
+	enabled, err := strconv.ParseBool(app.GetAnnotations()[AnnotationKeyAggregateChildStatus])
+	return err == nil && enabled
+}
+
+// GetChildOutOfSyncPropagation returns which OutOfSync child applications make the app-of-apps OutOfSync
+func (app *Application) GetChildOutOfSyncPropagation() ChildOutOfSyncPropagation {
+	switch propagation := ChildOutOfSyncPropagation(app.GetAnnotations()[AnnotationKeyChildOutOfSyncPropagation]); propagation {
+	case ChildOutOfSyncPropagationAll, ChildOutOfSyncPropagationManual:
+		return propagation
+	default:
+		return ChildOutOfSyncPropagationNone
+	}
+}
+
+func (app *Application) HasPreDeleteFinalizer(stage ...string) bool {
+	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/")) > -1
+}
+
+func (app *Application) SetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), true)
+}
+
+func (app *Application) UnSetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), false)
+}
+
 func (app *Application) HasPostDeleteFinalizer(stage ...string) bool {
 	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/")) > -1
 }
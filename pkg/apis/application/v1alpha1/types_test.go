@@ -587,6 +587,56 @@ func TestAppProject_ValidateSources(t *testing.T) {
 }
 
 // TestAppProject_ValidateDestinations tests for an invalid destination
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "guestbook-*", DefaultServiceAccount: "deployer"}}
+	require.NoError(t, p.ValidateProject())
+
+	for _, invalid := range []ApplicationDestinationServiceAccount{
+		{Server: "!*", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "!guestbook", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: " "},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "my deployer"},
+	} {
+		p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{invalid}
+		require.Error(t, p.ValidateProject(), invalid)
+	}
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "other"},
+	}
+	assert.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = destinationServiceAccount '*/*' already added")
+}
+
+func TestAppProject_GetServiceAccountToImpersonate(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "guestbook-deployer"},
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "argocd:team-deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"},
+	}
+
+	serviceAccount, err := p.GetServiceAccountToImpersonate(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:guestbook:guestbook-deployer", serviceAccount)
+
+	serviceAccount, err = p.GetServiceAccountToImpersonate(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:team-deployer", serviceAccount)
+
+	serviceAccount, err = p.GetServiceAccountToImpersonate(ApplicationDestination{Server: "https://remote", Namespace: ""}, "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:deployer", serviceAccount)
+
+	p.Spec.DestinationServiceAccounts = p.Spec.DestinationServiceAccounts[:2]
+	_, err = p.GetServiceAccountToImpersonate(ApplicationDestination{Server: "https://remote", Namespace: "guestbook"}, "argocd")
+	assert.EqualError(t, err, "no matching service account found for destination server https://remote and namespace guestbook")
+
+	_, err = p.GetServiceAccountToImpersonate(ApplicationDestination{Server: "https://remote", Namespace: ""}, "argocd")
+	assert.EqualError(t, err, "no matching service account found for destination server https://remote and namespace ")
+}
+
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
 	err := p.ValidateProject()
@@ -3090,6 +3140,142 @@ func TestOrphanedResourcesMonitorSettings_IsWarn(t *testing.T) {
 	assert.True(t, settings.IsWarn())
 }
 
+func TestOrphanedResourcesCleanupPolicy(t *testing.T) {
+	policy := OrphanedResourcesCleanupPolicy{}
+	minAge, err := policy.GetMinAge()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, minAge)
+	assert.Equal(t, int64(10), policy.GetMaxDeletions())
+
+	policy = OrphanedResourcesCleanupPolicy{MinAge: "24h", MaxDeletions: 3}
+	minAge, err = policy.GetMinAge()
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, minAge)
+	assert.Equal(t, int64(3), policy.GetMaxDeletions())
+
+	policy.MinAge = "one day"
+	_, err = policy.GetMinAge()
+	require.Error(t, err)
+}
+
+func TestOrphanedResourceSelector_Matches(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	selector := OrphanedResourceSelector{
+		Group:         "apps",
+		Name:          "preview-*",
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "preview"}},
+	}
+
+	matches, err := selector.Matches(deployment, "preview-123", map[string]string{"env": "preview"})
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = selector.Matches(deployment, "preview-123", nil)
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	matches, err = selector.Matches(deployment, "guestbook", map[string]string{"env": "preview"})
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	matches, err = (&OrphanedResourceSelector{}).Matches(deployment, "guestbook", nil)
+	require.NoError(t, err)
+	assert.True(t, matches)
+}
+
+func TestAppProject_ValidateOrphanedResourcesCleanup(t *testing.T) {
+	p := newTestProject()
+	p.Spec.OrphanedResources = &OrphanedResourcesMonitorSettings{Cleanup: &OrphanedResourcesCleanupPolicy{Enabled: true, MinAge: "24h"}}
+	require.Error(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Cleanup.Allow = []OrphanedResourceSelector{{Kind: "ConfigMap"}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Cleanup.MinAge = "one day"
+	require.Error(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Cleanup.MinAge = ""
+	p.Spec.OrphanedResources.Cleanup.Ignore = []OrphanedResourceSelector{{LabelSelector: &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "keep", Operator: "Unknown"}},
+	}}}
+	require.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ValidateOperations(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Operations = &ProjectOperationsPolicy{MaxConcurrent: 5, PriorityClass: OperationPriorityClassHigh}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.Operations.PriorityClass = "urgent"
+	require.Error(t, p.ValidateProject())
+
+	p.Spec.Operations = &ProjectOperationsPolicy{MaxConcurrent: -1}
+	require.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ValidateManagedNamespaceTemplates(t *testing.T) {
+	limits := ManagedNamespaceTemplate{Name: "limits", Manifest: "{apiVersion: v1, kind: LimitRange, metadata: {name: limits}}"}
+	p := newTestProject()
+	p.Spec.ManagedNamespaceTemplates = []ManagedNamespaceTemplate{limits}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ManagedNamespaceTemplates = []ManagedNamespaceTemplate{limits, limits}
+	require.ErrorContains(t, p.ValidateProject(), "already exists")
+
+	p.Spec.ManagedNamespaceTemplates = []ManagedNamespaceTemplate{{Manifest: limits.Manifest}}
+	require.ErrorContains(t, p.ValidateProject(), "has no name")
+
+	p.Spec.ManagedNamespaceTemplates = []ManagedNamespaceTemplate{{Name: "invalid", Manifest: "kind: ["}}
+	require.ErrorContains(t, p.ValidateProject(), "managed namespace template 'invalid' is invalid")
+}
+
+func TestManagedNamespaceTemplate_Render(t *testing.T) {
+	app := &Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: ApplicationSpec{
+			Project:     "tenant",
+			Destination: ApplicationDestination{Server: "https://kubernetes.default.svc", Name: "in-cluster", Namespace: "guestbook"},
+		},
+	}
+	template := ManagedNamespaceTemplate{Name: "deny-all", Manifest: `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: default-deny
+  labels:
+    app: $ARGOCD_APP_NAME
+    project: ${ARGOCD_APP_PROJECT_NAME}
+  annotations:
+    cluster: $ARGOCD_APP_DESTINATION_NAME
+spec:
+  podSelector: {}
+  policyTypes: [Ingress]
+`}
+	obj, err := template.Render(app)
+	require.NoError(t, err)
+	assert.Equal(t, "NetworkPolicy", obj.GetKind())
+	assert.Equal(t, "default-deny", obj.GetName())
+	assert.Equal(t, "guestbook", obj.GetNamespace())
+	assert.Equal(t, map[string]string{"app": "guestbook", "project": "tenant"}, obj.GetLabels())
+	assert.Equal(t, map[string]string{"cluster": "in-cluster"}, obj.GetAnnotations())
+
+	for name, manifest := range map[string]string{
+		"no name":          "{apiVersion: v1, kind: ResourceQuota}",
+		"namespace":        "{apiVersion: v1, kind: Namespace, metadata: {name: other}}",
+		"other namespace":  "{apiVersion: v1, kind: ResourceQuota, metadata: {name: quota, namespace: other}}",
+		"invalid manifest": "kind: [",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ManagedNamespaceTemplate{Name: name, Manifest: manifest}.Render(app)
+			require.Error(t, err)
+		})
+	}
+	t.Run("destination namespace", func(t *testing.T) {
+		obj, err := ManagedNamespaceTemplate{Manifest: "{apiVersion: v1, kind: ResourceQuota, metadata: {name: quota, namespace: $ARGOCD_APP_NAMESPACE}}"}.Render(app)
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook", obj.GetNamespace())
+	})
+}
+
 func Test_isValidPolicyObject(t *testing.T) {
 	policyTests := []struct {
 		name    string
@@ -3444,6 +3630,27 @@ func TestGetSummary(t *testing.T) {
 	assert.Equal(t, url, summary.ExternalURLs[0])
 }
 
+func TestGetSummary_Capacity(t *testing.T) {
+	app := newTestApp()
+	assert.Nil(t, (&ApplicationTree{}).GetSummary(app).Capacity)
+
+	tree := ApplicationTree{Nodes: []ResourceNode{
+		{ResourceRef: ResourceRef{Kind: "Deployment", Name: "guestbook"}, Capacity: &ResourceCapacity{Replicas: 2}},
+		{ResourceRef: ResourceRef{Kind: "Pod", Name: "guestbook-1"}, Capacity: &ResourceCapacity{CPURequests: "500m", MemoryRequests: "512Mi", MemoryLimits: "1Gi", Pods: 1}},
+		{ResourceRef: ResourceRef{Kind: "Pod", Name: "guestbook-2"}, Capacity: &ResourceCapacity{CPURequests: "1", MemoryRequests: "512Mi", MemoryLimits: "1Gi", Pods: 1}},
+		{ResourceRef: ResourceRef{Kind: "PersistentVolumeClaim", Name: "data"}, Capacity: &ResourceCapacity{Storage: "10Gi"}},
+		{ResourceRef: ResourceRef{Kind: "Service", Name: "guestbook"}},
+	}}
+	assert.Equal(t, &ResourceCapacity{
+		CPURequests:    "1500m",
+		MemoryRequests: "1Gi",
+		MemoryLimits:   "2Gi",
+		Storage:        "10Gi",
+		Pods:           2,
+		Replicas:       2,
+	}, tree.GetSummary(app).Capacity)
+}
+
 func TestApplicationSourcePluginParameters_Environ_string(t *testing.T) {
 	params := ApplicationSourcePluginParameters{
 		{
@@ -3742,3 +3949,27 @@ func TestApplicationSpec_GetSourcePtrByIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestRollbackOnDegradedGetGracePeriod(t *testing.T) {
+	var policy *RollbackOnDegraded
+	assert.Equal(t, DefaultRollbackOnDegradedGracePeriod, policy.GetGracePeriod())
+	assert.Equal(t, DefaultRollbackOnDegradedGracePeriod, (&RollbackOnDegraded{GracePeriod: "invalid"}).GetGracePeriod())
+	assert.Equal(t, 2*time.Minute, (&RollbackOnDegraded{GracePeriod: "2m"}).GetGracePeriod())
+	assert.Equal(t, 30*time.Second, (&RollbackOnDegraded{GracePeriod: "30"}).GetGracePeriod())
+}
+
+func TestApplicationPause(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var pause *ApplicationPause
+	assert.False(t, pause.IsActive(now))
+
+	pause = &ApplicationPause{}
+	assert.True(t, pause.IsActive(now))
+	assert.Equal(t, "Reconciliation paused", pause.Message())
+
+	until := metav1.NewTime(now.Add(time.Hour))
+	pause = &ApplicationPause{Reason: "maintenance", PausedBy: "admin", Until: &until}
+	assert.True(t, pause.IsActive(now))
+	assert.False(t, pause.IsActive(now.Add(2*time.Hour)))
+	assert.Equal(t, "Reconciliation paused by admin until 2024-01-01T13:00:00Z: maintenance", pause.Message())
+}
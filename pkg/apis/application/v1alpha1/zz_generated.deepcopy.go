@@ -156,6 +156,21 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DestinationServiceAccounts != nil {
+		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
+		*out = make([]ApplicationDestinationServiceAccount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = new(ProjectOperationsPolicy)
+		**out = **in
+	}
+	if in.ManagedNamespaceTemplates != nil {
+		in, out := &in.ManagedNamespaceTemplates, &out.ManagedNamespaceTemplates
+		*out = make([]ManagedNamespaceTemplate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -245,6 +260,22 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -261,6 +292,22 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationServiceAccount.
+func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinationServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -315,6 +362,26 @@ func (in *ApplicationMatchExpression) DeepCopy() *ApplicationMatchExpression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationPause) DeepCopyInto(out *ApplicationPause) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationPause.
+func (in *ApplicationPause) DeepCopy() *ApplicationPause {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPause)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationPreservedFields) DeepCopyInto(out *ApplicationPreservedFields) {
 	*out = *in
@@ -1308,6 +1375,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(ApplicationPause)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1332,7 +1409,7 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		}
 	}
 	in.Sync.DeepCopyInto(&out.Sync)
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
 	if in.History != nil {
 		in, out := &in.History, &out.History
 		*out = make(RevisionHistories, len(*in))
@@ -1392,6 +1469,16 @@ func (in *ApplicationSummary) DeepCopyInto(out *ApplicationSummary) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChildApplications != nil {
+		in, out := &in.ChildApplications, &out.ChildApplications
+		*out = new(ChildApplicationsSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(ResourceCapacity)
+		**out = **in
+	}
 	return
 }
 
@@ -1522,6 +1609,23 @@ func (in *BearerTokenBitbucketCloud) DeepCopy() *BearerTokenBitbucketCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapturedContainerLogs) DeepCopyInto(out *CapturedContainerLogs) {
+	*out = *in
+	in.CapturedAt.DeepCopyInto(&out.CapturedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapturedContainerLogs.
+func (in *CapturedContainerLogs) DeepCopy() *CapturedContainerLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(CapturedContainerLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDetails) DeepCopyInto(out *ChartDetails) {
 	*out = *in
@@ -1543,6 +1647,43 @@ func (in *ChartDetails) DeepCopy() *ChartDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildApplicationsSummary) DeepCopyInto(out *ChildApplicationsSummary) {
+	*out = *in
+	if in.SyncStatuses != nil {
+		in, out := &in.SyncStatuses, &out.SyncStatuses
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HealthStatuses != nil {
+		in, out := &in.HealthStatuses, &out.HealthStatuses
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Failing != nil {
+		in, out := &in.Failing, &out.Failing
+		*out = make([]FailingChildApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildApplicationsSummary.
+func (in *ChildApplicationsSummary) DeepCopy() *ChildApplicationsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildApplicationsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -1610,6 +1751,26 @@ func (in *ClusterCacheInfo) DeepCopy() *ClusterCacheInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCircuitBreaker) DeepCopyInto(out *ClusterCircuitBreaker) {
+	*out = *in
+	if in.OpenedAt != nil {
+		in, out := &in.OpenedAt, &out.OpenedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCircuitBreaker.
+func (in *ClusterCircuitBreaker) DeepCopy() *ClusterCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 	*out = *in
@@ -1672,6 +1833,11 @@ func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(ClusterCircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1941,6 +2107,29 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailingChildApplication) DeepCopyInto(out *FailingChildApplication) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ApplicationCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailingChildApplication.
+func (in *FailingChildApplication) DeepCopy() *FailingChildApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(FailingChildApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2053,6 +2242,10 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2507,6 +2700,22 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedNamespaceTemplate) DeepCopyInto(out *ManagedNamespaceTemplate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNamespaceTemplate.
+func (in *ManagedNamespaceTemplate) DeepCopy() *ManagedNamespaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNamespaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatrixGenerator) DeepCopyInto(out *MatrixGenerator) {
 	*out = *in
@@ -2748,6 +2957,57 @@ func (in *OrphanedResourceKey) DeepCopy() *OrphanedResourceKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourceSelector) DeepCopyInto(out *OrphanedResourceSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourceSelector.
+func (in *OrphanedResourceSelector) DeepCopy() *OrphanedResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourcesCleanupPolicy) DeepCopyInto(out *OrphanedResourcesCleanupPolicy) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]OrphanedResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ignore != nil {
+		in, out := &in.Ignore, &out.Ignore
+		*out = make([]OrphanedResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourcesCleanupPolicy.
+func (in *OrphanedResourcesCleanupPolicy) DeepCopy() *OrphanedResourcesCleanupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourcesCleanupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesMonitorSettings) {
 	*out = *in
@@ -2761,6 +3021,11 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesM
 		*out = make([]OrphanedResourceKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(OrphanedResourcesCleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2897,6 +3162,22 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectOperationsPolicy) DeepCopyInto(out *ProjectOperationsPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectOperationsPolicy.
+func (in *ProjectOperationsPolicy) DeepCopy() *ProjectOperationsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectOperationsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -3159,6 +3440,44 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcilePhaseTiming) DeepCopyInto(out *ReconcilePhaseTiming) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilePhaseTiming.
+func (in *ReconcilePhaseTiming) DeepCopy() *ReconcilePhaseTiming {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcilePhaseTiming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileProfile) DeepCopyInto(out *ReconcileProfile) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]ReconcilePhaseTiming, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileProfile.
+func (in *ReconcileProfile) DeepCopy() *ReconcileProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefTarget) DeepCopyInto(out *RefTarget) {
 	*out = *in
@@ -3434,6 +3753,22 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCapacity) DeepCopyInto(out *ResourceCapacity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCapacity.
+func (in *ResourceCapacity) DeepCopy() *ResourceCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
@@ -3555,7 +3890,7 @@ func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(HealthStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
@@ -3575,6 +3910,11 @@ func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 			(*out)[key] = val
 		}
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(ResourceCapacity)
+		**out = **in
+	}
 	return
 }
 
@@ -3675,7 +4015,7 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(HealthStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3790,6 +4130,22 @@ func (in *RevisionMetadata) DeepCopy() *RevisionMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackOnDegraded) DeepCopyInto(out *RollbackOnDegraded) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackOnDegraded.
+func (in *RollbackOnDegraded) DeepCopy() *RollbackOnDegraded {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackOnDegraded)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCMProviderGenerator) DeepCopyInto(out *SCMProviderGenerator) {
 	*out = *in
@@ -4092,6 +4448,44 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncHistoryRecord) DeepCopyInto(out *SyncHistoryRecord) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.InitiatedBy = in.InitiatedBy
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceResults, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResourceResult)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHistoryRecord.
+func (in *SyncHistoryRecord) DeepCopy() *SyncHistoryRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncHistoryRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4198,6 +4592,10 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncWaveAppliedAt != nil {
+		in, out := &in.SyncWaveAppliedAt, &out.SyncWaveAppliedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4237,7 +4635,7 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	if in.Automated != nil {
 		in, out := &in.Automated, &out.Automated
 		*out = new(SyncPolicyAutomated)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SyncOptions != nil {
 		in, out := &in.SyncOptions, &out.SyncOptions
@@ -4270,6 +4668,11 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 	*out = *in
+	if in.RollbackOnDegraded != nil {
+		in, out := &in.RollbackOnDegraded, &out.RollbackOnDegraded
+		*out = new(RollbackOnDegraded)
+		**out = **in
+	}
 	return
 }
 
@@ -17,6 +17,8 @@ type MetricsServer struct {
 	gitRequestCounter        *prometheus.CounterVec
 	gitRequestHistogram      *prometheus.HistogramVec
 	repoPendingRequestsGauge *prometheus.GaugeVec
+	gitWorktreesGauge        *prometheus.GaugeVec
+	gitWorktreesDiskGauge    *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
 }
@@ -80,6 +82,24 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(repoPendingRequestsGauge)
 
+	gitWorktreesGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_git_worktrees",
+			Help: "Number of git worktrees checked out by repo server",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitWorktreesGauge)
+
+	gitWorktreesDiskGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_git_worktrees_disk_usage_bytes",
+			Help: "Disk space used by the git worktrees checked out by repo server",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitWorktreesDiskGauge)
+
 	redisRequestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_redis_request_total",
@@ -106,6 +126,8 @@ func NewMetricsServer() *MetricsServer {
 		gitRequestCounter:        gitRequestCounter,
 		gitRequestHistogram:      gitRequestHistogram,
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
+		gitWorktreesGauge:        gitWorktreesGauge,
+		gitWorktreesDiskGauge:    gitWorktreesDiskGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
 	}
@@ -140,6 +162,12 @@ func (m *MetricsServer) DecPendingRepoRequest(repo string) {
 	m.repoPendingRequestsGauge.WithLabelValues(repo).Dec()
 }
 
+// SetGitWorktrees sets the number of git worktrees checked out for a repository and the disk space they use
+func (m *MetricsServer) SetGitWorktrees(repo string, count int, diskUsage int64) {
+	m.gitWorktreesGauge.WithLabelValues(repo).Set(float64(count))
+	m.gitWorktreesDiskGauge.WithLabelValues(repo).Set(float64(diskUsage))
+}
+
 func (m *MetricsServer) IncRedisRequest(failed bool) {
 	m.redisRequestCounter.WithLabelValues("argocd-repo-server", strconv.FormatBool(failed)).Inc()
 }
@@ -83,3 +83,39 @@ type repositoryState struct {
 	processCount    int
 	allowConcurrent bool
 }
+
+func newRepositoryAccess() *repositoryAccess {
+	return &repositoryAccess{closerByPath: map[string]io.Closer{}, countByPath: map[string]int{}}
+}
+
+// repositoryAccess counts the operations accessing each repository, so that the repository is initialized, i.e. made
+// accessible, by the first one and only closed by the last one. The operations on the worktrees of a repository access
+// it without holding its lock.
+type repositoryAccess struct {
+	lock         sync.Mutex
+	closerByPath map[string]io.Closer
+	countByPath  map[string]int
+}
+
+// open initializes the repository at the given path unless it is already accessed, and returns a closer which must be
+// closed once the repository is no longer accessed
+func (a *repositoryAccess) open(path string, init func(path string) io.Closer) io.Closer {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.countByPath[path] == 0 {
+		a.closerByPath[path] = init(path)
+	}
+	a.countByPath[path]++
+	return ioutil.NewCloser(func() error {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.countByPath[path]--
+		if a.countByPath[path] > 0 {
+			return nil
+		}
+		closer := a.closerByPath[path]
+		delete(a.closerByPath, path)
+		delete(a.countByPath, path)
+		return closer.Close()
+	})
+}
@@ -168,3 +168,28 @@ func TestLock_SameRevisionFirstNotConcurrent(t *testing.T) {
 
 	util.Close(closer1)
 }
+
+func TestRepositoryAccess(t *testing.T) {
+	access := newRepositoryAccess()
+	opened, closed := 0, 0
+	init := func(path string) io.Closer {
+		opened++
+		return util.NewCloser(func() error {
+			closed++
+			return nil
+		})
+	}
+
+	first := access.open("/repo", init)
+	second := access.open("/repo", init)
+	assert.Equal(t, 1, opened)
+
+	util.Close(first)
+	assert.Equal(t, 0, closed)
+	util.Close(second)
+	assert.Equal(t, 1, closed)
+
+	util.Close(access.open("/repo", init))
+	assert.Equal(t, 2, opened)
+	assert.Equal(t, 2, closed)
+}
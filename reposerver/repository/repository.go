@@ -89,6 +89,7 @@ type Service struct {
 	chartPaths                io.TempPaths
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
+	repoAccess                *repositoryAccess
 	cache                     *cache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
@@ -98,6 +99,7 @@ type Service struct {
 	initConstants             RepoServerInitConstants
 	codefreshClient           codefresh.CodefreshClientInterface
 	versionConfigManager      *version_config_manager.VersionConfigManager
+	gitWorktrees              *gitWorktrees
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
 }
@@ -120,6 +122,8 @@ type RepoServerInitConstants struct {
 	CodefreshApplicationVersioningEnabled        bool
 	CodefreshUseApplicationConfiguration         bool
 	CodefreshConfig                              codefresh.CodefreshConfig
+	GitWorktreesEnabled                          bool
+	MaxGitWorktrees                              int
 }
 
 // NewService returns a new instance of the Manifest service
@@ -139,6 +143,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
+		repoAccess:                newRepositoryAccess(),
 		cache:                     cache,
 		metricsServer:             metricsServer,
 		newGitClient:              git.NewClientExt,
@@ -155,6 +160,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		rootDir:              rootDir,
 		codefreshClient:      codefreshClient,
 		versionConfigManager: versionConfigManager,
+		gitWorktrees:         newGitWorktrees(initConstants.MaxGitWorktrees, repoLock, metricsServer),
 	}
 }
 
@@ -416,9 +422,18 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, ""}, nil
 		})
 	} else {
-		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
-		})
+		var closer goio.Closer
+		if s.initConstants.GitWorktreesEnabled {
+			// the revision is checked out into a worktree, so that the other revisions of the repository can be
+			// processed concurrently
+			gitClient, closer, err = s.lockWorktree(gitClient, repo.Repo, revision, settings.allowConcurrent, func(root string) (git.Client, error) {
+				return s.newClientWithRoot(repo, root, gitClientOpts)
+			})
+		} else {
+			closer, err = s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
+				return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+			})
+		}
 		if err != nil {
 			return err
 		}
@@ -2624,8 +2639,13 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	if err != nil {
 		return nil, err
 	}
+	return s.newClientWithRoot(repo, repoPath, opts...)
+}
+
+// newClientWithRoot returns a git client of the repository working in the given directory
+func (s *Service) newClientWithRoot(repo *v1alpha1.Repository, root string, opts ...git.ClientOpts) (git.Client, error) {
 	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
-	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, opts...)
+	return s.newGitClient(repo.Repo, root, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, opts...)
 }
 
 // newClientResolveRevision is a helper to perform the common task of instantiating a git client
@@ -2707,10 +2727,12 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 // Returns the 40 character commit SHA after the checkout has been performed
 // nolint:unparam
 func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) (goio.Closer, error) {
-	closer := s.gitRepoInitializer(gitClient.Root())
+	closer := s.repoAccess.open(gitClient.Root(), s.gitRepoInitializer)
 	err := checkoutRevision(gitClient, revision, submoduleEnabled)
 	if err != nil {
 		s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
+		// the closer is dropped by the repository lock when the initialization fails
+		io.Close(closer)
 	}
 	return closer, err
 }
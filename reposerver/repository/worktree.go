@@ -0,0 +1,279 @@
+package repository
+
+import (
+	goio "io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+const (
+	// worktreesDir is the directory of the git directory of a repository holding its worktrees
+	worktreesDir = "argocd-worktrees"
+	// worktreesLockRevision is the revision the repository lock is acquired with while worktrees are added or removed.
+	// The worktrees of different revisions share the lock, while the operations checking out a revision in the
+	// repository itself wait for them to complete.
+	worktreesLockRevision = "argocd-worktrees"
+)
+
+// worktree is a revision of a repository checked out in a git worktree
+type worktree struct {
+	path string
+	// inUse is the number of operations using the worktree
+	inUse     int
+	lastUsed  time.Time
+	diskUsage int64
+}
+
+// repositoryWorktrees holds the worktrees of a repository
+type repositoryWorktrees struct {
+	// lock serializes the git commands run in the repository, such as fetching revisions and adding worktrees
+	lock      sync.Mutex
+	repoURL   string
+	worktrees map[string]*worktree
+	// initialized is true once the worktrees left over by a previous run of the repo server are removed
+	initialized bool
+}
+
+// gitWorktrees checks out the revisions of the repositories into git worktrees which share the objects of their
+// repository, so that the manifests of different revisions of a repository can be generated concurrently. The idle
+// worktrees are removed, least recently used first, once a repository has more than the maximum number of worktrees.
+// The git commands adding and removing worktrees are run with the repository lock held, while the worktrees are used
+// without it, so that the operations checking out a revision in the repository itself are not kept waiting.
+type gitWorktrees struct {
+	lock          sync.Mutex
+	repos         map[string]*repositoryWorktrees
+	maxWorktrees  int
+	repoLock      *repositoryLock
+	metricsServer *metrics.MetricsServer
+	now           func() time.Time
+}
+
+func newGitWorktrees(maxWorktrees int, repoLock *repositoryLock, metricsServer *metrics.MetricsServer) *gitWorktrees {
+	return &gitWorktrees{
+		repos:         make(map[string]*repositoryWorktrees),
+		maxWorktrees:  maxWorktrees,
+		repoLock:      repoLock,
+		metricsServer: metricsServer,
+		now:           time.Now,
+	}
+}
+
+// getRepository returns the worktrees of the repository of the given client
+func (w *gitWorktrees) getRepository(gitClient git.Client, repoURL string) *repositoryWorktrees {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	repo, ok := w.repos[gitClient.Root()]
+	if !ok {
+		repo = &repositoryWorktrees{repoURL: repoURL, worktrees: make(map[string]*worktree)}
+		w.repos[gitClient.Root()] = repo
+	}
+	return repo
+}
+
+// getWorktree returns the worktree of the revision, unless it is not checked out. It must be called with the lock of
+// the repository held.
+func (repo *repositoryWorktrees) getWorktree(revision string) (*worktree, bool) {
+	wt, ok := repo.worktrees[revision]
+	if ok {
+		if _, err := os.Stat(wt.path); err != nil {
+			delete(repo.worktrees, revision)
+			return nil, false
+		}
+	}
+	return wt, ok
+}
+
+// acquire checks out the revision of the repository of the given client into a worktree, unless it is already checked
+// out, and returns the path of the worktree. The returned closer must be closed once the worktree is no longer used.
+// The repository lock is only acquired to add the worktree or to remove the idle ones, the worktrees in use being
+// removed by a later call once they are idle.
+func (w *gitWorktrees) acquire(gitClient git.Client, repoURL string, revision string) (string, goio.Closer, error) {
+	repo := w.getRepository(gitClient, repoURL)
+	repo.lock.Lock()
+	if wt, ok := repo.getWorktree(revision); ok && !w.exceedsMaxWorktrees(repo) {
+		defer repo.lock.Unlock()
+		return wt.path, w.use(repo, wt), nil
+	}
+	repo.lock.Unlock()
+
+	repoCloser, err := w.repoLock.Lock(gitClient.Root(), worktreesLockRevision, true, func() (goio.Closer, error) {
+		return io.NopCloser, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	defer io.Close(repoCloser)
+	repo.lock.Lock()
+	defer repo.lock.Unlock()
+
+	if !repo.initialized {
+		dir := filepath.Join(gitClient.Root(), ".git", worktreesDir)
+		if _, err := os.Stat(dir); err == nil {
+			// the directory is not a worktree, so it is deleted and the worktrees it contained are pruned
+			if err := gitClient.RemoveWorktree(dir); err != nil {
+				log.Warnf("Failed to remove the worktrees of %s: %v", repoURL, err)
+			}
+		}
+		repo.initialized = true
+	}
+	wt, ok := repo.getWorktree(revision)
+	if !ok {
+		path := filepath.Join(gitClient.Root(), ".git", worktreesDir, revision)
+		if err := addWorktree(gitClient, path, revision); err != nil {
+			return "", nil, err
+		}
+		wt = &worktree{path: path, diskUsage: getDiskUsage(path)}
+		repo.worktrees[revision] = wt
+	}
+	closer := w.use(repo, wt)
+	w.evict(gitClient, repo)
+	return wt.path, closer, nil
+}
+
+// use marks the worktree as used until the returned closer is closed. It must be called with the lock of the
+// repository held.
+func (w *gitWorktrees) use(repo *repositoryWorktrees, wt *worktree) goio.Closer {
+	wt.inUse++
+	wt.lastUsed = w.now()
+	return io.NewCloser(func() error {
+		repo.lock.Lock()
+		defer repo.lock.Unlock()
+		wt.inUse--
+		wt.lastUsed = w.now()
+		return nil
+	})
+}
+
+// exceedsMaxWorktrees returns true if the repository has more than the maximum number of worktrees. It must be called
+// with the lock of the repository held.
+func (w *gitWorktrees) exceedsMaxWorktrees(repo *repositoryWorktrees) bool {
+	return w.maxWorktrees > 0 && len(repo.worktrees) > w.maxWorktrees
+}
+
+// evict removes the least recently used idle worktrees of the repository until it has no more than the maximum number
+// of worktrees. It must be called with the lock of the repository and the repository lock held.
+func (w *gitWorktrees) evict(gitClient git.Client, repo *repositoryWorktrees) {
+	for w.exceedsMaxWorktrees(repo) {
+		var oldest string
+		for revision, wt := range repo.worktrees {
+			if wt.inUse == 0 && (oldest == "" || wt.lastUsed.Before(repo.worktrees[oldest].lastUsed)) {
+				oldest = revision
+			}
+		}
+		if oldest == "" {
+			// all the worktrees are in use
+			break
+		}
+		if err := gitClient.RemoveWorktree(repo.worktrees[oldest].path); err != nil {
+			log.Warnf("Failed to remove the worktree of revision %s of %s: %v", oldest, repo.repoURL, err)
+		}
+		delete(repo.worktrees, oldest)
+	}
+
+	var diskUsage int64
+	for _, wt := range repo.worktrees {
+		diskUsage += wt.diskUsage
+	}
+	w.metricsServer.SetGitWorktrees(repo.repoURL, len(repo.worktrees), diskUsage)
+}
+
+// addWorktree fetches the revision into the repository if it is not present yet, and checks it out into a new
+// worktree at the given path
+func addWorktree(gitClient git.Client, path string, revision string) error {
+	err := gitClient.Init()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+	}
+	if !gitClient.IsRevisionPresent(revision) {
+		// Fetching with no revision first. Fetching with an explicit version can cause repo bloat. https://github.com/argoproj/argo-cd/issues/8845
+		if err := gitClient.Fetch(""); err != nil {
+			return status.Errorf(codes.Internal, "Failed to fetch default: %v", err)
+		}
+		// the revision might not be in the default refspec
+		if !gitClient.IsRevisionPresent(revision) {
+			if err := gitClient.Fetch(revision); err != nil {
+				return status.Errorf(codes.Internal, "Failed to fetch revision %s: %v", revision, err)
+			}
+		}
+	}
+	// the worktree might have been left behind by a previous run of the repo server
+	if _, err := os.Stat(path); err == nil {
+		if err := gitClient.RemoveWorktree(path); err != nil {
+			return status.Errorf(codes.Internal, "Failed to remove worktree of revision %s: %v", revision, err)
+		}
+	}
+	if err := gitClient.AddWorktree(path, revision); err != nil {
+		return status.Errorf(codes.Internal, "Failed to add worktree of revision %s: %v", revision, err)
+	}
+	return nil
+}
+
+// getDiskUsage returns the size of the files of the given directory
+func getDiskUsage(path string) int64 {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warnf("Failed to compute the disk usage of %s: %v", path, err)
+	}
+	return size
+}
+
+// lockWorktree checks out the revision of the repository into a worktree and locks it, like the repository lock does
+// for the repository itself. It returns a client of the worktree, and a closer which must be closed once the
+// operation on the worktree is completed. The repository is only locked while the worktree is added, so that the
+// operations on the repository itself, such as the ones of the referenced sources of multi-source applications, run
+// concurrently with the operations on its worktrees.
+func (s *Service) lockWorktree(gitClient git.Client, repoURL string, revision string, allowConcurrent bool, newWorktreeClient func(root string) (git.Client, error)) (git.Client, goio.Closer, error) {
+	// the worktrees are in the git directory of the repository, which must remain accessible while they are used
+	accessCloser := s.repoAccess.open(gitClient.Root(), s.gitRepoInitializer)
+	path, worktreeCloser, err := s.gitWorktrees.acquire(gitClient, repoURL, revision)
+	if err != nil {
+		s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
+		io.Close(accessCloser)
+		return nil, nil, err
+	}
+	worktreeClient, err := newWorktreeClient(path)
+	if err != nil {
+		io.Close(worktreeCloser)
+		io.Close(accessCloser)
+		return nil, nil, err
+	}
+	// the worktree is restored to the revision before being used, since generating manifests may modify its files
+	closer, err := s.repoLock.Lock(path, revision, allowConcurrent, func() (goio.Closer, error) {
+		if err := worktreeClient.Checkout(revision, s.initConstants.SubmoduleEnabled); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to checkout revision %s: %v", revision, err)
+		}
+		return io.NopCloser, nil
+	})
+	if err != nil {
+		io.Close(worktreeCloser)
+		io.Close(accessCloser)
+		return nil, nil, err
+	}
+	return worktreeClient, io.NewCloser(func() error {
+		io.Close(closer)
+		io.Close(worktreeCloser)
+		return accessCloser.Close()
+	}), nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	goio "io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// commitFile writes the file into the git repository at the given path, commits it and returns the commit SHA
+func commitFile(t *testing.T, repoPath string, name string, content string) string {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0o644))
+	for _, args := range [][]string{{"add", "-A"}, {"commit", "-m", "Update " + name}} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoPath
+		require.NoError(t, cmd.Run())
+	}
+	var out bytes.Buffer
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = repoPath
+	cmd.Stdout = &out
+	require.NoError(t, cmd.Run())
+	return strings.TrimSpace(out.String())
+}
+
+func newWorktreesTestRepo(t *testing.T) (git.Client, string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	remotePath := filepath.Join(dir, "remote")
+	initGitRepo(t, newGitRepoOptions{path: remotePath, createPath: true})
+	first := commitFile(t, remotePath, "README", "first")
+	second := commitFile(t, remotePath, "README", "second")
+
+	gitClient, err := git.NewClientExt(fmt.Sprintf("file://%s", remotePath), filepath.Join(dir, "clone"), git.NopCreds{}, true, false, "")
+	require.NoError(t, err)
+	return gitClient, first, second
+}
+
+func TestGitWorktrees_Acquire(t *testing.T) {
+	gitClient, first, second := newWorktreesTestRepo(t)
+	worktrees := newGitWorktrees(1, NewRepositoryLock(), metrics.NewMetricsServer())
+
+	firstPath, firstCloser, err := worktrees.acquire(gitClient, "https://example.com/repo.git", first)
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(firstPath, "README"))
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(data))
+
+	// the worktrees in use are kept although the repository has more worktrees than the maximum
+	secondPath, secondCloser, err := worktrees.acquire(gitClient, "https://example.com/repo.git", second)
+	require.NoError(t, err)
+	data, err = os.ReadFile(filepath.Join(secondPath, "README"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+	assert.DirExists(t, firstPath)
+
+	// the worktree of the same revision is reused
+	path, closer, err := worktrees.acquire(gitClient, "https://example.com/repo.git", second)
+	require.NoError(t, err)
+	assert.Equal(t, secondPath, path)
+	io.Close(closer)
+
+	// the idle worktrees are removed by the next acquisition
+	io.Close(firstCloser)
+	io.Close(secondCloser)
+	assert.DirExists(t, firstPath)
+	path, closer, err = worktrees.acquire(gitClient, "https://example.com/repo.git", second)
+	require.NoError(t, err)
+	assert.Equal(t, secondPath, path)
+	assert.NoDirExists(t, firstPath)
+	io.Close(closer)
+
+	// an evicted revision can be checked out again
+	firstPath, firstCloser, err = worktrees.acquire(gitClient, "https://example.com/repo.git", first)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(firstPath, "README"))
+	io.Close(firstCloser)
+	assert.NoDirExists(t, secondPath)
+}
+
+func TestGitWorktrees_AcquireDoesNotHoldRepositoryLock(t *testing.T) {
+	gitClient, first, second := newWorktreesTestRepo(t)
+	repoLock := NewRepositoryLock()
+	worktrees := newGitWorktrees(10, repoLock, metrics.NewMetricsServer())
+
+	_, closer, err := worktrees.acquire(gitClient, "https://example.com/repo.git", first)
+	require.NoError(t, err)
+	defer io.Close(closer)
+
+	// the repository itself can be checked out while the worktree is used
+	repoCloser, err := repoLock.Lock(gitClient.Root(), second, false, func() (goio.Closer, error) {
+		return io.NopCloser, gitClient.Checkout(second, false)
+	})
+	require.NoError(t, err)
+	io.Close(repoCloser)
+}
+
+func TestGitWorktrees_RemovesLeftOvers(t *testing.T) {
+	gitClient, first, _ := newWorktreesTestRepo(t)
+	require.NoError(t, gitClient.Init())
+	leftOver := filepath.Join(gitClient.Root(), ".git", worktreesDir, "left-over")
+	require.NoError(t, os.MkdirAll(leftOver, 0o755))
+
+	worktrees := newGitWorktrees(10, NewRepositoryLock(), metrics.NewMetricsServer())
+	path, closer, err := worktrees.acquire(gitClient, "https://example.com/repo.git", first)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	assert.NoDirExists(t, leftOver)
+	assert.FileExists(t, filepath.Join(path, "README"))
+}
+
+func TestGenerateManifest_GitWorktrees(t *testing.T) {
+	dir := t.TempDir()
+	remotePath := filepath.Join(dir, "remote")
+	initGitRepo(t, newGitRepoOptions{path: remotePath, createPath: true})
+	configMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-map\ndata:\n  revision: %s\n"
+	first := commitFile(t, remotePath, "config-map.yaml", fmt.Sprintf(configMap, "first"))
+	second := commitFile(t, remotePath, "config-map.yaml", fmt.Sprintf(configMap, "second"))
+
+	root := filepath.Join(dir, "root")
+	cacheMocks := newCacheMocks()
+	t.Cleanup(func() {
+		cacheMocks.mockCache.StopRedisCallback()
+		// the repository is made unreadable once it is not used anymore
+		require.NoError(t, filepath.WalkDir(root, func(path string, _ fs.DirEntry, err error) error {
+			if err == nil {
+				return os.Chmod(path, 0o777)
+			}
+			return err
+		}))
+	})
+	service := NewService(metrics.NewMetricsServer(), cacheMocks.cache, RepoServerInitConstants{ParallelismLimit: 1, GitWorktreesEnabled: true, MaxGitWorktrees: 10}, argo.NewResourceTracking(), &git.NoopCredsStore{}, root)
+	require.NoError(t, service.Init())
+
+	repo := &argoappv1.Repository{Repo: fmt.Sprintf("file://%s", remotePath)}
+	for _, hasMultipleSources := range []bool{false, true} {
+		for revision, expected := range map[string]string{first: "first", second: "second"} {
+			res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+				Repo:               repo,
+				Revision:           revision,
+				ApplicationSource:  &argoappv1.ApplicationSource{RepoURL: repo.Repo, Path: "."},
+				ProjectName:        "default",
+				ProjectSourceRepos: []string{"*"},
+				NoCache:            true,
+				HasMultipleSources: hasMultipleSources,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, revision, res.Revision)
+			require.Len(t, res.Manifests, 1)
+			assert.Contains(t, res.Manifests[0].CompiledManifest, fmt.Sprintf(`"revision":"%s"`, expected))
+		}
+	}
+}
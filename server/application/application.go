@@ -79,8 +79,9 @@ const (
 )
 
 var (
-	watchAPIBufferSize  = env.ParseNumFromEnv(argocommon.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
-	permissionDeniedErr = status.Error(codes.PermissionDenied, "permission denied")
+	watchAPIBufferSize          = env.ParseNumFromEnv(argocommon.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+	bulkOperationMaxConcurrency = env.ParseNumFromEnv(argocommon.EnvBulkOperationMaxConcurrency, 50, 1, math.MaxInt32)
+	permissionDeniedErr         = status.Error(codes.PermissionDenied, "permission denied")
 )
 
 // Server provides an Application service
@@ -360,6 +361,14 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		}).Warn("User attempted to set operation on application creation. This could have allowed them to bypass branch protection rules by setting manifests directly. Ignoring the set operation.")
 		a.Operation = nil
 	}
+	// The pause is only set through the Pause and Resume APIs, which record the user who paused the application.
+	if a.Spec.Pause != nil {
+		log.WithFields(log.Fields{
+			"application":            a.Name,
+			argocommon.SecurityField: argocommon.SecurityLow,
+		}).Warn("User attempted to set the pause on application creation. Ignoring the set pause.")
+		a.Spec.Pause = nil
+	}
 
 	created, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Create(ctx, a, metav1.CreateOptions{})
 	if err == nil {
@@ -937,7 +946,10 @@ func (s *Server) waitSync(app *appv1.Application) {
 
 func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ctx context.Context, merge bool) (*appv1.Application, error) {
 	for i := 0; i < 10; i++ {
+		// the pause is only changed through the Pause and Resume APIs, so it is preserved whatever the new spec says
+		pause := app.Spec.Pause
 		app.Spec = newApp.Spec
+		app.Spec.Pause = pause
 		if merge {
 			app.Labels = collections.MergeStringMaps(app.Labels, newApp.Labels)
 			app.Annotations = collections.MergeStringMaps(app.Annotations, newApp.Annotations)
@@ -1921,6 +1933,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.Spec.Pause.IsActive(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot sync: %s", a.Spec.Pause.Message())
+	}
 
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
@@ -1955,15 +1970,16 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     revision,
-			Prune:        syncReq.GetPrune(),
-			DryRun:       syncReq.GetDryRun(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: syncReq.Strategy,
-			Resources:    resources,
-			Manifests:    syncReq.Manifests,
-			Sources:      a.Spec.Sources,
-			Revisions:    sourceRevisions,
+			Revision:         revision,
+			Prune:            syncReq.GetPrune(),
+			DryRun:           syncReq.GetDryRun(),
+			SyncOptions:      syncOptions,
+			SyncStrategy:     syncReq.Strategy,
+			Resources:        resources,
+			Manifests:        syncReq.Manifests,
+			Sources:          a.Spec.Sources,
+			Revisions:        sourceRevisions,
+			IgnorePruneLimit: syncReq.GetIgnorePruneLimit(),
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	gosync "sync"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+const (
+	BulkOperationSync        = "sync"
+	BulkOperationRefresh     = "refresh"
+	BulkOperationHardRefresh = "hard-refresh"
+	BulkOperationTerminate   = "terminate"
+	BulkOperationDelete      = "delete"
+
+	bulkOperationDefaultConcurrency = 10
+)
+
+// BulkOperations is the list of operations supported by the BulkOperation API
+var BulkOperations = []string{BulkOperationSync, BulkOperationRefresh, BulkOperationHardRefresh, BulkOperationTerminate, BulkOperationDelete}
+
+// BulkOperation runs an operation against all applications matching the given filters. Applications are processed
+// with bounded concurrency, and the outcome for every application is streamed back as soon as it is known. RBAC is
+// enforced per application by the underlying operation, so a failure for one application does not abort the others.
+func (s *Server) BulkOperation(q *application.ApplicationBulkOperationRequest, ws application.ApplicationService_BulkOperationServer) error {
+	ctx := ws.Context()
+	run, err := s.getBulkOperationFunc(q)
+	if err != nil {
+		return err
+	}
+	apps, err := s.listBulkOperationApps(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	concurrency := getBulkOperationConcurrency(q.GetConcurrency(), int64(bulkOperationMaxConcurrency))
+	sem := semaphore.NewWeighted(concurrency)
+	total := int64(len(apps))
+	completed := int64(0)
+	var sendLock gosync.Mutex
+	var sendErr error
+	var wg gosync.WaitGroup
+	for _, a := range apps {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(a *appv1.Application) {
+			defer wg.Done()
+			defer sem.Release(1)
+			resp := &application.ApplicationBulkOperationResponse{
+				Name:         &a.Name,
+				AppNamespace: &a.Namespace,
+				Project:      &a.Spec.Project,
+				Succeeded:    ptr.To(true),
+				Total:        &total,
+			}
+			if err := run(ctx, a); err != nil {
+				log.WithField("application", a.QualifiedName()).Warnf("Bulk %s failed: %v", q.GetOperation(), err)
+				resp.Succeeded = ptr.To(false)
+				resp.Message = ptr.To(status.Convert(err).Message())
+			}
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			completed++
+			resp.Completed = ptr.To(completed)
+			if sendErr == nil {
+				sendErr = ws.Send(resp)
+			}
+		}(a)
+	}
+	wg.Wait()
+	if sendErr != nil {
+		return fmt.Errorf("error sending bulk operation progress: %w", sendErr)
+	}
+	return ctx.Err()
+}
+
+// getBulkOperationConcurrency returns the number of applications to process in parallel, the requested concurrency
+// being capped by the maximum concurrency of the server
+func getBulkOperationConcurrency(requested int64, maxConcurrency int64) int64 {
+	concurrency := requested
+	if concurrency <= 0 {
+		concurrency = bulkOperationDefaultConcurrency
+	}
+	if concurrency > maxConcurrency {
+		concurrency = maxConcurrency
+	}
+	return concurrency
+}
+
+// getBulkOperationFunc returns the function applying the requested bulk operation to a single application
+func (s *Server) getBulkOperationFunc(q *application.ApplicationBulkOperationRequest) (func(ctx context.Context, a *appv1.Application) error, error) {
+	switch q.GetOperation() {
+	case BulkOperationSync:
+		return func(ctx context.Context, a *appv1.Application) error {
+			_, err := s.Sync(ctx, &application.ApplicationSyncRequest{
+				Name:         &a.Name,
+				AppNamespace: &a.Namespace,
+				Project:      &a.Spec.Project,
+				Prune:        q.Prune,
+				DryRun:       q.DryRun,
+			})
+			return err
+		}, nil
+	case BulkOperationRefresh, BulkOperationHardRefresh:
+		refreshType := appv1.RefreshTypeNormal
+		if q.GetOperation() == BulkOperationHardRefresh {
+			refreshType = appv1.RefreshTypeHard
+		}
+		return func(ctx context.Context, a *appv1.Application) error {
+			if _, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionGet, a.Spec.Project, a.Namespace, a.Name, ""); err != nil {
+				return err
+			}
+			_, err := argoutil.RefreshApp(s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace), a.Name, refreshType)
+			return err
+		}, nil
+	case BulkOperationTerminate:
+		return func(ctx context.Context, a *appv1.Application) error {
+			_, err := s.TerminateOperation(ctx, &application.OperationTerminateRequest{
+				Name:         &a.Name,
+				AppNamespace: &a.Namespace,
+				Project:      &a.Spec.Project,
+			})
+			return err
+		}, nil
+	case BulkOperationDelete:
+		return func(ctx context.Context, a *appv1.Application) error {
+			_, err := s.Delete(ctx, &application.ApplicationDeleteRequest{
+				Name:              &a.Name,
+				AppNamespace:      &a.Namespace,
+				Project:           &a.Spec.Project,
+				Cascade:           q.Cascade,
+				PropagationPolicy: q.PropagationPolicy,
+			})
+			return err
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported bulk operation %q, must be one of %v", q.GetOperation(), BulkOperations)
+	}
+}
+
+// listBulkOperationApps returns the applications matching the bulk operation filters which the user is allowed to see
+func (s *Server) listBulkOperationApps(ctx context.Context, q *application.ApplicationBulkOperationRequest) ([]*appv1.Application, error) {
+	selector, err := labels.Parse(q.GetSelector())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error parsing the selector: %v", err)
+	}
+	var apps []*appv1.Application
+	if q.GetAppNamespace() == "" {
+		apps, err = s.appLister.List(selector)
+	} else {
+		apps, err = s.appLister.Applications(q.GetAppNamespace()).List(selector)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
+	}
+	apps = argoutil.FilterByProjectsP(apps, q.GetProjects())
+
+	filteredApps := make([]*appv1.Application, 0, len(apps))
+	for _, a := range apps {
+		if q.GetName() != "" && !glob.Match(q.GetName(), a.Name) {
+			continue
+		}
+		if !s.isNamespaceEnabled(a.Namespace) {
+			continue
+		}
+		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)) {
+			filteredApps = append(filteredApps, a)
+		}
+	}
+	sort.Slice(filteredApps, func(i, j int) bool {
+		return filteredApps[i].QualifiedName() < filteredApps[j].QualifiedName()
+	})
+	return filteredApps, nil
+}
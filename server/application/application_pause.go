@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+// Pause pauses the reconciliation of an application. The controller stops refreshing and syncing the application
+// until it is resumed, or until the requested duration has elapsed.
+func (s *Server) Pause(ctx context.Context, q *application.ApplicationPauseRequest) (*appv1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	pause := &appv1.ApplicationPause{Reason: q.GetReason(), PausedBy: session.Username(ctx)}
+	if q.GetDuration() != "" {
+		duration, err := time.ParseDuration(q.GetDuration())
+		if err != nil || duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pause duration %q: must be a positive duration such as 30m or 2h", q.GetDuration())
+		}
+		until := metav1.NewTime(time.Now().Add(duration).Truncate(time.Second))
+		pause.Until = &until
+	}
+
+	updated, err := s.patchApplicationPause(ctx, a, pause)
+	if err != nil {
+		return nil, err
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("paused reconciliation: %s", pause.Message()))
+	return updated, nil
+}
+
+// Resume resumes the reconciliation of a paused application
+func (s *Server) Resume(ctx context.Context, q *application.ApplicationResumeRequest) (*appv1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	if a.Spec.Pause == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s is not paused", a.QualifiedName())
+	}
+
+	updated, err := s.patchApplicationPause(ctx, a, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "resumed reconciliation")
+	return updated, nil
+}
+
+// patchApplicationPause sets the pause of the application, or removes it if pause is nil. A resumed application also
+// gets a refresh requested, so that it is reconciled straight away.
+func (s *Server) patchApplicationPause(ctx context.Context, a *appv1.Application, pause *appv1.ApplicationPause) (*appv1.Application, error) {
+	values := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"pause": pause,
+		},
+	}
+	if pause == nil {
+		values["metadata"] = map[string]interface{}{
+			"annotations": map[string]string{
+				appv1.AnnotationKeyRefresh: string(appv1.RefreshTypeNormal),
+			},
+		}
+	}
+	patch, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling application pause patch: %w", err)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching application pause: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+)
+
+// ReconcileProfiles returns the time spent in each phase of the last reconciliations of an application, most recent
+// first. Nothing is returned once the profiles have expired from the cache.
+func (s *Server) ReconcileProfiles(ctx context.Context, q *application.ApplicationReconcileProfilesQuery) (*application.ApplicationReconcileProfilesResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.ApplicationReconcileProfilesResponse{}
+	var items []*appv1.ReconcileProfile
+	if err := s.cache.GetAppReconcileProfiles(a.InstanceName(s.ns), &items); err != nil {
+		if errors.Is(err, servercache.ErrCacheMiss) {
+			return res, nil
+		}
+		return nil, fmt.Errorf("error getting reconcile profiles: %w", err)
+	}
+	res.Items = items
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+)
+
+// SyncHistory returns a page of the extended sync history of an application, most recent first. The history is empty
+// unless it is enabled in the application controller.
+func (s *Server) SyncHistory(ctx context.Context, q *application.ApplicationSyncHistoryQuery) (*application.ApplicationSyncHistoryResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if q.GetOffset() < 0 || q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+
+	var records []*appv1.SyncHistoryRecord
+	if err := s.cache.GetAppSyncHistory(a.InstanceName(s.ns), &records); err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting sync history: %w", err)
+	}
+	total := int64(len(records))
+	res := &application.ApplicationSyncHistoryResponse{Total: &total}
+	if q.GetOffset() >= total {
+		return res, nil
+	}
+	records = records[q.GetOffset():]
+	if q.GetLimit() > 0 && q.GetLimit() < int64(len(records)) {
+		records = records[:q.GetLimit()]
+	}
+	res.Items = records
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+)
+
+// SyncLogs returns the tail of the container logs which the controller captured from the pods which failed during the
+// last sync operation of an application. Nothing is returned once the captured logs have expired from the cache.
+func (s *Server) SyncLogs(ctx context.Context, q *application.ApplicationSyncLogsQuery) (*application.ApplicationSyncLogsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// the captured logs are subject to the same RBAC as the pod logs
+	serverRBACLogEnforceEnable, err := s.settingsMgr.GetServerRBACLogEnforceEnable()
+	if err != nil {
+		return nil, fmt.Errorf("error getting RBAC log enforce enable: %w", err)
+	}
+	if serverRBACLogEnforceEnable {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &application.ApplicationSyncLogsResponse{}
+	opState := a.Status.OperationState
+	if opState == nil || opState.SyncResult == nil || opState.SyncResult.CapturedLogsID == "" {
+		return res, nil
+	}
+	var items []*appv1.CapturedContainerLogs
+	if err := s.cache.GetAppSyncCapturedLogs(a.InstanceName(s.ns), opState.SyncResult.CapturedLogsID, &items); err != nil {
+		if errors.Is(err, servercache.ErrCacheMiss) {
+			return res, nil
+		}
+		return nil, fmt.Errorf("error getting captured sync logs: %w", err)
+	}
+	res.Items = items
+	return res, nil
+}
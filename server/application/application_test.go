@@ -3289,3 +3289,331 @@ func Test_RevisionMetadata(t *testing.T) {
 		})
 	}
 }
+
+type TestBulkOperationServer struct {
+	ctx       context.Context
+	responses []*application.ApplicationBulkOperationResponse
+}
+
+func (t *TestBulkOperationServer) Send(resp *application.ApplicationBulkOperationResponse) error {
+	t.responses = append(t.responses, resp)
+	return nil
+}
+
+func (t *TestBulkOperationServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestBulkOperationServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestBulkOperationServer) SetTrailer(metadata.MD) {}
+
+func (t *TestBulkOperationServer) Context() context.Context {
+	return t.ctx
+}
+
+func (t *TestBulkOperationServer) SendMsg(m interface{}) error {
+	return nil
+}
+
+func (t *TestBulkOperationServer) RecvMsg(m interface{}) error {
+	return nil
+}
+
+func TestBulkOperation(t *testing.T) {
+	newApp := func(name string, project string) *appsv1.Application {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Name = name
+			app.Spec.Project = project
+			app.Labels = map[string]string{"team": "a"}
+		})
+	}
+
+	t.Run("Refresh apps matching name glob", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp("guestbook-1", "default"), newApp("guestbook-2", "default"), newApp("other", "default"))
+		stream := &TestBulkOperationServer{ctx: context.Background()}
+		err := appServer.BulkOperation(&application.ApplicationBulkOperationRequest{
+			Operation: ptr.To(BulkOperationHardRefresh),
+			Name:      ptr.To("guestbook-*"),
+		}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 2)
+		for _, resp := range stream.responses {
+			assert.True(t, resp.GetSucceeded())
+			assert.Equal(t, int64(2), resp.GetTotal())
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), resp.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, string(appsv1.RefreshTypeHard), app.Annotations[appsv1.AnnotationKeyRefresh])
+		}
+		assert.ElementsMatch(t, []int64{1, 2}, []int64{stream.responses[0].GetCompleted(), stream.responses[1].GetCompleted()})
+	})
+
+	t.Run("Sync reports per application RBAC failures", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp("app-1", "default"), newApp("app-2", "my-proj"))
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, */*, allow
+p, test-user, applications, sync, default/*, allow
+`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		stream := &TestBulkOperationServer{ctx: ctx}
+		err := appServer.BulkOperation(&application.ApplicationBulkOperationRequest{
+			Operation:   ptr.To(BulkOperationSync),
+			Selector:    ptr.To("team=a"),
+			Concurrency: ptr.To(int64(1)),
+		}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 2)
+		assert.Equal(t, "app-1", stream.responses[0].GetName())
+		assert.True(t, stream.responses[0].GetSucceeded())
+		assert.Equal(t, "app-2", stream.responses[1].GetName())
+		assert.False(t, stream.responses[1].GetSucceeded())
+		assert.Contains(t, stream.responses[1].GetMessage(), "permission denied")
+	})
+
+	t.Run("Apps the user cannot see are skipped", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp("app-1", "default"), newApp("app-2", "my-proj"))
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		stream := &TestBulkOperationServer{ctx: ctx}
+		err := appServer.BulkOperation(&application.ApplicationBulkOperationRequest{
+			Operation: ptr.To(BulkOperationRefresh),
+		}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 1)
+		assert.Equal(t, "app-1", stream.responses[0].GetName())
+	})
+
+	t.Run("Unsupported operation", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		err := appServer.BulkOperation(&application.ApplicationBulkOperationRequest{
+			Operation: ptr.To("rollback"),
+		}, &TestBulkOperationServer{ctx: context.Background()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetBulkOperationConcurrency(t *testing.T) {
+	assert.Equal(t, int64(bulkOperationDefaultConcurrency), getBulkOperationConcurrency(0, 50))
+	assert.Equal(t, int64(20), getBulkOperationConcurrency(20, 50))
+	assert.Equal(t, int64(50), getBulkOperationConcurrency(1000, 50))
+	assert.Equal(t, int64(5), getBulkOperationConcurrency(0, 5))
+}
+
+func TestPauseAndResume(t *testing.T) {
+	t.Run("Pause and resume application", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		ctx := context.Background()
+
+		app, err := appServer.Pause(ctx, &application.ApplicationPauseRequest{
+			Name:     ptr.To("test-app"),
+			Reason:   ptr.To("database migration"),
+			Duration: ptr.To("2h"),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.Pause)
+		assert.Equal(t, "database migration", app.Spec.Pause.Reason)
+		require.NotNil(t, app.Spec.Pause.Until)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), app.Spec.Pause.Until.Time, time.Minute)
+		assert.True(t, app.Spec.Pause.IsActive(time.Now()))
+
+		_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "database migration")
+
+		app, err = appServer.Resume(ctx, &application.ApplicationResumeRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Nil(t, app.Spec.Pause)
+		assert.Equal(t, string(appsv1.RefreshTypeNormal), app.Annotations[appsv1.AnnotationKeyRefresh])
+
+		_, err = appServer.Resume(ctx, &application.ApplicationResumeRequest{Name: ptr.To("test-app")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Invalid duration", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		_, err := appServer.Pause(context.Background(), &application.ApplicationPauseRequest{
+			Name:     ptr.To("test-app"),
+			Duration: ptr.To("forever"),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Pause requires update permission", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, */*, allow
+`)
+		// nolint:staticcheck
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		_, err := appServer.Pause(ctx, &application.ApplicationPauseRequest{Name: ptr.To("test-app")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestPauseIsPreservedOnUpdate(t *testing.T) {
+	pausedApp := func() *appsv1.Application {
+		app := newTestApp()
+		app.Spec.Pause = &appsv1.ApplicationPause{Reason: "database migration", PausedBy: "alice"}
+		return app
+	}
+	forgedPause := &appsv1.ApplicationPause{Reason: "forged", PausedBy: "bob"}
+
+	t.Run("Update", func(t *testing.T) {
+		appServer := newTestAppServer(t, pausedApp())
+		update := newTestApp()
+		update.Spec.Pause = forgedPause
+		app, err := appServer.Update(context.Background(), &application.ApplicationUpdateRequest{Application: update})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.Pause)
+		assert.Equal(t, "alice", app.Spec.Pause.PausedBy)
+	})
+
+	t.Run("UpdateSpec", func(t *testing.T) {
+		appServer := newTestAppServer(t, pausedApp())
+		spec := newTestApp().Spec
+		spec.Pause = nil
+		_, err := appServer.UpdateSpec(context.Background(), &application.ApplicationUpdateSpecRequest{Name: ptr.To("test-app"), Spec: &spec})
+		require.NoError(t, err)
+		app, err := appServer.Get(context.Background(), &application.ApplicationQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.Pause)
+		assert.Equal(t, "database migration", app.Spec.Pause.Reason)
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		appServer := newTestAppServer(t, pausedApp())
+		app, err := appServer.Patch(context.Background(), &application.ApplicationPatchRequest{
+			Name: ptr.To("test-app"), Patch: ptr.To(`{"spec": {"pause": {"reason": "forged", "pausedBy": "bob"}}}`), PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.Pause)
+		assert.Equal(t, "alice", app.Spec.Pause.PausedBy)
+
+		app, err = appServer.Patch(context.Background(), &application.ApplicationPatchRequest{
+			Name: ptr.To("test-app"), Patch: ptr.To(`[{"op": "remove", "path": "/spec/pause"}]`),
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Spec.Pause)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		app := newTestApp()
+		app.Spec.Pause = forgedPause
+		created, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: app})
+		require.NoError(t, err)
+		assert.Nil(t, created.Spec.Pause)
+	})
+}
+
+func TestSyncLogs(t *testing.T) {
+	newApp := func() *appsv1.Application {
+		app := newTestApp()
+		app.Status.OperationState = &appsv1.OperationState{
+			Phase:      synccommon.OperationFailed,
+			SyncResult: &appsv1.SyncOperationResult{CapturedLogsID: "1700000000-00001-abcde"},
+		}
+		return app
+	}
+
+	t.Run("Returns the captured logs of the last sync", func(t *testing.T) {
+		app := newApp()
+		appServer := newTestAppServer(t, app)
+		logs := []*appsv1.CapturedContainerLogs{{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", PodName: "migrate-x7k2p", Container: "main", Logs: "relation already exists\n"}}
+		err := appstate.NewCache(appServer.cache.GetCache(), time.Hour).SetAppSyncCapturedLogs(app.InstanceName(appServer.ns), "1700000000-00001-abcde", logs)
+		require.NoError(t, err)
+
+		res, err := appServer.SyncLogs(context.Background(), &application.ApplicationSyncLogsQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Equal(t, logs, res.Items)
+	})
+
+	t.Run("Returns nothing once the logs have expired", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp())
+		res, err := appServer.SyncLogs(context.Background(), &application.ApplicationSyncLogsQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+
+	t.Run("Returns nothing without captured logs", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		res, err := appServer.SyncLogs(context.Background(), &application.ApplicationSyncLogsQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+}
+
+func TestReconcileProfiles(t *testing.T) {
+	t.Run("Returns the profiles of the last reconciliations", func(t *testing.T) {
+		app := newTestApp()
+		appServer := newTestAppServer(t, app)
+		profiles := []*appsv1.ReconcileProfile{{DurationMs: 1200, ComparisonLevel: "Latest", Phases: []appsv1.ReconcilePhaseTiming{{Name: "git", DurationMs: 1000}, {Name: "diff", DurationMs: 150}}}}
+		err := appstate.NewCache(appServer.cache.GetCache(), time.Hour).SetAppReconcileProfiles(app.InstanceName(appServer.ns), profiles)
+		require.NoError(t, err)
+
+		res, err := appServer.ReconcileProfiles(context.Background(), &application.ApplicationReconcileProfilesQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Equal(t, profiles, res.Items)
+	})
+
+	t.Run("Returns nothing without profiles", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		res, err := appServer.ReconcileProfiles(context.Background(), &application.ApplicationReconcileProfilesQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+}
+
+func TestSyncHistory(t *testing.T) {
+	app := newTestApp()
+	appServer := newTestAppServer(t, app)
+	var records []*appsv1.SyncHistoryRecord
+	startedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+	for id := int64(5); id > 0; id-- {
+		records = append(records, &appsv1.SyncHistoryRecord{ID: id, Revisions: []string{fmt.Sprintf("rev-%d", id)}, StartedAt: startedAt})
+	}
+	history := struct {
+		Records   []*appsv1.SyncHistoryRecord
+		Retention time.Duration
+	}{Records: records, Retention: time.Hour}
+	err := appServer.cache.GetCache().SetItem(fmt.Sprintf("app|sync-history|%s", app.InstanceName(appServer.ns)), history, &cacheutil.CacheActionOpts{Expiration: time.Hour})
+	require.NoError(t, err)
+
+	t.Run("Returns all the records", func(t *testing.T) {
+		res, err := appServer.SyncHistory(context.Background(), &application.ApplicationSyncHistoryQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Equal(t, records, res.Items)
+		assert.Equal(t, int64(5), res.GetTotal())
+	})
+
+	t.Run("Returns a page of the records", func(t *testing.T) {
+		res, err := appServer.SyncHistory(context.Background(), &application.ApplicationSyncHistoryQuery{Name: ptr.To("test-app"), Offset: ptr.To(int64(2)), Limit: ptr.To(int64(2))})
+		require.NoError(t, err)
+		assert.Equal(t, records[2:4], res.Items)
+		assert.Equal(t, int64(5), res.GetTotal())
+	})
+
+	t.Run("Returns nothing beyond the last record", func(t *testing.T) {
+		res, err := appServer.SyncHistory(context.Background(), &application.ApplicationSyncHistoryQuery{Name: ptr.To("test-app"), Offset: ptr.To(int64(5))})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+
+	t.Run("Returns nothing without history", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		res, err := appServer.SyncHistory(context.Background(), &application.ApplicationSyncHistoryQuery{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+		assert.Equal(t, int64(0), res.GetTotal())
+	})
+}
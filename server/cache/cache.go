@@ -65,6 +65,18 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppSyncCapturedLogs(appName string, id string, res *[]*appv1.CapturedContainerLogs) error {
+	return c.cache.GetAppSyncCapturedLogs(appName, id, res)
+}
+
+func (c *Cache) GetAppReconcileProfiles(appName string, res *[]*appv1.ReconcileProfile) error {
+	return c.cache.GetAppReconcileProfiles(appName, res)
+}
+
+func (c *Cache) GetAppSyncHistory(appName string, res *[]*appv1.SyncHistoryRecord) error {
+	return c.cache.GetAppSyncHistory(appName, res)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, project string, state *appv1.ConnectionState) error {
 	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.connectionStatusCacheExpiration, state == nil)
 }
@@ -276,6 +276,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"clusterResources": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.ClusterResources = existing.ClusterResources
 	},
+	"autoNamespaces": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.AutoNamespaces = existing.AutoNamespaces
+	},
 	"labels": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Labels = existing.Labels
 	},
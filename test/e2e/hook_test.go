@@ -68,6 +68,28 @@ func TestPostDeleteHook(t *testing.T) {
 		})
 }
 
+func TestPreDeleteHook(t *testing.T) {
+	Given(t).
+		Path("pre-delete-hook").
+		When().
+		CreateApp().
+		Sync().
+		Then().
+		Expect(OperationPhaseIs(OperationSucceeded)).
+		// the hook is not created by the sync
+		Expect(ResourceResultNumbering(1)).
+		When().
+		Delete(true).
+		Then().
+		Expect(DoesNotExist()).
+		AndAction(func() {
+			// the hook is managed by the application, so it gets deleted along with the other resources
+			hooks, err := KubeClientset.CoreV1().Pods(DeploymentNamespace()).List(context.Background(), metav1.ListOptions{})
+			CheckError(err)
+			assert.Empty(t, hooks.Items)
+		})
+}
+
 // make sure that that hooks do not appear in "argocd app diff"
 func TestHookDiff(t *testing.T) {
 	Given(t).
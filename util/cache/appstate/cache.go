@@ -2,6 +2,7 @@ package appstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -17,6 +18,25 @@ var ErrCacheMiss = cacheutil.ErrCacheMiss
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// syncCapturedLogsCacheExpiration is how long the logs captured from the pods which failed during a sync are kept
+	syncCapturedLogsCacheExpiration = 24 * time.Hour
+	// clusterShardAssignmentsCacheExpiration is how long the cluster shard assignments are kept once the controller
+	// replicas stop refreshing them
+	clusterShardAssignmentsCacheExpiration = 24 * time.Hour
+)
+
+const (
+	// EnvSyncHistoryLimit is the env variable holding the number of sync operations kept per application in the
+	// extended sync history, 0 disables the history
+	EnvSyncHistoryLimit = "ARGOCD_SYNC_HISTORY_LIMIT"
+	// EnvSyncHistoryRetention is the env variable holding how long the sync operations are kept in the extended sync
+	// history
+	EnvSyncHistoryRetention = "ARGOCD_SYNC_HISTORY_RETENTION"
+)
+
+var (
+	syncHistoryLimit     = env.ParseNumFromEnv(EnvSyncHistoryLimit, 0, 0, 10000)
+	syncHistoryRetention = env.ParseDurationFromEnv(EnvSyncHistoryRetention, 30*24*time.Hour, time.Hour, 366*24*time.Hour)
 )
 
 type Cache struct {
@@ -68,6 +88,30 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+// ComparisonInputs are the inputs of the comparison which produced the managed resources of an application. The diffs
+// of the managed resources can be reused, e.g. after a restart of the controller, as long as the inputs are unchanged
+// and the live resources have the same resource versions.
+type ComparisonInputs struct {
+	// Revisions are the revisions the manifests have been generated from
+	Revisions []string
+	// Hash is the hash of the manifests, of the comparison settings and of the part of the spec compared
+	Hash string
+}
+
+func appComparisonInputsKey(appName string) string {
+	return fmt.Sprintf("app|comparison-inputs|%s", appName)
+}
+
+func (c *Cache) GetAppComparisonInputs(appName string, res *ComparisonInputs) error {
+	return c.GetItem(appComparisonInputsKey(appName), res)
+}
+
+// SetAppComparisonInputs sets the inputs of the comparison of an application. They expire with the managed resources of
+// the application.
+func (c *Cache) SetAppComparisonInputs(appName string, inputs *ComparisonInputs) error {
+	return c.SetItem(appComparisonInputsKey(appName), inputs, c.appStateCacheExpiration, inputs == nil)
+}
+
 func appResourcesTreeKey(appName string) string {
 	return fmt.Sprintf("app|resources-tree|%s", appName)
 }
@@ -96,6 +140,139 @@ func (c *Cache) SetAppResourcesTree(appName string, resourcesTree *appv1.Applica
 	return c.Cache.NotifyUpdated(appManagedResourcesKey(appName))
 }
 
+func appSyncCapturedLogsKey(appName string, id string) string {
+	return fmt.Sprintf("app|sync-captured-logs|%s|%s", appName, id)
+}
+
+func (c *Cache) GetAppSyncCapturedLogs(appName string, id string, res *[]*appv1.CapturedContainerLogs) error {
+	return c.GetItem(appSyncCapturedLogsKey(appName, id), &res)
+}
+
+func (c *Cache) SetAppSyncCapturedLogs(appName string, id string, logs []*appv1.CapturedContainerLogs) error {
+	return c.SetItem(appSyncCapturedLogsKey(appName, id), logs, syncCapturedLogsCacheExpiration, logs == nil)
+}
+
+func appReconcileProfilesKey(appName string) string {
+	return fmt.Sprintf("app|reconcile-profiles|%s", appName)
+}
+
+// GetAppReconcileProfiles returns the profiles of the last reconciliations of an application, most recent first
+func (c *Cache) GetAppReconcileProfiles(appName string, res *[]*appv1.ReconcileProfile) error {
+	return c.GetItem(appReconcileProfilesKey(appName), &res)
+}
+
+func (c *Cache) SetAppReconcileProfiles(appName string, profiles []*appv1.ReconcileProfile) error {
+	return c.SetItem(appReconcileProfilesKey(appName), profiles, c.appStateCacheExpiration, profiles == nil)
+}
+
+func appSyncHistoryKey(appName string) string {
+	return fmt.Sprintf("app|sync-history|%s", appName)
+}
+
+// syncHistory is the extended sync history of an application as stored in the cache
+type syncHistory struct {
+	// Records holds the records of the history, most recent first
+	Records []*appv1.SyncHistoryRecord
+	// Retention is the retention period of the controller which recorded the history, so that the readers drop the
+	// expired records without having to be configured with the same retention period
+	Retention time.Duration
+}
+
+// GetAppSyncHistory returns the records of the extended sync history of an application which are within the retention
+// period, most recent first
+func (c *Cache) GetAppSyncHistory(appName string, res *[]*appv1.SyncHistoryRecord) error {
+	var history syncHistory
+	if err := c.GetItem(appSyncHistoryKey(appName), &history); err != nil {
+		return err
+	}
+	*res = retainSyncHistory(history.Records, 0, history.Retention, time.Now())
+	return nil
+}
+
+// AddAppSyncHistoryRecord adds the result of a sync operation to the extended sync history of an application, unless
+// the history is disabled. The oldest records are dropped once the history limit is reached or once they are older
+// than the retention period. The history expires once its most recent record is older than the retention period,
+// since the records which are older are expired too.
+func (c *Cache) AddAppSyncHistoryRecord(appName string, record *appv1.SyncHistoryRecord) error {
+	if syncHistoryLimit == 0 {
+		return nil
+	}
+	var history syncHistory
+	if err := c.GetItem(appSyncHistoryKey(appName), &history); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+	record.ID = 1
+	if len(history.Records) > 0 {
+		record.ID = history.Records[0].ID + 1
+	}
+	now := time.Now()
+	history.Records = retainSyncHistory(append([]*appv1.SyncHistoryRecord{record}, history.Records...), syncHistoryLimit, syncHistoryRetention, now)
+	history.Retention = syncHistoryRetention
+	if len(history.Records) == 0 {
+		return c.SetItem(appSyncHistoryKey(appName), nil, 0, true)
+	}
+	expiration := history.Records[0].StartedAt.Add(syncHistoryRetention).Sub(now)
+	return c.SetItem(appSyncHistoryKey(appName), history, expiration, false)
+}
+
+// retainSyncHistory returns the records, most recent first, which are within the limit, unless it is 0, and the
+// retention period
+func retainSyncHistory(records []*appv1.SyncHistoryRecord, limit int, retention time.Duration, now time.Time) []*appv1.SyncHistoryRecord {
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	for i, record := range records {
+		if now.Sub(record.StartedAt.Time) > retention {
+			return records[:i]
+		}
+	}
+	return records
+}
+
+func clusterShardAssignmentsKey() string {
+	return "cluster|shard-assignments"
+}
+
+// GetClusterShardAssignments returns the shards of the clusters, indexed by server, computed by the resource-cost
+// sharding algorithm
+func (c *Cache) GetClusterShardAssignments(res *map[string]int) error {
+	return c.GetItem(clusterShardAssignmentsKey(), res)
+}
+
+func (c *Cache) SetClusterShardAssignments(assignments map[string]int) error {
+	return c.SetItem(clusterShardAssignmentsKey(), assignments, clusterShardAssignmentsCacheExpiration, assignments == nil)
+}
+
+func clusterShardRebalancingLeaseKey() string {
+	return "cluster|shard-rebalancing-lease"
+}
+
+// AcquireClusterShardRebalancingLease acquires, or renews if the holder already holds it, the lease of the controller
+// replica rebalancing the clusters across the shards for the given duration. It returns whether the holder holds the
+// lease.
+func (c *Cache) AcquireClusterShardRebalancingLease(holder string, duration time.Duration) (bool, error) {
+	var current string
+	err := c.GetItem(clusterShardRebalancingLeaseKey(), &current)
+	if err == nil {
+		if current != holder {
+			return false, nil
+		}
+		return true, c.SetItem(clusterShardRebalancingLeaseKey(), holder, duration, false)
+	}
+	if !errors.Is(err, ErrCacheMiss) {
+		return false, err
+	}
+	err = c.Cache.SetItem(clusterShardRebalancingLeaseKey(), holder, &cacheutil.CacheActionOpts{Expiration: duration, DisableOverwrite: true})
+	if err != nil {
+		return false, err
+	}
+	// another replica may have acquired the lease in the meantime
+	if err := c.GetItem(clusterShardRebalancingLeaseKey(), &current); err != nil {
+		return false, err
+	}
+	return current == holder, nil
+}
+
 func (c *Cache) SetClusterInfo(server string, info *appv1.ClusterInfo) error {
 	return c.SetItem(clusterInfoKey(server), info, clusterInfoCacheExpiration, info == nil)
 }
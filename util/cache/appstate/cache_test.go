@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
@@ -41,6 +42,24 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppSyncCapturedLogs(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]*CapturedContainerLogs{}
+	err := cache.GetAppSyncCapturedLogs("my-appname", "my-id", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppSyncCapturedLogs("my-appname", "my-id", []*CapturedContainerLogs{{Kind: "Job", Name: "my-job", Logs: "failed"}})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppSyncCapturedLogs("my-appname", "other-id", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppSyncCapturedLogs("my-appname", "my-id", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]*CapturedContainerLogs{{Kind: "Job", Name: "my-job", Logs: "failed"}}, value)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
@@ -64,3 +83,106 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.appStateCacheExpiration)
 }
+
+func TestCache_GetAppReconcileProfiles(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]*ReconcileProfile{}
+	err := cache.GetAppReconcileProfiles("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppReconcileProfiles("my-appname", []*ReconcileProfile{{DurationMs: 100, Phases: []ReconcilePhaseTiming{{Name: "git", DurationMs: 80}}}})
+	require.NoError(t, err)
+	// cache hit
+	err = cache.GetAppReconcileProfiles("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &[]*ReconcileProfile{{DurationMs: 100, Phases: []ReconcilePhaseTiming{{Name: "git", DurationMs: 80}}}}, value)
+}
+
+func TestCache_GetAppComparisonInputs(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &ComparisonInputs{}
+	err := cache.GetAppComparisonInputs("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppComparisonInputs("my-appname", &ComparisonInputs{Revisions: []string{"abc123"}, Hash: "myhash"})
+	require.NoError(t, err)
+	// cache hit
+	err = cache.GetAppComparisonInputs("my-appname", value)
+	require.NoError(t, err)
+	assert.Equal(t, &ComparisonInputs{Revisions: []string{"abc123"}, Hash: "myhash"}, value)
+	// delete
+	err = cache.SetAppComparisonInputs("my-appname", nil)
+	require.NoError(t, err)
+	err = cache.GetAppComparisonInputs("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
+func TestCache_AcquireClusterShardRebalancingLease(t *testing.T) {
+	cache := newFixtures().Cache
+	// acquire
+	leader, err := cache.AcquireClusterShardRebalancingLease("replica-0", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+	// held by another replica
+	leader, err = cache.AcquireClusterShardRebalancingLease("replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, leader)
+	// renew
+	leader, err = cache.AcquireClusterShardRebalancingLease("replica-0", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, leader)
+}
+
+func TestCache_AddAppSyncHistoryRecord(t *testing.T) {
+	cache := newFixtures().Cache
+	defer func(limit int) { syncHistoryLimit = limit }(syncHistoryLimit)
+
+	// the history is disabled by default
+	require.NoError(t, cache.AddAppSyncHistoryRecord("my-appname", &SyncHistoryRecord{StartedAt: metav1.Now()}))
+	value := &[]*SyncHistoryRecord{}
+	assert.Equal(t, ErrCacheMiss, cache.GetAppSyncHistory("my-appname", value))
+
+	syncHistoryLimit = 2
+	for _, revision := range []string{"a", "b", "c"} {
+		require.NoError(t, cache.AddAppSyncHistoryRecord("my-appname", &SyncHistoryRecord{Revisions: []string{revision}, StartedAt: metav1.Now()}))
+	}
+	require.NoError(t, cache.GetAppSyncHistory("my-appname", value))
+	require.Len(t, *value, 2)
+	assert.Equal(t, int64(3), (*value)[0].ID)
+	assert.Equal(t, []string{"c"}, (*value)[0].Revisions)
+	assert.Equal(t, int64(2), (*value)[1].ID)
+}
+
+func TestCache_GetAppSyncHistory(t *testing.T) {
+	cache := newFixtures().Cache
+	now := time.Now()
+	require.NoError(t, cache.SetItem(appSyncHistoryKey("my-appname"), syncHistory{
+		Records: []*SyncHistoryRecord{
+			{ID: 2, StartedAt: metav1.NewTime(now.Add(-time.Minute))},
+			{ID: 1, StartedAt: metav1.NewTime(now.Add(-2 * time.Hour))},
+		},
+		Retention: time.Hour,
+	}, time.Hour, false))
+
+	// the expired records are not returned
+	value := &[]*SyncHistoryRecord{}
+	require.NoError(t, cache.GetAppSyncHistory("my-appname", value))
+	require.Len(t, *value, 1)
+	assert.Equal(t, int64(2), (*value)[0].ID)
+}
+
+func TestRetainSyncHistory(t *testing.T) {
+	now := time.Now()
+	records := []*SyncHistoryRecord{
+		{ID: 3, StartedAt: metav1.NewTime(now.Add(-time.Hour))},
+		{ID: 2, StartedAt: metav1.NewTime(now.Add(-48 * time.Hour))},
+		{ID: 1, StartedAt: metav1.NewTime(now.Add(-72 * time.Hour))},
+	}
+	assert.Len(t, retainSyncHistory(records, 10, 100*time.Hour, now), 3)
+	assert.Len(t, retainSyncHistory(records, 2, 100*time.Hour, now), 2)
+	retained := retainSyncHistory(records, 10, 50*time.Hour, now)
+	require.Len(t, retained, 2)
+	assert.Equal(t, int64(2), retained[1].ID)
+}
@@ -319,6 +319,9 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 
 // clusterToSecret converts a cluster object to string data for serialization to a secret
 func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
+	if c.AutoNamespaces && len(c.Namespaces) != 0 {
+		return status.Errorf(codes.InvalidArgument, "automatic namespaces cannot be combined with a list of namespaces")
+	}
 	data := make(map[string][]byte)
 	data["server"] = []byte(strings.TrimRight(c.Server, "/"))
 	if c.Name == "" {
@@ -340,6 +343,9 @@ func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
 	if c.ClusterResources {
 		data["clusterResources"] = []byte("true")
 	}
+	if c.AutoNamespaces {
+		data["autoNamespaces"] = []byte("true")
+	}
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
@@ -418,6 +424,7 @@ func SecretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
 		Name:               string(s.Data["name"]),
 		Namespaces:         namespaces,
 		ClusterResources:   string(s.Data["clusterResources"]) == "true",
+		AutoNamespaces:     string(s.Data["autoNamespaces"]) == "true",
 		Config:             config,
 		RefreshRequestedAt: refreshRequestedAt,
 		Shard:              shard,
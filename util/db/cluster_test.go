@@ -133,6 +133,19 @@ func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
+func TestClusterToSecret_AutoNamespacesWithNamespacesRejected(t *testing.T) {
+	cluster := &appv1.Cluster{
+		Server:         "server",
+		Name:           "test",
+		Namespaces:     []string{"default"},
+		AutoNamespaces: true,
+	}
+	s := &v1.Secret{}
+	err := clusterToSecret(cluster, s)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func Test_secretToCluster_NoConfig(t *testing.T) {
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -84,6 +84,8 @@ type Client interface {
 	IsRevisionPresent(revision string) bool
 	ListRevisions(revision string, targetRevision string) ([]string, error)
 	DiffTree(targetRevision string) ([]string, error)
+	AddWorktree(path string, revision string) error
+	RemoveWorktree(path string) error
 }
 
 type EventHandlers struct {
@@ -460,6 +462,28 @@ func (m *nativeGitClient) Submodule() error {
 	return nil
 }
 
+// AddWorktree checks out the specified revision into a new worktree at the given path. The worktree shares the objects
+// of the repository, and its HEAD is detached so that several worktrees can check out the same branch.
+func (m *nativeGitClient) AddWorktree(path string, revision string) error {
+	// --force allows to re-use the path of a worktree which has been deleted without being removed
+	_, err := m.runCmd("worktree", "add", "--force", "--detach", path, revision)
+	return err
+}
+
+// RemoveWorktree removes the worktree at the given path, along with its files
+func (m *nativeGitClient) RemoveWorktree(path string) error {
+	if _, err := m.runCmd("worktree", "remove", "--force", path); err != nil {
+		// the worktree might be corrupted or partially deleted, in which case its files are deleted and git forgets
+		// about it when pruning the worktrees
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		_, err = m.runCmd("worktree", "prune")
+		return err
+	}
+	return nil
+}
+
 // Checkout checkout specified revision
 func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) error {
 	if revision == "" || revision == "HEAD" {
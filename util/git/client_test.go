@@ -310,3 +310,58 @@ func Test_ListOfRevisionsContainSameRevision(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{"15a9e18218d74c033d411316e6cbe5e45565875a"}, revs)
 }
+
+func Test_nativeGitClient_Worktree(t *testing.T) {
+	tempDir := t.TempDir()
+
+	client, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), tempDir, NopCreds{}, true, false, "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(client.Root(), "README"), []byte("first"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(client.Root(), "git", "add", "README")
+	require.NoError(t, err)
+	err = runCmd(client.Root(), "git", "commit", "-m", "First commit")
+	require.NoError(t, err)
+	first, err := client.CommitSHA()
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(client.Root(), "README"), []byte("second"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(client.Root(), "git", "commit", "-m", "Second commit", "-a")
+	require.NoError(t, err)
+
+	worktreePath := filepath.Join(client.Root(), ".git", "worktrees-test", first)
+	err = client.AddWorktree(worktreePath, first)
+	require.NoError(t, err)
+
+	// the worktree has the first revision checked out, while the repository keeps the second one
+	data, err := os.ReadFile(filepath.Join(worktreePath, "README"))
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(data))
+	data, err = os.ReadFile(filepath.Join(client.Root(), "README"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	worktreeClient, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), worktreePath, NopCreds{}, true, false, "")
+	require.NoError(t, err)
+	commitSHA, err := worktreeClient.CommitSHA()
+	require.NoError(t, err)
+	assert.Equal(t, first, commitSHA)
+
+	err = client.RemoveWorktree(worktreePath)
+	require.NoError(t, err)
+	assert.NoDirExists(t, worktreePath)
+
+	// a deleted worktree can be added again
+	err = client.AddWorktree(worktreePath, first)
+	require.NoError(t, err)
+	err = os.RemoveAll(worktreePath)
+	require.NoError(t, err)
+	err = client.AddWorktree(worktreePath, first)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(worktreePath, "README"))
+}
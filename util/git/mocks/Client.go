@@ -12,6 +12,24 @@ type Client struct {
 	mock.Mock
 }
 
+// AddWorktree provides a mock function with given fields: path, revision
+func (_m *Client) AddWorktree(path string, revision string) error {
+	ret := _m.Called(path, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWorktree")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ChangedFiles provides a mock function with given fields: revision, targetRevision
 func (_m *Client) ChangedFiles(revision string, targetRevision string) ([]string, error) {
 	ret := _m.Called(revision, targetRevision)
@@ -368,6 +386,24 @@ func (_m *Client) RevisionMetadata(revision string) (*git.RevisionMetadata, erro
 	return r0, r1
 }
 
+// RemoveWorktree provides a mock function with given fields: path
+func (_m *Client) RemoveWorktree(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveWorktree")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Root provides a mock function with given fields:
 func (_m *Client) Root() string {
 	ret := _m.Called()
@@ -54,6 +54,8 @@ type Server struct {
 	PlainText bool `json:"plain-text,omitempty"`
 	// Core indicates to talk to Kubernetes API without using Argo CD API server
 	Core bool `json:"core,omitempty"`
+	// CoreLocal indicates to generate manifests in-process and keep the cache in memory when talking to Kubernetes API
+	CoreLocal bool `json:"core-local,omitempty"`
 }
 
 // User contains user authentication information
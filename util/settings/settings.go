@@ -522,6 +522,10 @@ const (
 	RespectRBACValueNormal = "normal"
 	// kustomizeSetNamespaceEnabledKey is the key to configure if kustomize set namespace should be executed
 	kustomizeSetNamespaceEnabledKey = "kustomize.setNamespace.enabled"
+	// impersonationEnabledKey is the key to configure whether syncs impersonate the service accounts of the project destinations
+	impersonationEnabledKey = "application.sync.impersonation.enabled"
+	// impersonationAuditLogEnabledKey is the key to configure whether the impersonated service account of each sync is logged
+	impersonationAuditLogEnabledKey = "application.sync.impersonation.auditLog.enabled"
 )
 
 const (
@@ -789,6 +793,25 @@ func (mgr *SettingsManager) GetKustomizeSetNamespaceEnabled() bool {
 	return kustomizeSetNamespaceEnabled == "true"
 }
 
+// IsImpersonationEnabled returns whether syncs impersonate the service accounts configured in the destinationServiceAccounts
+// of the application project
+func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", impersonationEnabledKey, err)
+	}
+	return argoCDCM.Data[impersonationEnabledKey] == "true", nil
+}
+
+// IsImpersonationAuditLogEnabled returns whether the service account impersonated by each sync is audit logged
+func (mgr *SettingsManager) IsImpersonationAuditLogEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", impersonationAuditLogEnabledKey, err)
+	}
+	return argoCDCM.Data[impersonationAuditLogEnabledKey] == "true", nil
+}
+
 func (mgr *SettingsManager) GetTrackingMethod() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -210,6 +210,27 @@ func TestGetServerRBACLogEnforceEnableKey(t *testing.T) {
 	assert.True(t, serverRBACLogEnforceEnable)
 }
 
+func TestIsImpersonationEnabled(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	enabled, err := settingsManager.IsImpersonationEnabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	auditLogEnabled, err := settingsManager.IsImpersonationAuditLogEnabled()
+	require.NoError(t, err)
+	assert.False(t, auditLogEnabled)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.sync.impersonation.enabled":          "true",
+		"application.sync.impersonation.auditLog.enabled": "true",
+	})
+	enabled, err = settingsManager.IsImpersonationEnabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	auditLogEnabled, err = settingsManager.IsImpersonationAuditLogEnabled()
+	require.NoError(t, err)
+	assert.True(t, auditLogEnabled)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},